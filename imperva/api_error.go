@@ -0,0 +1,85 @@
+package imperva
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+)
+
+// APIError is an error response of the Incapsula API. Its message is the one the client method returned before,
+// the status code and res code let callers react to specific errors, e.g. IsConflictError.
+type APIError struct {
+	StatusCode int
+	Res        int
+	ResMessage string
+	Body       string
+	message    string
+}
+
+func (e *APIError) Error() string {
+	return e.message
+}
+
+// NewAPIError returns an APIError for the response, with the res code and message of v1 API responses when present
+func NewAPIError(statusCode int, responseBody []byte, format string, args ...interface{}) *APIError {
+	apiError := &APIError{
+		StatusCode: statusCode,
+		Body:       string(responseBody),
+		message:    fmt.Sprintf(format, args...),
+	}
+
+	var resResponse struct {
+		Res        interface{} `json:"res"`
+		ResMessage string      `json:"res_message"`
+	}
+	if json.Unmarshal(responseBody, &resResponse) == nil {
+		// Res can sometimes oscillate between a string and number
+		fmt.Sscan(fmt.Sprint(resResponse.Res), &apiError.Res)
+		apiError.ResMessage = resResponse.ResMessage
+	}
+
+	return apiError
+}
+
+// addSiteInProgressMessage is part of the res_message of the v1 API rejecting a change of a site which is still being
+// added, its res code is the generic one so the message tells it apart
+const addSiteInProgressMessage = "add site operation"
+
+// IsConflictError tells whether the API rejected a change because another change is in flight (HTTP 409 or 423, or
+// a v1 response for a site still being added), in which case the change can be retried once the other one is done
+func IsConflictError(err error) bool {
+	var apiError *APIError
+	if !errors.As(err, &apiError) {
+		return false
+	}
+
+	switch apiError.StatusCode {
+	case http.StatusConflict, http.StatusLocked:
+		return true
+	}
+
+	return apiError.Res != 0 && strings.Contains(strings.ToLower(apiError.ResMessage), addSiteInProgressMessage)
+}
+
+// IsNotFoundError tells whether the API rejected a request because the object doesn't exist, e.g. it was deleted
+// outside of Terraform
+func IsNotFoundError(err error) bool {
+	var apiError *APIError
+	return errors.As(err, &apiError) && apiError.StatusCode == http.StatusNotFound
+}
+
+// IsTransientError tells whether a request failed before the API answered because of a transient transport error,
+// e.g. a timeout or a reset connection, in which case the request can be retried
+func IsTransientError(err error) bool {
+	var netError net.Error
+	if errors.As(err, &netError) && netError.Timeout() {
+		return true
+	}
+	return errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED)
+}
@@ -0,0 +1,100 @@
+package imperva
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"syscall"
+	"testing"
+)
+
+func TestNewAPIError(t *testing.T) {
+	apiError := NewAPIError(200, []byte(`{"res":"9413","res_message":"Unknown/unauthorized site_id"}`), "Error updating site %s", "123")
+	if apiError.Error() != "Error updating site 123" {
+		t.Errorf("Should have kept the message, got: %s", apiError.Error())
+	}
+	if apiError.Res != 9413 || apiError.ResMessage != "Unknown/unauthorized site_id" {
+		t.Errorf("Should have parsed the res code, got: %+v", apiError)
+	}
+
+	apiError = NewAPIError(409, []byte(`{"errors":[{"status":"409"}]}`), "Error")
+	if apiError.StatusCode != 409 || apiError.Res != 0 {
+		t.Errorf("Unexpected API error: %+v", apiError)
+	}
+}
+
+func TestIsConflictError(t *testing.T) {
+	cases := []struct {
+		err      error
+		conflict bool
+	}{
+		{NewAPIError(http.StatusConflict, []byte(`{"errors":[{"status":"409"}]}`), "Error"), true},
+		{fmt.Errorf("wrapped: %w", NewAPIError(http.StatusConflict, nil, "Error")), true},
+		{NewAPIError(http.StatusLocked, nil, "Error"), true},
+		{NewAPIError(200, []byte(`{"res":1,"res_message":"Add site operation is still in progress"}`), "Error"), true},
+		{NewAPIError(http.StatusBadRequest, []byte(`{"errors":[{"status":"400","detail":"Invalid concurrent_sessions_threshold"}]}`), "Error"), false},
+		{NewAPIError(200, []byte(`{"res":0,"debug_info":"Add site operation"}`), "Error"), false},
+		{NewAPIError(http.StatusBadRequest, []byte(`{"errors":[{"status":"400","detail":"Invalid filter"}]}`), "Error"), false},
+		{NewAPIError(200, []byte(`{"res":9413,"res_message":"Unknown/unauthorized site_id"}`), "Error"), false},
+		{errors.New("operation in progress"), false},
+	}
+
+	for _, c := range cases {
+		if IsConflictError(c.err) != c.conflict {
+			t.Errorf("isConflictError(%v) should be %t", c.err, c.conflict)
+		}
+	}
+}
+
+func TestIsNotFoundError(t *testing.T) {
+	if !IsNotFoundError(fmt.Errorf("wrapped: %w", NewAPIError(http.StatusNotFound, nil, "Error"))) {
+		t.Error("Should have been a not found error")
+	}
+	if IsNotFoundError(NewAPIError(http.StatusBadRequest, nil, "Error")) || IsNotFoundError(errors.New("not found")) {
+		t.Error("Should not have been a not found error")
+	}
+}
+
+func TestIsTransientError(t *testing.T) {
+	cases := []struct {
+		err       error
+		transient bool
+	}{
+		{fmt.Errorf("wrapped: %w", &url.Error{Op: "Put", URL: "https://my.imperva.com", Err: io.ErrUnexpectedEOF}), true},
+		{&url.Error{Op: "Put", URL: "https://my.imperva.com", Err: syscall.ECONNRESET}, true},
+		{NewAPIError(http.StatusBadRequest, nil, "Error"), false},
+		{errors.New("connection reset"), false},
+	}
+
+	for _, c := range cases {
+		if IsTransientError(c.err) != c.transient {
+			t.Errorf("IsTransientError(%v) should be %t", c.err, c.transient)
+		}
+	}
+}
+
+func TestClientGetPolicyNotFound(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodGet, "/"+endpointPolicies+"/123", mockAPIError(http.StatusNotFound, "Policy not found"))
+
+	_, err := api.client().GetPolicy("123")
+	if !IsNotFoundError(err) {
+		t.Errorf("Should have received a not found error, got: %v", err)
+	}
+}
+
+func TestClientListDataCentersResCode(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodPost, "/"+endpointDataCenterList, mockJSON(`{"res":"9413","res_message":"Unknown/unauthorized site_id"}`))
+
+	_, err := api.client().ListDataCenters("123")
+	var apiError *APIError
+	if !errors.As(err, &apiError) {
+		t.Fatalf("Should have received an APIError, got: %v", err)
+	}
+	if apiError.Res != 9413 || apiError.ResMessage != "Unknown/unauthorized site_id" {
+		t.Errorf("Should have kept the res code, got: %+v", apiError)
+	}
+}
@@ -1,4 +1,4 @@
-package incapsula
+package imperva
 
 type ViolationActions struct {
 	InvalidUrlViolationAction        string `json:"invalidUrlViolationAction"`
@@ -1,4 +1,4 @@
-package incapsula
+package imperva
 
 import (
 	"bytes"
@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
 const contentTypeApplicationUrlEncoded = "application/x-www-form-urlencoded"
@@ -20,11 +21,25 @@ type Client struct {
 	config          *Config
 	httpClient      *http.Client
 	providerVersion string
+
+	// Geo info is only fetched once, see GetCachedGeoInfo
+	geoInfo      *GeoInfoResponse
+	geoInfoErr   error
+	geoInfoMutex sync.Mutex
+
+	// Referenced sites and accounts are only checked once, see checkReference
+	referenceChecks      map[string]error
+	referenceChecksMutex sync.Mutex
+
+	// Sites are listed once per account, see CachedSiteStatus
+	siteCache         map[int]SiteStatusResponse
+	siteCacheAccounts map[int]bool
+	siteCacheMutex    sync.Mutex
 }
 
 // NewClient creates a new client with the provided configuration
 func NewClient(config *Config) *Client {
-	client := &http.Client{}
+	client := &http.Client{Transport: config.Transport}
 
 	return &Client{config: config, httpClient: client, providerVersion: "3.5.2"}
 }
@@ -65,7 +80,7 @@ func (c *Client) Verify() (*AccountStatusResponse, error) {
 
 	// Look at the response status code from Incapsula
 	if resString != "0" {
-		return &accountStatusResponse, fmt.Errorf("Error from Incapsula service when checking account: %s", string(responseBody))
+		return &accountStatusResponse, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when checking account: %s", string(responseBody))
 	}
 	return &accountStatusResponse, nil
 }
@@ -123,6 +138,15 @@ func GetRequestParamsWithCaid(accountId int) map[string]string {
 	return params
 }
 
+// integrationBaseURL returns the base URL of the integration API (client applications, geo info, etc.),
+// which lives next to the provisioning API: https://my.incapsula.com/api/integration/v1
+func (c *Client) integrationBaseURL() string {
+	if strings.HasSuffix(c.config.BaseURL, "/prov/v1") {
+		return strings.TrimSuffix(c.config.BaseURL, "/prov/v1") + "/integration/v1"
+	}
+	return c.config.BaseURL
+}
+
 func (c *Client) DoJsonRequestWithHeadersForm(method string, url string, data []byte, contentType string, operation string) (*http.Response, error) {
 	req, err := PrepareJsonRequest(method, url, data)
 	if err != nil {
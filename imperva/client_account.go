@@ -1,7 +1,6 @@
-package incapsula
+package imperva
 
 import (
-	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -122,14 +121,14 @@ func (c *Client) AddAccount(email, refID, userName, planID, accountName, logLeve
 
 	// Parse the JSON
 	var accountAddResponse AccountAddResponse
-	err = json.Unmarshal([]byte(responseBody), &accountAddResponse)
+	err = decodeJSONResponse(endpointAccountAdd, responseBody, &accountAddResponse)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing add account JSON response for email %s: %s", email, err)
 	}
 
 	// Look at the response status code from Incapsula
 	if accountAddResponse.Res != 0 {
-		return nil, fmt.Errorf("Error from Incapsula service when adding account for email %s: %s", email, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when adding account for email %s: %s", email, string(responseBody))
 	}
 
 	return &accountAddResponse, nil
@@ -156,7 +155,7 @@ func (c *Client) AccountStatus(accountID int) (*AccountStatusResponse, error) {
 
 	// Parse the JSON
 	var accountStatusResponse AccountStatusResponse
-	err = json.Unmarshal([]byte(responseBody), &accountStatusResponse)
+	err = decodeJSONResponse(endpointAccountStatus, responseBody, &accountStatusResponse)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing account status JSON response for account id %d: %s", accountID, err)
 	}
@@ -171,7 +170,7 @@ func (c *Client) AccountStatus(accountID int) (*AccountStatusResponse, error) {
 
 	// Look at the response status code from Incapsula
 	if resString != "0" {
-		return &accountStatusResponse, fmt.Errorf("Error from Incapsula service when getting account status for account id %d: %s", accountID, string(responseBody))
+		return &accountStatusResponse, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when getting account status for account id %d: %s", accountID, string(responseBody))
 	}
 
 	return &accountStatusResponse, nil
@@ -201,14 +200,14 @@ func (c *Client) UpdateAccount(accountID, param, value string) (*AccountUpdateRe
 
 	// Parse the JSON
 	var accountUpdateResponse AccountUpdateResponse
-	err = json.Unmarshal([]byte(responseBody), &accountUpdateResponse)
+	err = decodeJSONResponse(endpointAccountUpdate, responseBody, &accountUpdateResponse)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing update account JSON response for accountID %s: %s", accountID, err)
 	}
 
 	// Look at the response status code from Incapsula
 	if accountUpdateResponse.Res != 0 {
-		return nil, fmt.Errorf("Error from Incapsula service when updating account for accountID %s: %s", accountID, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when updating account for accountID %s: %s", accountID, string(responseBody))
 	}
 
 	return &accountUpdateResponse, nil
@@ -242,14 +241,14 @@ func (c *Client) DeleteAccount(accountID int) error {
 
 	// Parse the JSON
 	var accountDeleteResponse AccountDeleteResponse
-	err = json.Unmarshal([]byte(responseBody), &accountDeleteResponse)
+	err = decodeJSONResponse(endpointAccountDelete, responseBody, &accountDeleteResponse)
 	if err != nil {
 		return fmt.Errorf("Error parsing delete account JSON response for account id: %d: %s", accountID, err)
 	}
 
 	// Look at the response status code from Incapsula
 	if accountDeleteResponse.Res != 0 {
-		return fmt.Errorf("Error from Incapsula service when deleting account id: %d: %s", accountID, string(responseBody))
+		return NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when deleting account id: %d: %s", accountID, string(responseBody))
 	}
 
 	return nil
@@ -1,4 +1,4 @@
-package incapsula
+package imperva
 
 import (
 	"encoding/json"
@@ -49,7 +49,7 @@ func (c *Client) GetAccountDataStorageRegion(accountID string) (*AccountDataStor
 
 	// Look at the response status code from Incapsula
 	if accountDataStorageRegionResponse.Res != 0 {
-		return &accountDataStorageRegionResponse, fmt.Errorf("Error from Incapsula service when getting default data storage region for account id: %s: %s", accountID, string(responseBody))
+		return &accountDataStorageRegionResponse, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when getting default data storage region for account id: %s: %s", accountID, string(responseBody))
 	}
 
 	return &accountDataStorageRegionResponse, nil
@@ -86,7 +86,7 @@ func (c *Client) UpdateAccountDataStorageRegion(accountID, region string) (*Acco
 
 	// Look at the response status code from Incapsula
 	if accountDataStorageRegionResponse.Res != 0 {
-		return nil, fmt.Errorf("Error from Incapsula service when updating default data storage region for accountID %s: %s", accountID, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when updating default data storage region for accountID %s: %s", accountID, string(responseBody))
 	}
 
 	return &accountDataStorageRegionResponse, nil
@@ -1,4 +1,4 @@
-package incapsula
+package imperva
 
 import (
 	"bytes"
@@ -147,7 +147,7 @@ func (c *Client) CreateApiSecurityApiConfig(siteId int, apiConfigPayload *ApiSec
 	log.Printf("[DEBUG] Incapsula Create Api-Security API Config JSON response: %s\n", string(responseBody))
 
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Error status code %d from Incapsula service while creating API Security API Config for Site ID %d: %v", resp.StatusCode, siteId, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service while creating API Security API Config for Site ID %d: %v", resp.StatusCode, siteId, string(responseBody))
 	}
 	// Dump JSON
 	var apiAddResponse ApiSecurityApiConfigPostResponse
@@ -266,7 +266,7 @@ func (c *Client) GetApiSecurityApiConfig(siteId int, apiId int) (*ApiSecurityApi
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Error status code %d from Incapsula service when reading Api-Security Api Config for Api ID %d: %s", resp.StatusCode, apiId, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when reading Api-Security Api Config for Api ID %d: %s", resp.StatusCode, apiId, string(responseBody))
 	}
 
 	// Parse the JSON
@@ -295,7 +295,7 @@ func (c *Client) GetApiSecurityApiSwaggerConfig(siteId int, apiId int) (*ApiSecu
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Error status code %d from Incapsula service when reading Api-Security Api Config for Api ID %d: %s", resp.StatusCode, apiId, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when reading Api-Security Api Config for Api ID %d: %s", resp.StatusCode, apiId, string(responseBody))
 	}
 
 	// Dump JSON
@@ -324,7 +324,7 @@ func (c *Client) DeleteApiSecurityApiConfig(siteID int, apiID string) error {
 	responseBody, err := ioutil.ReadAll(resp.Body)
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return fmt.Errorf("[ERROR] Error status code %d from Incapsula service when deleting API Security API Config for Site ID %d, API Config ID %s: %s", resp.StatusCode, siteID, apiID, string(responseBody))
+		return NewAPIError(resp.StatusCode, responseBody, "[ERROR] Error status code %d from Incapsula service when deleting API Security API Config for Site ID %d, API Config ID %s: %s", resp.StatusCode, siteID, apiID, string(responseBody))
 	}
 	// Dump JSON
 	var apiSecurityApiConfigDeleteResponse ApiSecurityApiConfigDeleteResponse
@@ -1,4 +1,4 @@
-package incapsula
+package imperva
 
 import (
 	"bytes"
@@ -81,7 +81,7 @@ func (c *Client) PostApiSecurityEndpointConfig(apiId, endpointId int, endpointCo
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Error status code %d from Incapsula service while updating Api Security Endpoint configuration for API Config Id %d, Endpoint Config Id: %d. Error: %s", resp.StatusCode, apiId, endpointId, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service while updating Api Security Endpoint configuration for API Config Id %d, Endpoint Config Id: %d. Error: %s", resp.StatusCode, apiId, endpointId, string(responseBody))
 	}
 
 	// Parse the JSON
@@ -110,7 +110,7 @@ func (c *Client) GetApiSecurityEndpointConfig(apiId int, endpointId string) (*Ap
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("[ERROR] Error status code %d from Incapsula service when reading Api-Security Endpoint Config for API ID %d and Endpoint ID %s: %s", resp.StatusCode, apiId, endpointId, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "[ERROR] Error status code %d from Incapsula service when reading Api-Security Endpoint Config for API ID %d and Endpoint ID %s: %s", resp.StatusCode, apiId, endpointId, string(responseBody))
 	}
 
 	// Parse the JSON
@@ -140,7 +140,7 @@ func (c *Client) GetApiSecurityAllEndpointsConfig(apiId int) (*ApiSecurityEndpoi
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("error status code %d from Incapsula service when reading Api-Security all Endpoints Config for API ID %d: %s", resp.StatusCode, apiId, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "error status code %d from Incapsula service when reading Api-Security all Endpoints Config for API ID %d: %s", resp.StatusCode, apiId, string(responseBody))
 	}
 
 	// Parse the JSON
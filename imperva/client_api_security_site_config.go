@@ -1,4 +1,4 @@
-package incapsula
+package imperva
 
 import (
 	"encoding/json"
@@ -58,7 +58,7 @@ func (c *Client) ReadApiSecuritySiteConfig(siteId int) (*ApiSecuritySiteConfigGe
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Error status code %d from Incapsula service when reading Api-Security Site Config for site ID %d: %s", resp.StatusCode, siteId, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when reading Api-Security Site Config for site ID %d: %s", resp.StatusCode, siteId, string(responseBody))
 	}
 
 	// Parse the JSON
@@ -96,7 +96,7 @@ func (c *Client) UpdateApiSecuritySiteConfig(siteId int, siteConfigPayload *ApiS
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Error status code %d from Incapsula service when updating api-security site configuration: %s", resp.StatusCode, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when updating api-security site configuration: %s", resp.StatusCode, string(responseBody))
 	}
 
 	// Parse the JSON
@@ -0,0 +1,90 @@
+package imperva
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+)
+
+// Endpoints (unexported consts)
+const endpointBGPConnection = "bgp-connections"
+
+// BGPConnection is a BGP peering session between Infrastructure Protection and the customer's router.
+// The MD5 password is only sent to the API, it is never returned. It's left unchanged when nil,
+// and removed when empty.
+type BGPConnection struct {
+	ID           string  `json:"id,omitempty"`
+	Name         string  `json:"name"`
+	PeerASN      int     `json:"peerAsn"`
+	PeerIP       string  `json:"peerIp"`
+	MD5Password  *string `json:"md5Password,omitempty"`
+	TunnelID     string  `json:"tunnelId,omitempty"`
+	SessionState string  `json:"sessionState,omitempty"`
+}
+
+// BGPConnectionResponse contains the BGP connection returned by the API
+type BGPConnectionResponse struct {
+	Data []BGPConnection `json:"data"`
+}
+
+// AddBGPConnection adds a BGP connection to an account
+func (c *Client) AddBGPConnection(accountID int, connection *BGPConnection) (*BGPConnection, error) {
+	log.Printf("[INFO] Adding Incapsula BGP connection %s for account %d\n", connection.Name, accountID)
+
+	responseBody, _, err := c.doInfraProtectRequest(http.MethodPost, endpointBGPConnection, accountID, connection, CreateBGPConnection, "adding BGP connection")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseBGPConnectionResponse(responseBody, "add BGP connection")
+}
+
+// GetBGPConnection gets a BGP connection, along with the status code of the response
+func (c *Client) GetBGPConnection(accountID int, connectionID string) (*BGPConnection, int, error) {
+	log.Printf("[INFO] Getting Incapsula BGP connection %s for account %d\n", connectionID, accountID)
+
+	path := fmt.Sprintf("%s/%s", endpointBGPConnection, connectionID)
+	responseBody, statusCode, err := c.doInfraProtectRequest(http.MethodGet, path, accountID, nil, ReadBGPConnection, fmt.Sprintf("reading BGP connection %s", connectionID))
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	connection, err := parseBGPConnectionResponse(responseBody, fmt.Sprintf("read BGP connection %s", connectionID))
+	return connection, statusCode, err
+}
+
+// UpdateBGPConnection updates a BGP connection
+func (c *Client) UpdateBGPConnection(accountID int, connectionID string, connection *BGPConnection) (*BGPConnection, error) {
+	log.Printf("[INFO] Updating Incapsula BGP connection %s for account %d\n", connectionID, accountID)
+
+	path := fmt.Sprintf("%s/%s", endpointBGPConnection, connectionID)
+	responseBody, _, err := c.doInfraProtectRequest(http.MethodPut, path, accountID, connection, UpdateBGPConnection, fmt.Sprintf("updating BGP connection %s", connectionID))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseBGPConnectionResponse(responseBody, fmt.Sprintf("update BGP connection %s", connectionID))
+}
+
+// DeleteBGPConnection deletes a BGP connection
+func (c *Client) DeleteBGPConnection(accountID int, connectionID string) error {
+	log.Printf("[INFO] Deleting Incapsula BGP connection %s for account %d\n", connectionID, accountID)
+
+	path := fmt.Sprintf("%s/%s", endpointBGPConnection, connectionID)
+	_, _, err := c.doInfraProtectRequest(http.MethodDelete, path, accountID, nil, DeleteBGPConnection, fmt.Sprintf("deleting BGP connection %s", connectionID))
+	return err
+}
+
+func parseBGPConnectionResponse(responseBody []byte, action string) (*BGPConnection, error) {
+	var bgpConnectionResponse BGPConnectionResponse
+	err := decodeJSONResponse(fmt.Sprintf("%s/%s", endpointInfraProtect, endpointBGPConnection), responseBody, &bgpConnectionResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %s JSON response: %s\nresponse: %s", action, err, string(responseBody))
+	}
+
+	if len(bgpConnectionResponse.Data) == 0 {
+		return nil, fmt.Errorf("Error parsing %s JSON response: no BGP connection returned\nresponse: %s", action, string(responseBody))
+	}
+
+	return &bgpConnectionResponse.Data[0], nil
+}
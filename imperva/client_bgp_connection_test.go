@@ -0,0 +1,157 @@
+package imperva
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////
+// AddBGPConnection Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientAddBGPConnectionBadConnection(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+
+	connection, err := client.AddBGPConnection(0, &BGPConnection{Name: "router"})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error from Incapsula service when adding BGP connection") {
+		t.Errorf("Should have received a client error, got: %s", err)
+	}
+	if connection != nil {
+		t.Errorf("Should have received a nil BGP connection instance")
+	}
+}
+
+func TestClientAddBGPConnectionBadJSON(t *testing.T) {
+	endpoint := fmt.Sprintf("/%s/%s?caid=42", endpointInfraProtect, endpointBGPConnection)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		rw.Write([]byte(`{`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	connection, err := client.AddBGPConnection(42, &BGPConnection{Name: "router"})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error parsing add BGP connection JSON response") {
+		t.Errorf("Should have received a JSON parse error, got: %s", err)
+	}
+	if connection != nil {
+		t.Errorf("Should have received a nil BGP connection instance")
+	}
+}
+
+func TestClientAddBGPConnectionValid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		if !strings.Contains(string(body), `"md5Password":"secret"`) {
+			t.Errorf("Should have sent the MD5 password. Got: %s", string(body))
+		}
+		rw.Write([]byte(`{"data":[{"id":"123","name":"router","peerAsn":64512,"peerIp":"192.0.2.1","tunnelId":"77","sessionState":"IDLE"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	md5Password := "secret"
+	connection, err := client.AddBGPConnection(0, &BGPConnection{Name: "router", PeerASN: 64512, PeerIP: "192.0.2.1", MD5Password: &md5Password, TunnelID: "77"})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if connection == nil || connection.ID != "123" || connection.SessionState != "IDLE" || connection.MD5Password != nil {
+		t.Errorf("Unexpected BGP connection: %+v", connection)
+	}
+}
+
+func TestClientUpdateBGPConnectionMD5Password(t *testing.T) {
+	path := fmt.Sprintf("/%s/%s/123", endpointInfraProtect, endpointBGPConnection)
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodPut, path, mockJSON(`{"data":[{"id":"123","name":"router"}]}`))
+	client := api.client()
+
+	// The password is kept when not set, and removed when empty
+	md5Password := ""
+	for _, connection := range []*BGPConnection{{Name: "router"}, {Name: "router", MD5Password: &md5Password}} {
+		_, err := client.UpdateBGPConnection(0, "123", connection)
+		if err != nil {
+			t.Fatalf("Should not have received an error, got: %s", err)
+		}
+	}
+
+	requests := api.requestsTo(http.MethodPut, path)
+	if strings.Contains(requests[0].Body, "md5Password") {
+		t.Errorf("Should not have sent the MD5 password, got: %s", requests[0].Body)
+	}
+	if !strings.Contains(requests[1].Body, `"md5Password":""`) {
+		t.Errorf("Should have removed the MD5 password, got: %s", requests[1].Body)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// GetBGPConnection Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetBGPConnectionNotFound(t *testing.T) {
+	endpoint := fmt.Sprintf("/%s/%s/123", endpointInfraProtect, endpointBGPConnection)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		rw.WriteHeader(404)
+		rw.Write([]byte(`{"errors":[{"status":"404","detail":"Connection not found"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	connection, statusCode, err := client.GetBGPConnection(0, "123")
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error status code 404 from Incapsula service when reading BGP connection 123") {
+		t.Errorf("Should have received a not found error, got: %s", err)
+	}
+	if statusCode != 404 {
+		t.Errorf("Should have received a 404 status code, got: %d", statusCode)
+	}
+	if connection != nil {
+		t.Errorf("Should have received a nil BGP connection instance")
+	}
+}
+
+func TestClientGetBGPConnectionValid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"data":[{"id":"123","name":"router","peerAsn":64512,"peerIp":"192.0.2.1","sessionState":"ESTABLISHED"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	connection, statusCode, err := client.GetBGPConnection(0, "123")
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if statusCode != 200 {
+		t.Errorf("Should have received a 200 status code, got: %d", statusCode)
+	}
+	if connection == nil || connection.PeerASN != 64512 || connection.SessionState != "ESTABLISHED" {
+		t.Errorf("Unexpected BGP connection: %+v", connection)
+	}
+}
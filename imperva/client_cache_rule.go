@@ -1,4 +1,4 @@
-package incapsula
+package imperva
 
 import (
 	"encoding/json"
@@ -9,6 +9,9 @@ import (
 	"strings"
 )
 
+// endpointCacheRule names the cache rules endpoint of the v2 API in errors, its URLs include the site ID
+const endpointCacheRule = "sites/{site_id}/settings/cache/rules"
+
 // CacheRule is a struct that encompasses all the properties of a CacheRule
 type CacheRule struct {
 	Name                 string `json:"name"`
@@ -55,12 +58,12 @@ func (c *Client) AddCacheRule(siteID string, rule *CacheRule) (*CacheRuleWithID,
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Error status code %d from Incapsula service when adding Cache Rule for Site ID %s: %s", resp.StatusCode, siteID, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when adding Cache Rule for Site ID %s: %s", resp.StatusCode, siteID, string(responseBody))
 	}
 
 	// Parse the JSON
 	var cacheRuleWithID CacheRuleWithID
-	err = json.Unmarshal([]byte(responseBody), &cacheRuleWithID)
+	err = decodeJSONResponse(endpointCacheRule, responseBody, &cacheRuleWithID)
 	if err != nil || !strings.Contains(string(responseBody), "\"rule_id\":") {
 		return nil, fmt.Errorf("Error parsing Cache Rule JSON response for Site ID %s: %s\nresponse: %s", siteID, err, string(responseBody))
 	}
@@ -88,12 +91,12 @@ func (c *Client) ReadCacheRule(siteID string, ruleID int) (*CacheRuleWithID, int
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return nil, resp.StatusCode, fmt.Errorf("Error status code %d from Incapsula service when reading Cache Rule %d for Site ID %s: %s", resp.StatusCode, ruleID, siteID, string(responseBody))
+		return nil, resp.StatusCode, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when reading Cache Rule %d for Site ID %s: %s", resp.StatusCode, ruleID, siteID, string(responseBody))
 	}
 
 	// Parse the JSON
 	var cacheRuleWithID CacheRuleWithID
-	err = json.Unmarshal([]byte(responseBody), &cacheRuleWithID)
+	err = decodeJSONResponse(endpointCacheRule, responseBody, &cacheRuleWithID)
 	if err != nil {
 		return nil, resp.StatusCode, fmt.Errorf("Error parsing Cache Rule %d JSON response for Site ID %s: %s\nresponse: %s", ruleID, siteID, err, string(responseBody))
 	}
@@ -126,12 +129,12 @@ func (c *Client) UpdateCacheRule(siteID string, ruleID int, rule *CacheRule) err
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return fmt.Errorf("Error status code %d from Incapsula service when updating Cache Rule %d for Site ID %s: %s", resp.StatusCode, ruleID, siteID, string(responseBody))
+		return NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when updating Cache Rule %d for Site ID %s: %s", resp.StatusCode, ruleID, siteID, string(responseBody))
 	}
 
 	// Parse the JSON
 	var cacheRuleWithID CacheRuleWithID
-	err = json.Unmarshal([]byte(responseBody), &cacheRuleWithID)
+	err = decodeJSONResponse(endpointCacheRule, responseBody, &cacheRuleWithID)
 	if err != nil {
 		return fmt.Errorf("Error parsing Cache Rule %d JSON response for Site ID %s: %s\nresponse: %s", ruleID, siteID, err, string(responseBody))
 	}
@@ -169,18 +172,18 @@ func (c *Client) DeleteCacheRule(siteID string, ruleID int) error {
 	// Check the response code
 	// Unfortunately, this API endpoint is not RESTful and we return 200's back for failures (instead of 40X - joy)
 	if resp.StatusCode != 200 {
-		return fmt.Errorf("Error status code %d from Incapsula service when deleting Cache Rule %d for Site ID %s: %s", resp.StatusCode, ruleID, siteID, string(responseBody))
+		return NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when deleting Cache Rule %d for Site ID %s: %s", resp.StatusCode, ruleID, siteID, string(responseBody))
 	}
 
 	// Parse the JSON
 	var deleteCacheRuleResponse DeleteCacheRuleResponse
-	err = json.Unmarshal([]byte(responseBody), &deleteCacheRuleResponse)
+	err = decodeJSONResponse(endpointCacheRule, responseBody, &deleteCacheRuleResponse)
 	if err != nil {
 		return fmt.Errorf("Error parsing Delete Cache Rule %d JSON response for Site ID %s: %s\nresponse: %s", ruleID, siteID, err, string(responseBody))
 	}
 
 	if deleteCacheRuleResponse.Res != 0 {
-		return fmt.Errorf("Error deleting Cache Rule %d JSON response for Site ID %s: %s\nresponse: %s", ruleID, siteID, err, string(responseBody))
+		return NewAPIError(resp.StatusCode, responseBody, "Error deleting Cache Rule %d JSON response for Site ID %s: %s\nresponse: %s", ruleID, siteID, err, string(responseBody))
 	}
 
 	return nil
@@ -1,7 +1,6 @@
-package incapsula
+package imperva
 
 import (
-	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -38,7 +37,16 @@ type SSL struct {
 }
 
 type CustomCertificate struct {
-	InputHash string `json:"inputHash"`
+	Active                bool     `json:"active"`
+	InputHash             string   `json:"inputHash"`
+	ExpirationDate        int64    `json:"expirationDate"`
+	Subject               string   `json:"subject"`
+	San                   []string `json:"san"`
+	Fingerprint           string   `json:"fingerprint"`
+	RevocationError       bool     `json:"revocationError"`
+	ValidityError         bool     `json:"validityError"`
+	ChainError            bool     `json:"chainError"`
+	HostnameMismatchError bool     `json:"hostnameMismatchError"`
 }
 
 // AddCertificate adds a custom SSL certificate to a site in Incapsula
@@ -75,14 +83,14 @@ func (c *Client) AddCertificate(siteID, certificate, privateKey, passphrase, inp
 
 	// Parse the JSON
 	var certificateAddResponse CertificateAddResponse
-	err = json.Unmarshal([]byte(responseBody), &certificateAddResponse)
+	err = decodeJSONResponse(endpointCertificateAdd, responseBody, &certificateAddResponse)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing add custom certificate JSON response for site_id %s: %s\nresponse: %s", siteID, err, string(responseBody))
 	}
 
 	// Look at the response status code from Incapsula
 	if certificateAddResponse.Res != 0 {
-		return nil, fmt.Errorf("Error from Incapsula service when adding custom certificate for site_id %s: %s", siteID, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when adding custom certificate for site_id %s: %s", siteID, string(responseBody))
 	}
 
 	return &certificateAddResponse, nil
@@ -109,14 +117,14 @@ func (c *Client) ListCertificates(siteID string) (*CertificateListResponse, erro
 
 	// Parse the JSON
 	var certificateListResponse CertificateListResponse
-	err = json.Unmarshal([]byte(responseBody), &certificateListResponse)
+	err = decodeJSONResponse(endpointCertificateList, responseBody, &certificateListResponse)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing certificates list JSON response for site_id: %s %s\nresponse: %s", siteID, err, string(responseBody))
 	}
 
 	// Look at the response status code from Incapsula
 	if certificateListResponse.Res != 0 {
-		return &certificateListResponse, fmt.Errorf("Error from Incapsula service when getting custom certificates list for site_id %s: %s", siteID, string(responseBody))
+		return &certificateListResponse, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when getting custom certificates list for site_id %s: %s", siteID, string(responseBody))
 	}
 
 	return &certificateListResponse, nil
@@ -157,14 +165,14 @@ func (c *Client) EditCertificate(siteID, certificate, privateKey, passphrase, in
 
 	// Parse the JSON
 	var certificateEditResponse CertificateEditResponse
-	err = json.Unmarshal([]byte(responseBody), &certificateEditResponse)
+	err = decodeJSONResponse(endpointCertificateEdit, responseBody, &certificateEditResponse)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing edit custom certificarte JSON response for site_id: %s: %s)", siteID, err)
 	}
 
 	// Look at the response status code from Incapsula
 	if certificateEditResponse.Res != 0 {
-		return nil, fmt.Errorf("Error from Incapsula service when editing custom certificarte for site_id %s: %s", siteID, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when editing custom certificarte for site_id %s: %s", siteID, string(responseBody))
 	}
 
 	return &certificateEditResponse, nil
@@ -198,7 +206,7 @@ func (c *Client) DeleteCertificate(siteID string) error {
 
 	// Parse the JSON
 	var certificateDeleteResponse CertificateDeleteResponse
-	err = json.Unmarshal([]byte(responseBody), &certificateDeleteResponse)
+	err = decodeJSONResponse(endpointCertificateDelete, responseBody, &certificateDeleteResponse)
 	if err != nil {
 		return fmt.Errorf("Error deleting custom certificate for site_id: %s %s", siteID, err)
 	}
@@ -218,5 +226,5 @@ func (c *Client) DeleteCertificate(siteID string) error {
 		return nil
 	}
 
-	return fmt.Errorf("Error from Incapsula service when deleting custom certificate for site_id %s %s", siteID, string(responseBody))
+	return NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when deleting custom certificate for site_id %s %s", siteID, string(responseBody))
 }
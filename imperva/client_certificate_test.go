@@ -1,4 +1,4 @@
-package incapsula
+package imperva
 
 import (
 	"fmt"
@@ -184,6 +184,39 @@ func TestClientListCertificatesInvalidRequest(t *testing.T) {
 	}
 }
 
+func TestClientListCertificatesValidSite(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[DEBUG] Running test client_certificate_test.TestClientListCertificatesValidSite")
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointCertificateList) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointCertificateList, req.URL.String())
+		}
+		rw.Write([]byte(`{"res":0,"ssl":{"custom_certificate":{"active":true,"inputHash":"abc","expirationDate":1893456000000,"subject":"CN=www.example.com","san":["www.example.com","example.com"],"fingerprint":"AB:CD:EF","revocationError":false,"validityError":false,"chainError":false,"hostnameMismatchError":false}}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	siteID := "1234"
+	listCertificatesResponse, err := client.ListCertificates(siteID)
+	if err != nil {
+		t.Errorf("Should not have received an error")
+	}
+	if listCertificatesResponse == nil {
+		t.Fatalf("Should not have received a nil listCertificatesResponse instance")
+	}
+	customCertificate := listCertificatesResponse.SSL.CustomCertificate
+	if !customCertificate.Active || customCertificate.InputHash != "abc" {
+		t.Errorf("Should have received an active custom certificate with input hash abc, got: %+v", customCertificate)
+	}
+	if customCertificate.ExpirationDate != 1893456000000 {
+		t.Errorf("Should have received expiration date 1893456000000, got: %d", customCertificate.ExpirationDate)
+	}
+	if len(customCertificate.San) != 2 || customCertificate.Fingerprint != "AB:CD:EF" {
+		t.Errorf("Should have received 2 SANs and fingerprint AB:CD:EF, got: %+v", customCertificate)
+	}
+}
+
 ////////////////////////////////////////////////////////////////
 // EditCertificate Tests
 ////////////////////////////////////////////////////////////////
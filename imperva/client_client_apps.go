@@ -0,0 +1,51 @@
+package imperva
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/url"
+)
+
+const endpointClientApps = "clapps"
+
+// ClientAppsResponse contains the client applications (and their types) known to Incapsula, keyed by ID
+type ClientAppsResponse struct {
+	ClientApps     map[string]string `json:"clientApps"`
+	ClientAppTypes map[string]string `json:"clientAppTypes"`
+	Res            int               `json:"res"`
+	ResMessage     string            `json:"res_message"`
+}
+
+// GetClientApps gets the list of client applications (e.g. Googlebot) that can be used in security rules and exceptions
+func (c *Client) GetClientApps() (*ClientAppsResponse, error) {
+	log.Printf("[INFO] Getting Incapsula client applications\n")
+
+	reqURL := fmt.Sprintf("%s/%s", c.integrationBaseURL(), endpointClientApps)
+	resp, err := c.PostFormWithHeaders(reqURL, url.Values{}, ReadClientApps)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting client applications: %s", err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula client applications JSON response: %s\n", string(responseBody))
+
+	// Parse the JSON
+	var clientAppsResponse ClientAppsResponse
+	err = json.Unmarshal([]byte(responseBody), &clientAppsResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing client applications JSON response: %s", err)
+	}
+
+	// Look at the response status code from Incapsula
+	if clientAppsResponse.Res != 0 {
+		return &clientAppsResponse, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when getting client applications: %s", string(responseBody))
+	}
+
+	return &clientAppsResponse, nil
+}
@@ -0,0 +1,100 @@
+package imperva
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////
+// GetClientApps Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetClientAppsBadConnection(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+	clientAppsResponse, err := client.GetClientApps()
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error getting client applications") {
+		t.Errorf("Should have received an client error, got: %s", err)
+	}
+	if clientAppsResponse != nil {
+		t.Errorf("Should have received a nil clientAppsResponse instance")
+	}
+}
+
+func TestClientGetClientAppsBadJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointClientApps) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointClientApps, req.URL.String())
+		}
+		rw.Write([]byte(`{`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	clientAppsResponse, err := client.GetClientApps()
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error parsing client applications JSON response") {
+		t.Errorf("Should have received a JSON parse error, got: %s", err)
+	}
+	if clientAppsResponse != nil {
+		t.Errorf("Should have received a nil clientAppsResponse instance")
+	}
+}
+
+func TestClientGetClientAppsInvalidResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"res":1,"res_message":"Unexpected error"}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	clientAppsResponse, err := client.GetClientApps()
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error from Incapsula service when getting client applications") {
+		t.Errorf("Should have received a bad response error, got: %s", err)
+	}
+	if clientAppsResponse == nil {
+		t.Errorf("Should have received a clientAppsResponse instance")
+	}
+}
+
+func TestClientGetClientAppsValidResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"clientApps":{"6":"Googlebot","7":"Bingbot"},"clientAppTypes":{"1":"Search bot"},"res":0,"res_message":"OK"}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	clientAppsResponse, err := client.GetClientApps()
+	if err != nil {
+		t.Errorf("Should not have received an error")
+	}
+	if clientAppsResponse == nil {
+		t.Fatalf("Should not have received a nil clientAppsResponse instance")
+	}
+	if clientAppsResponse.ClientApps["6"] != "Googlebot" {
+		t.Errorf("Client application name doesn't match")
+	}
+}
+
+func TestClientIntegrationBaseURL(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "https://my.incapsula.com/api/prov/v1"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	if client.integrationBaseURL() != "https://my.incapsula.com/api/integration/v1" {
+		t.Errorf("Integration base URL doesn't match, got: %s", client.integrationBaseURL())
+	}
+}
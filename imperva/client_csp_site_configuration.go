@@ -1,4 +1,4 @@
-package incapsula
+package imperva
 
 import (
 	"encoding/json"
@@ -66,7 +66,7 @@ func (c *Client) GetCSPSite(accountID, siteID int) (*CSPSiteConfig, error) {
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Error status code %d from CSP API when reading site config for ID %d: %s", resp.StatusCode, siteID, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from CSP API when reading site config for ID %d: %s", resp.StatusCode, siteID, string(responseBody))
 	}
 
 	// Parse the JSON
@@ -133,7 +133,7 @@ func (c *Client) UpdateCSPSite(accountID, siteID int, config *CSPSiteConfig) (*C
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Error status code %d from CSP API when updating site config for ID %d: %s", resp.StatusCode, siteID, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from CSP API when updating site config for ID %d: %s", resp.StatusCode, siteID, string(responseBody))
 	}
 
 	// Parse the JSON
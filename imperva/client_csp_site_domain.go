@@ -1,4 +1,4 @@
-package incapsula
+package imperva
 
 import (
 	"encoding/base64"
@@ -60,7 +60,7 @@ func (c *Client) getCSPDomainAPI(accountID, siteID int, domain string, APIPath s
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return fmt.Errorf("Error status code %d from CSP API when getting domain %s for domain %s from site %d: %s\n",
+		return NewAPIError(resp.StatusCode, responseBody, "Error status code %d from CSP API when getting domain %s for domain %s from site %d: %s\n",
 			resp.StatusCode, APIPath, domain, siteID, string(responseBody))
 	}
 
@@ -74,7 +74,7 @@ func (c *Client) getCSPDomainAPI(accountID, siteID int, domain string, APIPath s
 	return nil
 }
 
-func (c *Client) getCSPDomainStatus(accountID, siteID int, domain string) (*CSPDomainStatus, error) {
+func (c *Client) GetCSPDomainStatus(accountID, siteID int, domain string) (*CSPDomainStatus, error) {
 	ret := &CSPDomainStatus{}
 	if err := c.getCSPDomainAPI(accountID, siteID, domain, "status", ret); err != nil {
 		return nil, err
@@ -82,7 +82,7 @@ func (c *Client) getCSPDomainStatus(accountID, siteID int, domain string) (*CSPD
 	return ret, nil
 }
 
-func (c *Client) updateCSPDomainStatus(accountID, siteID int, domain string, status *CSPDomainStatus) (*CSPDomainStatus, error) {
+func (c *Client) UpdateCSPDomainStatus(accountID, siteID int, domain string, status *CSPDomainStatus) (*CSPDomainStatus, error) {
 	log.Printf("[INFO] Updating CSP domain status for domain %s from site ID: %d to: %v\n", domain, siteID, status)
 
 	domainRef := base64.RawURLEncoding.EncodeToString([]byte(domain))
@@ -117,7 +117,7 @@ func (c *Client) updateCSPDomainStatus(accountID, siteID int, domain string, sta
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Error status code %d from CSP API when updating domain status for domain %s from site %d: %s\n",
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from CSP API when updating domain status for domain %s from site %d: %s\n",
 			resp.StatusCode, domain, siteID, string(responseBody))
 	}
 
@@ -132,7 +132,7 @@ func (c *Client) updateCSPDomainStatus(accountID, siteID int, domain string, sta
 	return st, nil
 }
 
-func (c *Client) getCSPDomainNotes(accountID, siteID int, domain string) ([]CSPDomainNote, error) {
+func (c *Client) GetCSPDomainNotes(accountID, siteID int, domain string) ([]CSPDomainNote, error) {
 	var ret []CSPDomainNote
 	if err := c.getCSPDomainAPI(accountID, siteID, domain, "notes", &ret); err != nil {
 		return nil, err
@@ -140,7 +140,7 @@ func (c *Client) getCSPDomainNotes(accountID, siteID int, domain string) ([]CSPD
 	return ret, nil
 }
 
-func (c *Client) addCSPDomainNote(accountID, siteID int, domain string, note string) error {
+func (c *Client) AddCSPDomainNote(accountID, siteID int, domain string, note string) error {
 	log.Printf("[INFO] Getting CSP domain notes for domain %s from site ID: %d\n", domain, siteID)
 
 	domainRef := base64.RawURLEncoding.EncodeToString([]byte(domain))
@@ -171,7 +171,7 @@ func (c *Client) addCSPDomainNote(accountID, siteID int, domain string, note str
 
 	// Check the response code
 	if resp.StatusCode != 201 {
-		return fmt.Errorf("Error status code %d from CSP API when getting domain notes for domain %s from site %d: %s\n",
+		return NewAPIError(resp.StatusCode, responseBody, "Error status code %d from CSP API when getting domain notes for domain %s from site %d: %s\n",
 			resp.StatusCode, domain, siteID, string(responseBody))
 	}
 
@@ -186,7 +186,7 @@ func (c *Client) addCSPDomainNote(accountID, siteID int, domain string, note str
 	return nil
 }
 
-func (c *Client) deleteCSPDomainNotes(accountID, siteID int, domain string) error {
+func (c *Client) DeleteCSPDomainNotes(accountID, siteID int, domain string) error {
 	log.Printf("[INFO] Deleting CSP domain notes for domain %s from site ID: %d\n", domain, siteID)
 
 	domainRef := base64.RawURLEncoding.EncodeToString([]byte(domain))
@@ -211,14 +211,14 @@ func (c *Client) deleteCSPDomainNotes(accountID, siteID int, domain string) erro
 
 	// Check the response code
 	if resp.StatusCode != 204 {
-		return fmt.Errorf("Error status code %d from CSP API when getting domain notes for domain %s from site %d\n",
+		return NewAPIError(resp.StatusCode, nil, "Error status code %d from CSP API when getting domain notes for domain %s from site %d\n",
 			resp.StatusCode, domain, siteID)
 	}
 
 	return nil
 }
 
-func (c *Client) getCSPPreApprovedDomain(accountID, siteID int, domain string) (*CSPPreApprovedDomain, error) {
+func (c *Client) GetCSPPreApprovedDomain(accountID, siteID int, domain string) (*CSPPreApprovedDomain, error) {
 	log.Printf("[INFO] Getting CSP pre-approved domain %s from site ID: %d\n", domain, siteID)
 
 	domainRef := base64.RawURLEncoding.EncodeToString([]byte(domain))
@@ -248,7 +248,7 @@ func (c *Client) getCSPPreApprovedDomain(accountID, siteID int, domain string) (
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Error status code %d from CSP API when getting pre-approved domain %s for site %d: %s\n",
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from CSP API when getting pre-approved domain %s for site %d: %s\n",
 			resp.StatusCode, domain, siteID, string(responseBody))
 	}
 
@@ -263,7 +263,7 @@ func (c *Client) getCSPPreApprovedDomain(accountID, siteID int, domain string) (
 	return &preApprovedDomain, nil
 }
 
-func (c *Client) updateCSPPreApprovedDomain(accountID, siteID int, dom *CSPPreApprovedDomain) (*CSPPreApprovedDomain, error) {
+func (c *Client) UpdateCSPPreApprovedDomain(accountID, siteID int, dom *CSPPreApprovedDomain) (*CSPPreApprovedDomain, error) {
 	log.Printf("[INFO] Updating CSP pre-approved domain for site ID: %d , domain: %v", siteID, dom)
 
 	domJSON, err := json.Marshal(dom)
@@ -294,7 +294,7 @@ func (c *Client) updateCSPPreApprovedDomain(accountID, siteID int, dom *CSPPreAp
 
 	// Check the response code
 	if resp.StatusCode != 201 {
-		return nil, fmt.Errorf("Error status code %d from CSP API when updating pre-approved domain for site %d: %s\n",
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from CSP API when updating pre-approved domain for site %d: %s\n",
 			resp.StatusCode, siteID, string(responseBody))
 	}
 
@@ -309,7 +309,7 @@ func (c *Client) updateCSPPreApprovedDomain(accountID, siteID int, dom *CSPPreAp
 	return &updatedDom, nil
 }
 
-func (c *Client) deleteCSPPreApprovedDomains(accountID, siteID int, domainRef string) error {
+func (c *Client) DeleteCSPPreApprovedDomains(accountID, siteID int, domainRef string) error {
 	log.Printf("[INFO] Deleting CSP pre-approved domain %s for site ID: %d\n", domainRef, siteID)
 
 	var resp *http.Response
@@ -334,7 +334,7 @@ func (c *Client) deleteCSPPreApprovedDomains(accountID, siteID int, domainRef st
 
 	// Check the response code - no content for DELETE
 	if resp.StatusCode != 204 {
-		return fmt.Errorf("Error status code %d from CSP API when deleting pre-approved domain %s for site ID %d\n",
+		return NewAPIError(resp.StatusCode, nil, "Error status code %d from CSP API when deleting pre-approved domain %s for site ID %d\n",
 			resp.StatusCode, domainRef, siteID)
 	}
 	log.Printf("[DEBUG] CSP API Delete Pre-Approved Domain %s was successful\n", domainRef)
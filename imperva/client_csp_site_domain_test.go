@@ -1,4 +1,4 @@
-package incapsula
+package imperva
 
 import (
 	"encoding/base64"
@@ -16,7 +16,7 @@ func TestCspSiteDomainBadConnection(t *testing.T) {
 	siteID := 42
 	accountID := 55
 
-	updatedDom, err := client.updateCSPPreApprovedDomain(accountID, siteID, &CSPPreApprovedDomain{})
+	updatedDom, err := client.UpdateCSPPreApprovedDomain(accountID, siteID, &CSPPreApprovedDomain{})
 	if err == nil {
 		t.Errorf("Should have received an error")
 	}
@@ -27,7 +27,7 @@ func TestCspSiteDomainBadConnection(t *testing.T) {
 		t.Errorf("Should have received a nil response")
 	}
 
-	err = client.deleteCSPPreApprovedDomains(accountID, siteID, "ref")
+	err = client.DeleteCSPPreApprovedDomains(accountID, siteID, "ref")
 	if err == nil {
 		t.Errorf("Should have received an error")
 	}
@@ -52,7 +52,7 @@ func TestCspSiteDomainErrorResponse(t *testing.T) {
 	config := &Config{APIID: apiID, APIKey: apiKey, BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLAPI: server.URL}
 	client := &Client{config: config, httpClient: &http.Client{}}
 
-	updatedDom, err := client.updateCSPPreApprovedDomain(accountID, siteID, &CSPPreApprovedDomain{})
+	updatedDom, err := client.UpdateCSPPreApprovedDomain(accountID, siteID, &CSPPreApprovedDomain{})
 	if err == nil {
 		t.Errorf("Should have received an error")
 	}
@@ -63,7 +63,7 @@ func TestCspSiteDomainErrorResponse(t *testing.T) {
 		t.Errorf("Should have received a nil response")
 	}
 
-	err = client.deleteCSPPreApprovedDomains(accountID, siteID, "ref")
+	err = client.DeleteCSPPreApprovedDomains(accountID, siteID, "ref")
 	if err == nil {
 		t.Errorf("Should have received an error")
 	}
@@ -92,7 +92,7 @@ func TestCspSiteDomainInvalidResponse(t *testing.T) {
 	config := &Config{APIID: apiID, APIKey: apiKey, BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLAPI: server.URL}
 	client := &Client{config: config, httpClient: &http.Client{}}
 
-	updatedDom, err := client.updateCSPPreApprovedDomain(accountID, siteID, &CSPPreApprovedDomain{})
+	updatedDom, err := client.UpdateCSPPreApprovedDomain(accountID, siteID, &CSPPreApprovedDomain{})
 	if err == nil {
 		t.Errorf("Should have received an error")
 	}
@@ -128,7 +128,7 @@ func TestCSPSiteDomainPreApprovedGetResponse(t *testing.T) {
 	config := &Config{APIID: apiID, APIKey: apiKey, BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLAPI: server.URL}
 	client := &Client{config: config, httpClient: &http.Client{}}
 
-	domain, err := client.getCSPPreApprovedDomain(accountID, siteID, "domain.com")
+	domain, err := client.GetCSPPreApprovedDomain(accountID, siteID, "domain.com")
 	if err != nil {
 		t.Errorf("Should have not received an error")
 	}
@@ -167,7 +167,7 @@ func TestCSPSiteDomainPreApprovedUpdateResponse(t *testing.T) {
 	config := &Config{APIID: apiID, APIKey: apiKey, BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLAPI: server.URL}
 	client := &Client{config: config, httpClient: &http.Client{}}
 
-	domain, err := client.updateCSPPreApprovedDomain(accountID, siteID, &CSPPreApprovedDomain{})
+	domain, err := client.UpdateCSPPreApprovedDomain(accountID, siteID, &CSPPreApprovedDomain{})
 	if err != nil {
 		t.Errorf("Should have not received an error")
 	}
@@ -210,7 +210,7 @@ func TestCSPSiteDomainNotesResponse(t *testing.T) {
 	config := &Config{APIID: apiID, APIKey: apiKey, BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLAPI: server.URL}
 	client := &Client{config: config, httpClient: &http.Client{}}
 
-	notes, err := client.getCSPDomainNotes(accountID, siteID, domain)
+	notes, err := client.GetCSPDomainNotes(accountID, siteID, domain)
 	if err != nil {
 		t.Errorf("Should have not received an error")
 	}
@@ -251,7 +251,7 @@ func TestCSPSiteDomainStatusResponse(t *testing.T) {
 	config := &Config{APIID: apiID, APIKey: apiKey, BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLAPI: server.URL}
 	client := &Client{config: config, httpClient: &http.Client{}}
 
-	notes, err := client.getCSPDomainStatus(accountID, siteID, domain)
+	notes, err := client.GetCSPDomainStatus(accountID, siteID, domain)
 	if err != nil {
 		t.Errorf("Should have not received an error")
 	}
@@ -282,7 +282,7 @@ func TestCSPSiteDomainStatusEmptyResponse(t *testing.T) {
 	config := &Config{APIID: apiID, APIKey: apiKey, BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLAPI: server.URL}
 	client := &Client{config: config, httpClient: &http.Client{}}
 
-	notes, err := client.getCSPDomainStatus(accountID, siteID, domain)
+	notes, err := client.GetCSPDomainStatus(accountID, siteID, domain)
 	if err != nil {
 		t.Errorf("Should have not received an error")
 	}
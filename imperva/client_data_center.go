@@ -1,7 +1,6 @@
-package incapsula
+package imperva
 
 import (
-	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -72,7 +71,7 @@ func (c *Client) AddDataCenter(siteID, name, serverAddress, isContent, isEnabled
 
 	// Parse the JSON
 	var dataCenterAddResponse DataCenterAddResponse
-	err = json.Unmarshal([]byte(responseBody), &dataCenterAddResponse)
+	err = decodeJSONResponse(endpointDataCenterAdd, responseBody, &dataCenterAddResponse)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing add data center JSON response for siteID %s: %s\nresponse: %s", siteID, err, string(responseBody))
 	}
@@ -89,7 +88,7 @@ func (c *Client) AddDataCenter(siteID, name, serverAddress, isContent, isEnabled
 
 	// Look at the response status code from Incapsula
 	if resString != "0" {
-		return nil, fmt.Errorf("Error from Incapsula service when adding data center for siteID %s: %s", siteID, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when adding data center for siteID %s: %s", siteID, string(responseBody))
 	}
 
 	return &dataCenterAddResponse, nil
@@ -116,7 +115,7 @@ func (c *Client) ListDataCenters(siteID string) (*DataCenterListResponse, error)
 
 	// Parse the JSON
 	var dataCenterListResponse DataCenterListResponse
-	err = json.Unmarshal([]byte(responseBody), &dataCenterListResponse)
+	err = decodeJSONResponse(endpointDataCenterList, responseBody, &dataCenterListResponse)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing data centers list JSON response for siteID: %s %s\nresponse: %s", siteID, err, string(responseBody))
 	}
@@ -133,7 +132,7 @@ func (c *Client) ListDataCenters(siteID string) (*DataCenterListResponse, error)
 
 	// Look at the response status code from Incapsula
 	if resString != "0" {
-		return &dataCenterListResponse, fmt.Errorf("Error from Incapsula service when getting data centers list (site_id: %s): %s", siteID, string(responseBody))
+		return &dataCenterListResponse, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when getting data centers list (site_id: %s): %s", siteID, string(responseBody))
 	}
 
 	return &dataCenterListResponse, nil
@@ -179,7 +178,7 @@ func (c *Client) EditDataCenter(dcID, name, isContent, isEnabled string) (*DataC
 
 	// Parse the JSON
 	var dataCenterEditResponse DataCenterEditResponse
-	err = json.Unmarshal([]byte(responseBody), &dataCenterEditResponse)
+	err = decodeJSONResponse(endpointDataCenterEdit, responseBody, &dataCenterEditResponse)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing edit data center JSON response (%s): %s", dcID, err)
 	}
@@ -196,7 +195,7 @@ func (c *Client) EditDataCenter(dcID, name, isContent, isEnabled string) (*DataC
 
 	// Look at the response status code from Incapsula
 	if resString != "0" {
-		return nil, fmt.Errorf("Error from Incapsula service when editing data center (%s): %s", dcID, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when editing data center (%s): %s", dcID, string(responseBody))
 	}
 
 	return &dataCenterEditResponse, nil
@@ -234,7 +233,7 @@ func (c *Client) DeleteDataCenter(dcID string) error {
 
 	// Parse the JSON
 	var dataCenterDeleteResponse DataCenterDeleteResponse
-	err = json.Unmarshal([]byte(responseBody), &dataCenterDeleteResponse)
+	err = decodeJSONResponse(endpointDataCenterDelete, responseBody, &dataCenterDeleteResponse)
 	if err != nil {
 		return fmt.Errorf("Error parsing delete data center JSON response (%s): %s", dcID, err)
 	}
@@ -254,5 +253,5 @@ func (c *Client) DeleteDataCenter(dcID string) error {
 		return nil
 	}
 
-	return fmt.Errorf("Error from Incapsula service when deleting data center (%s): %s", dcID, string(responseBody))
+	return NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when deleting data center (%s): %s", dcID, string(responseBody))
 }
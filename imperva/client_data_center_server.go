@@ -1,7 +1,6 @@
-package incapsula
+package imperva
 
 import (
-	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -57,7 +56,7 @@ func (c *Client) AddDataCenterServer(dcID, serverAddress, isStandby string, isEn
 
 	// Parse the JSON
 	var dataCenterServerAddResponse DataCenterServerAddResponse
-	err = json.Unmarshal([]byte(responseBody), &dataCenterServerAddResponse)
+	err = decodeJSONResponse(endpointDataCenterServerAdd, responseBody, &dataCenterServerAddResponse)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing add data center server JSON response for dcID %s: %s\nresponse: %s", dcID, err, string(responseBody))
 	}
@@ -74,7 +73,7 @@ func (c *Client) AddDataCenterServer(dcID, serverAddress, isStandby string, isEn
 
 	// Look at the response status code from Incapsula
 	if resString != "0" {
-		return nil, fmt.Errorf("Error from Incapsula service when adding data center server for dcID %s: %s", dcID, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when adding data center server for dcID %s: %s", dcID, string(responseBody))
 	}
 
 	return &dataCenterServerAddResponse, nil
@@ -106,7 +105,7 @@ func (c *Client) EditDataCenterServer(serverID, serverAddress, isStandby, isEnab
 
 	// Parse the JSON
 	var dataCenterServerEditResponse DataCenterServerEditResponse
-	err = json.Unmarshal([]byte(responseBody), &dataCenterServerEditResponse)
+	err = decodeJSONResponse(endpointDataCenterServerEdit, responseBody, &dataCenterServerEditResponse)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing edit data center server JSON response for serverID %s: %s", serverID, err)
 	}
@@ -123,7 +122,7 @@ func (c *Client) EditDataCenterServer(serverID, serverAddress, isStandby, isEnab
 
 	// Look at the response status code from Incapsula
 	if resString != "0" {
-		return nil, fmt.Errorf("Error from Incapsula service when editing data center server for serverID %s: %s", serverID, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when editing data center server for serverID %s: %s", serverID, string(responseBody))
 	}
 
 	return &dataCenterServerEditResponse, nil
@@ -157,7 +156,7 @@ func (c *Client) DeleteDataCenterServer(serverID string) error {
 
 	// Parse the JSON
 	var dataCenterServerDeleteResponse DataCenterServerDeleteResponse
-	err = json.Unmarshal([]byte(responseBody), &dataCenterServerDeleteResponse)
+	err = decodeJSONResponse(endpointDataCenterServerDelete, responseBody, &dataCenterServerDeleteResponse)
 	if err != nil {
 		return fmt.Errorf("Error parsing delete data center server JSON response (server_id: %s): %s", serverID, err)
 	}
@@ -177,5 +176,5 @@ func (c *Client) DeleteDataCenterServer(serverID string) error {
 		return nil
 	}
 
-	return fmt.Errorf("Error from Incapsula service when deleting data center server (server_id: %s): %s", serverID, string(responseBody))
+	return NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when deleting data center server (server_id: %s): %s", serverID, string(responseBody))
 }
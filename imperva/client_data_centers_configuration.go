@@ -1,4 +1,4 @@
-package incapsula
+package imperva
 
 import (
 	"encoding/json"
@@ -8,6 +8,9 @@ import (
 	"net/http"
 )
 
+// Endpoints (unexported consts)
+const endpointDataCentersConfiguration = "data-centers-configuration"
+
 type OriginServerStruct struct {
 	Address    string `json:"address"`
 	IsEnabled  bool   `json:"isEnabled"`
@@ -68,10 +71,10 @@ func (c *Client) PutDataCentersConfiguration(siteID string, requestDTO DataCente
 
 	baseURLv3 := c.config.BaseURL[:len(c.config.BaseURL)-3] + "/v3"
 	dcsJSON, err := json.Marshal(requestDTO)
-	reqURL := fmt.Sprintf("%s/sites/%s/data-centers-configuration", baseURLv3, siteID)
+	reqURL := fmt.Sprintf("%s/sites/%s/%s", baseURLv3, siteID, endpointDataCentersConfiguration)
 	resp, err := c.DoJsonRequestWithHeaders(http.MethodPut, reqURL, dcsJSON, CreateDataCenterConfiguration)
 	if err != nil {
-		return nil, fmt.Errorf("Error executing update Data Centers configuration request for siteID %s: %s", siteID, err)
+		return nil, fmt.Errorf("Error executing update Data Centers configuration request for siteID %s: %w", siteID, err)
 	}
 
 	// Read the body
@@ -83,7 +86,7 @@ func (c *Client) PutDataCentersConfiguration(siteID string, requestDTO DataCente
 
 	// Parse the JSON
 	var responseDTO DataCentersConfigurationDTO
-	err = json.Unmarshal([]byte(responseBody), &responseDTO)
+	err = decodeJSONResponse(endpointDataCentersConfiguration, responseBody, &responseDTO)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing update Data Centers configuration JSON response for siteID %s: %s\nresponse: %s", siteID, err, string(responseBody))
 	}
@@ -97,7 +100,7 @@ func (c *Client) GetDataCentersConfiguration(siteID string) (*DataCentersConfigu
 
 	// Get request to Incapsula
 	baseURLv3 := c.config.BaseURL[:len(c.config.BaseURL)-3] + "/v3"
-	reqURL := fmt.Sprintf("%s/sites/%s/data-centers-configuration", baseURLv3, siteID)
+	reqURL := fmt.Sprintf("%s/sites/%s/%s", baseURLv3, siteID, endpointDataCentersConfiguration)
 	resp, err := c.DoJsonRequestWithHeaders(http.MethodGet, reqURL, nil, ReadDataCenterConfiguration)
 	if err != nil {
 		return nil, fmt.Errorf("Error executing get Data Centers configuration request for siteID %s: %s", siteID, err)
@@ -112,7 +115,7 @@ func (c *Client) GetDataCentersConfiguration(siteID string) (*DataCentersConfigu
 
 	// Parse the JSON
 	var responseDTO DataCentersConfigurationDTO
-	err = json.Unmarshal([]byte(responseBody), &responseDTO)
+	err = decodeJSONResponse(endpointDataCentersConfiguration, responseBody, &responseDTO)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing data centers list JSON response for siteID: %s %s\nresponse: %s", siteID, err, string(responseBody))
 	}
@@ -1,4 +1,4 @@
-package incapsula
+package imperva
 
 import (
 	"encoding/json"
@@ -49,7 +49,7 @@ func (c *Client) GetDataStorageRegion(siteID string) (*DataStorageRegionResponse
 
 	// Look at the response status code from Incapsula
 	if dataStorageRegionResponse.Res != 0 {
-		return &dataStorageRegionResponse, fmt.Errorf("Error from Incapsula service when getting site data storage region for site id: %s: %s", siteID, string(responseBody))
+		return &dataStorageRegionResponse, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when getting site data storage region for site id: %s: %s", siteID, string(responseBody))
 	}
 
 	return &dataStorageRegionResponse, nil
@@ -86,7 +86,7 @@ func (c *Client) UpdateDataStorageRegion(siteID, region string) (*DataStorageReg
 
 	// Look at the response status code from Incapsula
 	if dataStorageRegionResponse.Res != 0 {
-		return nil, fmt.Errorf("Error from Incapsula service when updating site data storage region for siteID %s: %s", siteID, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when updating site data storage region for siteID %s: %s", siteID, string(responseBody))
 	}
 
 	return &dataStorageRegionResponse, nil
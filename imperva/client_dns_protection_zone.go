@@ -0,0 +1,87 @@
+package imperva
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+)
+
+// Endpoints (unexported consts)
+const endpointDNSProtectionZone = "dns-zones"
+
+// DNSProtectionZone is a DNS zone delegated to the protective DNS of Imperva.
+// Imperva answers queries for the zone and forwards them to the origin name servers.
+type DNSProtectionZone struct {
+	ID                  string   `json:"id,omitempty"`
+	ZoneName            string   `json:"zoneName"`
+	OriginNameServers   []string `json:"originNameServers"`
+	AssignedNameServers []string `json:"assignedNameServers,omitempty"`
+	Status              string   `json:"status,omitempty"`
+}
+
+// DNSProtectionZoneResponse contains the DNS protection zone returned by the API
+type DNSProtectionZoneResponse struct {
+	Data []DNSProtectionZone `json:"data"`
+}
+
+// AddDNSProtectionZone adds a DNS protection zone to an account
+func (c *Client) AddDNSProtectionZone(accountID int, zone *DNSProtectionZone) (*DNSProtectionZone, error) {
+	log.Printf("[INFO] Adding Incapsula DNS protection zone %s for account %d\n", zone.ZoneName, accountID)
+
+	responseBody, _, err := c.doInfraProtectRequest(http.MethodPost, endpointDNSProtectionZone, accountID, zone, CreateDNSProtectionZone, fmt.Sprintf("adding DNS protection zone %s", zone.ZoneName))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseDNSProtectionZoneResponse(responseBody, "add DNS protection zone")
+}
+
+// GetDNSProtectionZone gets a DNS protection zone, along with the status code of the response
+func (c *Client) GetDNSProtectionZone(accountID int, zoneID string) (*DNSProtectionZone, int, error) {
+	log.Printf("[INFO] Getting Incapsula DNS protection zone %s for account %d\n", zoneID, accountID)
+
+	path := fmt.Sprintf("%s/%s", endpointDNSProtectionZone, zoneID)
+	responseBody, statusCode, err := c.doInfraProtectRequest(http.MethodGet, path, accountID, nil, ReadDNSProtectionZone, fmt.Sprintf("reading DNS protection zone %s", zoneID))
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	zone, err := parseDNSProtectionZoneResponse(responseBody, fmt.Sprintf("read DNS protection zone %s", zoneID))
+	return zone, statusCode, err
+}
+
+// UpdateDNSProtectionZone updates the origin name servers of a DNS protection zone
+func (c *Client) UpdateDNSProtectionZone(accountID int, zoneID string, zone *DNSProtectionZone) (*DNSProtectionZone, error) {
+	log.Printf("[INFO] Updating Incapsula DNS protection zone %s for account %d\n", zoneID, accountID)
+
+	path := fmt.Sprintf("%s/%s", endpointDNSProtectionZone, zoneID)
+	responseBody, _, err := c.doInfraProtectRequest(http.MethodPut, path, accountID, zone, UpdateDNSProtectionZone, fmt.Sprintf("updating DNS protection zone %s", zoneID))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseDNSProtectionZoneResponse(responseBody, fmt.Sprintf("update DNS protection zone %s", zoneID))
+}
+
+// DeleteDNSProtectionZone deletes a DNS protection zone
+func (c *Client) DeleteDNSProtectionZone(accountID int, zoneID string) error {
+	log.Printf("[INFO] Deleting Incapsula DNS protection zone %s for account %d\n", zoneID, accountID)
+
+	path := fmt.Sprintf("%s/%s", endpointDNSProtectionZone, zoneID)
+	_, _, err := c.doInfraProtectRequest(http.MethodDelete, path, accountID, nil, DeleteDNSProtectionZone, fmt.Sprintf("deleting DNS protection zone %s", zoneID))
+	return err
+}
+
+func parseDNSProtectionZoneResponse(responseBody []byte, action string) (*DNSProtectionZone, error) {
+	var zoneResponse DNSProtectionZoneResponse
+	err := decodeJSONResponse(fmt.Sprintf("%s/%s", endpointInfraProtect, endpointDNSProtectionZone), responseBody, &zoneResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %s JSON response: %s\nresponse: %s", action, err, string(responseBody))
+	}
+
+	if len(zoneResponse.Data) == 0 {
+		return nil, fmt.Errorf("Error parsing %s JSON response: no DNS protection zone returned\nresponse: %s", action, string(responseBody))
+	}
+
+	return &zoneResponse.Data[0], nil
+}
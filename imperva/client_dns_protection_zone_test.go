@@ -0,0 +1,127 @@
+package imperva
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////
+// AddDNSProtectionZone Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientAddDNSProtectionZoneBadConnection(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+
+	zone, err := client.AddDNSProtectionZone(0, &DNSProtectionZone{ZoneName: "example.com"})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error from Incapsula service when adding DNS protection zone example.com") {
+		t.Errorf("Should have received a client error, got: %s", err)
+	}
+	if zone != nil {
+		t.Errorf("Should have received a nil zone instance")
+	}
+}
+
+func TestClientAddDNSProtectionZoneBadJSON(t *testing.T) {
+	endpoint := fmt.Sprintf("/%s/%s?caid=42", endpointInfraProtect, endpointDNSProtectionZone)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		rw.Write([]byte(`{`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	zone, err := client.AddDNSProtectionZone(42, &DNSProtectionZone{ZoneName: "example.com"})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error parsing add DNS protection zone JSON response") {
+		t.Errorf("Should have received a JSON parse error, got: %s", err)
+	}
+	if zone != nil {
+		t.Errorf("Should have received a nil zone instance")
+	}
+}
+
+func TestClientAddDNSProtectionZoneValid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"data":[{"id":"123","zoneName":"example.com","originNameServers":["192.0.2.53"],"assignedNameServers":["ns1.incapdns.net","ns2.incapdns.net"],"status":"PENDING_DELEGATION"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	zone, err := client.AddDNSProtectionZone(0, &DNSProtectionZone{ZoneName: "example.com", OriginNameServers: []string{"192.0.2.53"}})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if zone == nil || zone.ID != "123" || len(zone.AssignedNameServers) != 2 || zone.Status != "PENDING_DELEGATION" {
+		t.Errorf("Unexpected zone: %+v", zone)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// GetDNSProtectionZone Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetDNSProtectionZoneNotFound(t *testing.T) {
+	endpoint := fmt.Sprintf("/%s/%s/123", endpointInfraProtect, endpointDNSProtectionZone)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		rw.WriteHeader(404)
+		rw.Write([]byte(`{"errors":[{"status":"404","detail":"Zone not found"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	zone, statusCode, err := client.GetDNSProtectionZone(0, "123")
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error status code 404 from Incapsula service when reading DNS protection zone 123") {
+		t.Errorf("Should have received a not found error, got: %s", err)
+	}
+	if statusCode != 404 {
+		t.Errorf("Should have received a 404 status code, got: %d", statusCode)
+	}
+	if zone != nil {
+		t.Errorf("Should have received a nil zone instance")
+	}
+}
+
+func TestClientGetDNSProtectionZoneValid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"data":[{"id":"123","zoneName":"example.com","originNameServers":["192.0.2.53"],"assignedNameServers":["ns1.incapdns.net","ns2.incapdns.net"],"status":"ACTIVE"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	zone, statusCode, err := client.GetDNSProtectionZone(0, "123")
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if statusCode != 200 {
+		t.Errorf("Should have received a 200 status code, got: %d", statusCode)
+	}
+	if zone == nil || zone.AssignedNameServers[0] != "ns1.incapdns.net" || zone.Status != "ACTIVE" {
+		t.Errorf("Unexpected zone: %+v", zone)
+	}
+}
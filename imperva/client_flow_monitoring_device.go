@@ -0,0 +1,87 @@
+package imperva
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+)
+
+// Endpoints (unexported consts)
+const endpointFlowMonitoringDevice = "flow-monitoring/devices"
+
+// FlowMonitoringDevice is a router which sends flow data to Imperva flow monitoring
+type FlowMonitoringDevice struct {
+	ID           string `json:"id,omitempty"`
+	Name         string `json:"name"`
+	IPAddress    string `json:"ipAddress"`
+	SamplingRate int    `json:"samplingRate"`
+	Description  string `json:"description"`
+	Status       string `json:"status,omitempty"`
+}
+
+// FlowMonitoringDeviceResponse contains the flow monitoring device returned by the API
+type FlowMonitoringDeviceResponse struct {
+	Data []FlowMonitoringDevice `json:"data"`
+}
+
+// AddFlowMonitoringDevice registers a flow monitoring device for an account
+func (c *Client) AddFlowMonitoringDevice(accountID int, device *FlowMonitoringDevice) (*FlowMonitoringDevice, error) {
+	log.Printf("[INFO] Adding Incapsula flow monitoring device %s for account %d\n", device.Name, accountID)
+
+	responseBody, _, err := c.doInfraProtectRequest(http.MethodPost, endpointFlowMonitoringDevice, accountID, device, CreateFlowMonitoringDevice, "adding flow monitoring device")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseFlowMonitoringDeviceResponse(responseBody, "add flow monitoring device")
+}
+
+// GetFlowMonitoringDevice gets a flow monitoring device, along with the status code of the response
+func (c *Client) GetFlowMonitoringDevice(accountID int, deviceID string) (*FlowMonitoringDevice, int, error) {
+	log.Printf("[INFO] Getting Incapsula flow monitoring device %s for account %d\n", deviceID, accountID)
+
+	path := fmt.Sprintf("%s/%s", endpointFlowMonitoringDevice, deviceID)
+	responseBody, statusCode, err := c.doInfraProtectRequest(http.MethodGet, path, accountID, nil, ReadFlowMonitoringDevice, fmt.Sprintf("reading flow monitoring device %s", deviceID))
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	device, err := parseFlowMonitoringDeviceResponse(responseBody, fmt.Sprintf("read flow monitoring device %s", deviceID))
+	return device, statusCode, err
+}
+
+// UpdateFlowMonitoringDevice updates a flow monitoring device
+func (c *Client) UpdateFlowMonitoringDevice(accountID int, deviceID string, device *FlowMonitoringDevice) (*FlowMonitoringDevice, error) {
+	log.Printf("[INFO] Updating Incapsula flow monitoring device %s for account %d\n", deviceID, accountID)
+
+	path := fmt.Sprintf("%s/%s", endpointFlowMonitoringDevice, deviceID)
+	responseBody, _, err := c.doInfraProtectRequest(http.MethodPut, path, accountID, device, UpdateFlowMonitoringDevice, fmt.Sprintf("updating flow monitoring device %s", deviceID))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseFlowMonitoringDeviceResponse(responseBody, fmt.Sprintf("update flow monitoring device %s", deviceID))
+}
+
+// DeleteFlowMonitoringDevice unregisters a flow monitoring device
+func (c *Client) DeleteFlowMonitoringDevice(accountID int, deviceID string) error {
+	log.Printf("[INFO] Deleting Incapsula flow monitoring device %s for account %d\n", deviceID, accountID)
+
+	path := fmt.Sprintf("%s/%s", endpointFlowMonitoringDevice, deviceID)
+	_, _, err := c.doInfraProtectRequest(http.MethodDelete, path, accountID, nil, DeleteFlowMonitoringDevice, fmt.Sprintf("deleting flow monitoring device %s", deviceID))
+	return err
+}
+
+func parseFlowMonitoringDeviceResponse(responseBody []byte, action string) (*FlowMonitoringDevice, error) {
+	var deviceResponse FlowMonitoringDeviceResponse
+	err := decodeJSONResponse(fmt.Sprintf("%s/%s", endpointInfraProtect, endpointFlowMonitoringDevice), responseBody, &deviceResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %s JSON response: %s\nresponse: %s", action, err, string(responseBody))
+	}
+
+	if len(deviceResponse.Data) == 0 {
+		return nil, fmt.Errorf("Error parsing %s JSON response: no flow monitoring device returned\nresponse: %s", action, string(responseBody))
+	}
+
+	return &deviceResponse.Data[0], nil
+}
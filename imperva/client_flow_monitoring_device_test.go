@@ -0,0 +1,127 @@
+package imperva
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////
+// AddFlowMonitoringDevice Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientAddFlowMonitoringDeviceBadConnection(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+
+	device, err := client.AddFlowMonitoringDevice(0, &FlowMonitoringDevice{Name: "edge-1"})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error from Incapsula service when adding flow monitoring device") {
+		t.Errorf("Should have received a client error, got: %s", err)
+	}
+	if device != nil {
+		t.Errorf("Should have received a nil device instance")
+	}
+}
+
+func TestClientAddFlowMonitoringDeviceBadJSON(t *testing.T) {
+	endpoint := fmt.Sprintf("/%s/%s?caid=42", endpointInfraProtect, endpointFlowMonitoringDevice)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		rw.Write([]byte(`{`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	device, err := client.AddFlowMonitoringDevice(42, &FlowMonitoringDevice{Name: "edge-1"})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error parsing add flow monitoring device JSON response") {
+		t.Errorf("Should have received a JSON parse error, got: %s", err)
+	}
+	if device != nil {
+		t.Errorf("Should have received a nil device instance")
+	}
+}
+
+func TestClientAddFlowMonitoringDeviceValid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"data":[{"id":"123","name":"edge-1","ipAddress":"192.0.2.1","samplingRate":1000,"description":"","status":"NOT_RECEIVING"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	device, err := client.AddFlowMonitoringDevice(0, &FlowMonitoringDevice{Name: "edge-1", IPAddress: "192.0.2.1", SamplingRate: 1000})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if device == nil || device.ID != "123" || device.SamplingRate != 1000 || device.Status != "NOT_RECEIVING" {
+		t.Errorf("Unexpected device: %+v", device)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// GetFlowMonitoringDevice Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetFlowMonitoringDeviceNotFound(t *testing.T) {
+	endpoint := fmt.Sprintf("/%s/%s/123", endpointInfraProtect, endpointFlowMonitoringDevice)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		rw.WriteHeader(404)
+		rw.Write([]byte(`{"errors":[{"status":"404","detail":"Device not found"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	device, statusCode, err := client.GetFlowMonitoringDevice(0, "123")
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error status code 404 from Incapsula service when reading flow monitoring device 123") {
+		t.Errorf("Should have received a not found error, got: %s", err)
+	}
+	if statusCode != 404 {
+		t.Errorf("Should have received a 404 status code, got: %d", statusCode)
+	}
+	if device != nil {
+		t.Errorf("Should have received a nil device instance")
+	}
+}
+
+func TestClientGetFlowMonitoringDeviceValid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"data":[{"id":"123","name":"edge-1","ipAddress":"192.0.2.1","samplingRate":1000,"description":"core router","status":"RECEIVING"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	device, statusCode, err := client.GetFlowMonitoringDevice(0, "123")
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if statusCode != 200 {
+		t.Errorf("Should have received a 200 status code, got: %d", statusCode)
+	}
+	if device == nil || device.Description != "core router" || device.Status != "RECEIVING" {
+		t.Errorf("Unexpected device: %+v", device)
+	}
+}
@@ -0,0 +1,77 @@
+package imperva
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/url"
+)
+
+const endpointGeoInfo = "geo"
+
+// GeoCode is a country or continent code and its display name
+type GeoCode struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// GeoInfoResponse contains the country and continent codes accepted by Incapsula
+type GeoInfoResponse struct {
+	Countries  []GeoCode `json:"countries"`
+	Continents []GeoCode `json:"continents"`
+	Res        int       `json:"res"`
+	ResMessage string    `json:"res_message"`
+}
+
+// GetGeoInfo gets the country and continent codes that can be used in ACL rules and exceptions
+func (c *Client) GetGeoInfo() (*GeoInfoResponse, error) {
+	log.Printf("[INFO] Getting Incapsula geo info\n")
+
+	reqURL := fmt.Sprintf("%s/%s", c.integrationBaseURL(), endpointGeoInfo)
+	resp, err := c.PostFormWithHeaders(reqURL, url.Values{}, ReadGeoInfo)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting geo info: %s", err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula geo info JSON response: %s\n", string(responseBody))
+
+	// Parse the JSON
+	var geoInfoResponse GeoInfoResponse
+	err = json.Unmarshal([]byte(responseBody), &geoInfoResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing geo info JSON response: %s", err)
+	}
+
+	// Look at the response status code from Incapsula
+	if geoInfoResponse.Res != 0 {
+		return &geoInfoResponse, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when getting geo info: %s", string(responseBody))
+	}
+
+	return &geoInfoResponse, nil
+}
+
+// GetCachedGeoInfo gets the geo info once per client, the codes don't change between calls. A failure is kept as well,
+// so an unavailable geo endpoint isn't called again for every resource
+func (c *Client) GetCachedGeoInfo() (*GeoInfoResponse, error) {
+	c.geoInfoMutex.Lock()
+	defer c.geoInfoMutex.Unlock()
+
+	if c.geoInfo != nil || c.geoInfoErr != nil {
+		return c.geoInfo, c.geoInfoErr
+	}
+
+	geoInfoResponse, err := c.GetGeoInfo()
+	if err != nil {
+		c.geoInfoErr = err
+		return nil, err
+	}
+	c.geoInfo = geoInfoResponse
+
+	return c.geoInfo, nil
+}
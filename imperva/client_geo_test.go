@@ -0,0 +1,84 @@
+package imperva
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// GetGeoInfo Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetGeoInfoBadJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointGeoInfo) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointGeoInfo, req.URL.String())
+		}
+		rw.Write([]byte(`{`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	geoInfoResponse, err := client.GetGeoInfo()
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error parsing geo info JSON response") {
+		t.Errorf("Should have received a JSON parse error, got: %s", err)
+	}
+	if geoInfoResponse != nil {
+		t.Errorf("Should have received a nil geoInfoResponse instance")
+	}
+}
+
+func TestClientGetGeoInfoCached(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		requests++
+		rw.Write([]byte(`{"countries":[{"id":"US","name":"United States"}],"continents":[{"id":"EU","name":"Europe"}],"res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	for i := 0; i < 2; i++ {
+		geoInfoResponse, err := client.GetCachedGeoInfo()
+		if err != nil {
+			t.Fatalf("Should not have received an error, got: %s", err)
+		}
+		if geoInfoResponse.Countries[0].ID != "US" || geoInfoResponse.Continents[0].ID != "EU" {
+			t.Errorf("Geo codes don't match")
+		}
+	}
+	if requests != 1 {
+		t.Errorf("Should have hit the geo endpoint once, got: %d", requests)
+	}
+}
+
+func TestClientGetGeoInfoCachedError(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		requests++
+		rw.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	for i := 0; i < 2; i++ {
+		geoInfoResponse, err := client.GetCachedGeoInfo()
+		if err == nil {
+			t.Fatalf("Should have received an error")
+		}
+		if geoInfoResponse != nil {
+			t.Errorf("Should have received a nil geoInfoResponse instance")
+		}
+	}
+	if requests != 1 {
+		t.Errorf("Should have hit the geo endpoint once, got: %d", requests)
+	}
+}
@@ -1,4 +1,4 @@
-package incapsula
+package imperva
 
 import (
 	"encoding/json"
@@ -6,8 +6,15 @@ import (
 	"io/ioutil"
 	"log"
 	"net/http"
+	"net/url"
 )
 
+// Endpoints (unexported consts)
+const endpointIncapRuleList = "sites/incapRules/list"
+
+// endpointIncapRule names the rules endpoint of the v2 API in errors, its URLs include the site ID
+const endpointIncapRule = "sites/{site_id}/rules"
+
 // IncapRule is a struct that encompasses all the properties of an IncapRule
 type IncapRule struct {
 	Name                  string `json:"name"`
@@ -18,6 +25,7 @@ type IncapRule struct {
 	From                  string `json:"from,omitempty"`
 	To                    string `json:"to,omitempty"`
 	RewriteName           string `json:"rewrite_name,omitempty"`
+	RewriteExisting       *bool  `json:"rewrite_existing,omitempty"`
 	DCID                  int    `json:"dc_id,omitempty"`
 	PortForwardingContext string `json:"port_forwarding_context,omitempty"`
 	PortForwardingValue   string `json:"port_forwarding_value,omitempty"`
@@ -62,12 +70,12 @@ func (c *Client) AddIncapRule(siteID string, rule *IncapRule) (*IncapRuleWithID,
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Error status code %d from Incapsula service when adding Incap Rule for Site ID %s: %s", resp.StatusCode, siteID, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when adding Incap Rule for Site ID %s: %s", resp.StatusCode, siteID, string(responseBody))
 	}
 
 	// Parse the JSON
 	var incapRuleWithID IncapRuleWithID
-	err = json.Unmarshal([]byte(responseBody), &incapRuleWithID)
+	err = decodeJSONResponse(endpointIncapRule, responseBody, &incapRuleWithID)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing Incap Rule JSON response for Site ID %s: %s\nresponse: %s", siteID, err, string(responseBody))
 	}
@@ -95,12 +103,12 @@ func (c *Client) ReadIncapRule(siteID string, ruleID int) (*IncapRuleWithID, int
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return nil, resp.StatusCode, fmt.Errorf("Error status code %d from Incapsula service when reading Incap Rule %d for Site ID %s: %s", resp.StatusCode, ruleID, siteID, string(responseBody))
+		return nil, resp.StatusCode, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when reading Incap Rule %d for Site ID %s: %s", resp.StatusCode, ruleID, siteID, string(responseBody))
 	}
 
 	// Parse the JSON
 	var incapRuleWithID IncapRuleWithID
-	err = json.Unmarshal([]byte(responseBody), &incapRuleWithID)
+	err = decodeJSONResponse(endpointIncapRule, responseBody, &incapRuleWithID)
 	if err != nil {
 		return nil, resp.StatusCode, fmt.Errorf("Error parsing Incap Rule %d JSON response for Site ID %s: %s\nresponse: %s", ruleID, siteID, err, string(responseBody))
 	}
@@ -133,12 +141,12 @@ func (c *Client) UpdateIncapRule(siteID string, ruleID int, rule *IncapRule) (*I
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Error status code %d from Incapsula service when updating Incap Rule %d for Site ID %s: %s", resp.StatusCode, ruleID, siteID, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when updating Incap Rule %d for Site ID %s: %s", resp.StatusCode, ruleID, siteID, string(responseBody))
 	}
 
 	// Parse the JSON
 	var incapRuleWithID IncapRuleWithID
-	err = json.Unmarshal([]byte(responseBody), &incapRuleWithID)
+	err = decodeJSONResponse(endpointIncapRule, responseBody, &incapRuleWithID)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing Incap Rule %d JSON response for Site ID %s: %s\nresponse: %s", ruleID, siteID, err, string(responseBody))
 	}
@@ -166,8 +174,62 @@ func (c *Client) DeleteIncapRule(siteID string, ruleID int) error {
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return fmt.Errorf("Error status code %d from Incapsula service when deleting Incap Rule %d for Site ID %s: %s", resp.StatusCode, ruleID, siteID, string(responseBody))
+		return NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when deleting Incap Rule %d for Site ID %s: %s", resp.StatusCode, ruleID, siteID, string(responseBody))
 	}
 
 	return nil
 }
+
+// IncapRuleListItem is the summary of an Incap Rule returned when listing the rules of a site
+type IncapRuleListItem struct {
+	ID     json.Number `json:"id"`
+	Name   string      `json:"name"`
+	Action string      `json:"action"`
+}
+
+// IncapRuleListResponse contains the Incap Rules and the delivery rules of a site, grouped by category
+type IncapRuleListResponse struct {
+	IncapRules    map[string][]IncapRuleListItem `json:"incap_rules"`
+	DeliveryRules map[string][]IncapRuleListItem `json:"delivery_rules"`
+	Res           int                            `json:"res"`
+	ResMessage    string                         `json:"res_message"`
+}
+
+// ListIncapRules gets the Incap Rules and the delivery rules of a site
+func (c *Client) ListIncapRules(siteID string) (*IncapRuleListResponse, error) {
+	log.Printf("[INFO] Listing Incapsula Incap Rules for Site ID %s\n", siteID)
+
+	// Post form to Incapsula
+	values := url.Values{
+		"site_id":             {siteID},
+		"include_ad_rules":    {"yes"},
+		"include_incap_rules": {"yes"},
+		"page_size":           {"100"},
+	}
+	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointIncapRuleList)
+	resp, err := c.PostFormWithHeaders(reqURL, values, ListIncapRules)
+	if err != nil {
+		return nil, fmt.Errorf("Error from Incapsula service when listing Incap Rules for Site ID %s: %s", siteID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula List Incap Rules JSON response: %s\n", string(responseBody))
+
+	// Parse the JSON
+	var incapRuleListResponse IncapRuleListResponse
+	err = decodeJSONResponse(endpointIncapRuleList, responseBody, &incapRuleListResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Incap Rules list JSON response for Site ID %s: %s\nresponse: %s", siteID, err, string(responseBody))
+	}
+
+	// Look at the response status code from Incapsula
+	if incapRuleListResponse.Res != 0 {
+		return &incapRuleListResponse, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when listing Incap Rules for Site ID %s: %s", siteID, string(responseBody))
+	}
+
+	return &incapRuleListResponse, nil
+}
@@ -1,7 +1,8 @@
-package incapsula
+package imperva
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -144,6 +145,40 @@ func TestClientAddIncapRuleValidRule(t *testing.T) {
 	}
 }
 
+func TestClientAddIncapRuleRewriteExisting(t *testing.T) {
+	var requestBodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		requestBodies = append(requestBodies, string(body))
+		rw.WriteHeader(200)
+		rw.Write([]byte(`{"rule_id":290110,"name":"header","action":"RULE_ACTION_REWRITE_HEADER","rewrite_existing":false}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	rewriteExisting := false
+	rules := []IncapRule{
+		{Name: "header", Action: "RULE_ACTION_REWRITE_HEADER", RewriteName: "X-Client-Cert", To: "cert", AddMissing: true, RewriteExisting: &rewriteExisting},
+		{Name: "alert", Action: "RULE_ACTION_ALERT"},
+	}
+	for _, rule := range rules {
+		rule := rule
+		_, err := client.AddIncapRule("42", &rule)
+		if err != nil {
+			t.Errorf("Should not have received an error, got: %s", err)
+		}
+	}
+
+	if len(requestBodies) != 2 || !strings.Contains(requestBodies[0], `"rewrite_existing":false`) {
+		t.Errorf("Should have sent rewrite_existing for the rewrite rule, got: %v", requestBodies)
+	}
+	if len(requestBodies) == 2 && strings.Contains(requestBodies[1], "rewrite_existing") {
+		t.Errorf("Should not have sent rewrite_existing when not set, got: %s", requestBodies[1])
+	}
+}
+
 ////////////////////////////////////////////////////////////////
 // ReadIncapRule Tests
 ////////////////////////////////////////////////////////////////
@@ -479,3 +514,115 @@ func TestClientDeleteIncapRuleValidRule(t *testing.T) {
 		t.Errorf("Should not have received an error")
 	}
 }
+
+////////////////////////////////////////////////////////////////
+// ListIncapRules Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientListIncapRulesBadConnection(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com", BaseURLRev2: "badness.incapsula.com", BaseURLAPI: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+	siteID := "42"
+
+	listIncapRulesResponse, err := client.ListIncapRules(siteID)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), fmt.Sprintf("Error from Incapsula service when listing Incap Rules for Site ID %s", siteID)) {
+		t.Errorf("Should have received an client error, got: %s", err)
+	}
+	if listIncapRulesResponse != nil {
+		t.Errorf("Should have received a nil listIncapRulesResponse instance")
+	}
+}
+
+func TestClientListIncapRulesBadJSON(t *testing.T) {
+	siteID := "42"
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointIncapRuleList) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointIncapRuleList, req.URL.String())
+		}
+		rw.Write([]byte(`{`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	listIncapRulesResponse, err := client.ListIncapRules(siteID)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), fmt.Sprintf("Error parsing Incap Rules list JSON response for Site ID %s", siteID)) {
+		t.Errorf("Should have received a JSON parse error, got: %s", err)
+	}
+	if listIncapRulesResponse != nil {
+		t.Errorf("Should have received a nil listIncapRulesResponse instance")
+	}
+}
+
+func TestClientListIncapRulesInvalidSite(t *testing.T) {
+	siteID := "42"
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"res":9413,"res_message":"Unknown/unauthorized site_id","debug_info":{"id-info":"13007","site_id":"42"}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	listIncapRulesResponse, err := client.ListIncapRules(siteID)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), fmt.Sprintf("Error from Incapsula service when listing Incap Rules for Site ID %s", siteID)) {
+		t.Errorf("Should have received a bad site error, got: %s", err)
+	}
+	if listIncapRulesResponse == nil {
+		t.Errorf("Should have received a listIncapRulesResponse instance")
+	}
+}
+
+func TestClientListIncapRulesValidSite(t *testing.T) {
+	siteID := "42"
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"res":0,"incap_rules":{"All":[{"id":"290109","name":"myfirstcoolrule","action":"api.rule_action_type.alert"}]},"delivery_rules":{"Redirect":[{"id":290110,"name":"redirect","action":"api.rule_action_type.redirect"}]}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	listIncapRulesResponse, err := client.ListIncapRules(siteID)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if listIncapRulesResponse == nil {
+		t.Fatalf("Should not have received a nil listIncapRulesResponse instance")
+	}
+	if len(listIncapRulesResponse.IncapRules["All"]) != 1 || listIncapRulesResponse.IncapRules["All"][0].ID.String() != "290109" {
+		t.Errorf("Should have received incap rule 290109, got: %v", listIncapRulesResponse.IncapRules)
+	}
+	if len(listIncapRulesResponse.DeliveryRules["Redirect"]) != 1 || listIncapRulesResponse.DeliveryRules["Redirect"][0].ID.String() != "290110" {
+		t.Errorf("Should have received delivery rule 290110, got: %v", listIncapRulesResponse.DeliveryRules)
+	}
+}
+
+func TestClientUpdateIncapRuleConflict(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodPut, "/sites/42/rules/123", mockAPIError(http.StatusConflict, "Another operation is in progress for the site"))
+
+	_, err := api.client().UpdateIncapRule("42", 123, &IncapRule{Name: "rule"})
+	if err == nil {
+		t.Fatalf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error status code 409 from Incapsula service when updating Incap Rule 123 for Site ID 42") {
+		t.Errorf("Should have received the status code error, got: %s", err)
+	}
+	if !IsConflictError(err) {
+		t.Errorf("Should have received a conflict error, got: %s", err)
+	}
+}
@@ -0,0 +1,45 @@
+package imperva
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+)
+
+// Endpoints (unexported consts)
+const endpointInfraProtect = "infra-protect/v1"
+
+// doInfraProtectRequest sends a JSON request to the Infrastructure Protection API and returns the response body and status code.
+// action describes the request for error messages, e.g. "adding netflow exporter".
+// The request body isn't logged, it carries secrets such as BGP passwords, tunnel keys and TLS client keys.
+func (c *Client) doInfraProtectRequest(method, path string, accountID int, body interface{}, operation, action string) ([]byte, int, error) {
+	var requestJSON []byte
+	if body != nil {
+		var err error
+		requestJSON, err = json.Marshal(body)
+		if err != nil {
+			return nil, 0, fmt.Errorf("Failed to JSON marshal request when %s: %s", action, err)
+		}
+	}
+
+	reqURL := fmt.Sprintf("%s/%s/%s", c.config.BaseURLAPI, endpointInfraProtect, path)
+	resp, err := c.DoJsonAndQueryParamsRequestWithHeaders(method, reqURL, requestJSON, GetRequestParamsWithCaid(accountID), operation)
+	if err != nil {
+		return nil, 0, fmt.Errorf("Error from Incapsula service when %s: %s", action, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula Infrastructure Protection JSON response when %s: %s\n", action, string(responseBody))
+
+	// Check the response code
+	if resp.StatusCode != 200 {
+		return responseBody, resp.StatusCode, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when %s: %s", resp.StatusCode, action, string(responseBody))
+	}
+
+	return responseBody, resp.StatusCode, nil
+}
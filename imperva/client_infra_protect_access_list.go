@@ -0,0 +1,73 @@
+package imperva
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+)
+
+// Endpoints (unexported consts)
+const endpointInfraProtectAccessList = "access-list"
+
+// Access list actions
+const AccessListActionAllow = "ALLOW"
+const AccessListActionBlock = "BLOCK"
+
+// InfraProtectAccessListEntry allows or blocks traffic to a protected IP range before it is mitigated
+type InfraProtectAccessListEntry struct {
+	Action   string `json:"action"`
+	Source   string `json:"source"`
+	Protocol string `json:"protocol"`
+	Ports    string `json:"ports,omitempty"`
+}
+
+// InfraProtectAccessList contains the static allow and block entries of a protected IP range
+type InfraProtectAccessList struct {
+	Entries []InfraProtectAccessListEntry `json:"entries"`
+}
+
+// InfraProtectAccessListResponse contains the access list returned by the API
+type InfraProtectAccessListResponse struct {
+	Data []InfraProtectAccessList `json:"data"`
+}
+
+// GetInfraProtectAccessList gets the access list of a protected IP range, along with the status code of the response
+func (c *Client) GetInfraProtectAccessList(accountID int, rangeID string) (*InfraProtectAccessList, int, error) {
+	log.Printf("[INFO] Getting Incapsula Infrastructure Protection access list for protected IP range %s\n", rangeID)
+
+	path := fmt.Sprintf("%s/%s/%s", endpointProtectedIPRange, rangeID, endpointInfraProtectAccessList)
+	responseBody, statusCode, err := c.doInfraProtectRequest(http.MethodGet, path, accountID, nil, ReadInfraProtectAccessList, fmt.Sprintf("reading access list for protected IP range %s", rangeID))
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	accessList, err := parseInfraProtectAccessListResponse(responseBody, fmt.Sprintf("read access list for protected IP range %s", rangeID))
+	return accessList, statusCode, err
+}
+
+// UpdateInfraProtectAccessList replaces the access list of a protected IP range
+func (c *Client) UpdateInfraProtectAccessList(accountID int, rangeID string, accessList *InfraProtectAccessList) (*InfraProtectAccessList, error) {
+	log.Printf("[INFO] Updating Incapsula Infrastructure Protection access list for protected IP range %s\n", rangeID)
+
+	path := fmt.Sprintf("%s/%s/%s", endpointProtectedIPRange, rangeID, endpointInfraProtectAccessList)
+	responseBody, _, err := c.doInfraProtectRequest(http.MethodPut, path, accountID, accessList, UpdateInfraProtectAccessList, fmt.Sprintf("updating access list for protected IP range %s", rangeID))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseInfraProtectAccessListResponse(responseBody, fmt.Sprintf("update access list for protected IP range %s", rangeID))
+}
+
+func parseInfraProtectAccessListResponse(responseBody []byte, action string) (*InfraProtectAccessList, error) {
+	var accessListResponse InfraProtectAccessListResponse
+	err := decodeJSONResponse(fmt.Sprintf("%s/%s", endpointInfraProtect, endpointInfraProtectAccessList), responseBody, &accessListResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %s JSON response: %s\nresponse: %s", action, err, string(responseBody))
+	}
+
+	if len(accessListResponse.Data) == 0 {
+		return nil, fmt.Errorf("Error parsing %s JSON response: no access list returned\nresponse: %s", action, string(responseBody))
+	}
+
+	return &accessListResponse.Data[0], nil
+}
@@ -0,0 +1,103 @@
+package imperva
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////
+// GetInfraProtectAccessList Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetInfraProtectAccessListBadConnection(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+
+	accessList, _, err := client.GetInfraProtectAccessList(0, "123")
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error from Incapsula service when reading access list for protected IP range 123") {
+		t.Errorf("Should have received a client error, got: %s", err)
+	}
+	if accessList != nil {
+		t.Errorf("Should have received a nil access list instance")
+	}
+}
+
+func TestClientGetInfraProtectAccessListBadJSON(t *testing.T) {
+	endpoint := fmt.Sprintf("/%s/%s/123/%s", endpointInfraProtect, endpointProtectedIPRange, endpointInfraProtectAccessList)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		rw.Write([]byte(`{`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	accessList, _, err := client.GetInfraProtectAccessList(0, "123")
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error parsing read access list for protected IP range 123 JSON response") {
+		t.Errorf("Should have received a JSON parse error, got: %s", err)
+	}
+	if accessList != nil {
+		t.Errorf("Should have received a nil access list instance")
+	}
+}
+
+func TestClientGetInfraProtectAccessListValid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"data":[{"entries":[{"action":"ALLOW","source":"198.51.100.0/24","protocol":"TCP","ports":"443"},{"action":"BLOCK","source":"203.0.113.7","protocol":"ANY"}]}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	accessList, _, err := client.GetInfraProtectAccessList(0, "123")
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if accessList == nil || len(accessList.Entries) != 2 || accessList.Entries[1].Action != AccessListActionBlock {
+		t.Errorf("Unexpected access list: %+v", accessList)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// UpdateInfraProtectAccessList Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientUpdateInfraProtectAccessListValid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPut {
+			t.Errorf("Should have sent a PUT request. Got: %s", req.Method)
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		if string(body) != `{"entries":[]}` {
+			t.Errorf("Should have sent an empty list of entries. Got: %s", string(body))
+		}
+		rw.Write([]byte(`{"data":[{"entries":[]}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	accessList, err := client.UpdateInfraProtectAccessList(0, "123", &InfraProtectAccessList{Entries: []InfraProtectAccessListEntry{}})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if accessList == nil || len(accessList.Entries) != 0 {
+		t.Errorf("Unexpected access list: %+v", accessList)
+	}
+}
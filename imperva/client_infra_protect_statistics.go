@@ -0,0 +1,57 @@
+package imperva
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Endpoints (unexported consts)
+const endpointInfraProtectStatistics = "statistics"
+
+// InfraProtectRangeStatistics contains the traffic statistics of a protected IP range over a time range
+type InfraProtectRangeStatistics struct {
+	IPRangeID      string `json:"ipRangeId"`
+	Prefix         string `json:"prefix"`
+	AverageBps     int64  `json:"averageBps"`
+	PeakBps        int64  `json:"peakBps"`
+	AveragePps     int64  `json:"averagePps"`
+	PeakPps        int64  `json:"peakPps"`
+	MitigatedBytes int64  `json:"mitigatedBytes"`
+	Attacks        int    `json:"attacks"`
+}
+
+// InfraProtectStatisticsResponse contains the statistics of the protected IP ranges returned by the API
+type InfraProtectStatisticsResponse struct {
+	Data []InfraProtectRangeStatistics `json:"data"`
+}
+
+// GetInfraProtectStatistics gets the traffic statistics of protected IP ranges between two times, in milliseconds since the epoch.
+// If no range IDs are given, the statistics of all the ranges of the account are returned.
+func (c *Client) GetInfraProtectStatistics(accountID int, rangeIDs []string, from, to int64) (*InfraProtectStatisticsResponse, error) {
+	log.Printf("[INFO] Getting Incapsula Infrastructure Protection statistics for account %d\n", accountID)
+
+	query := url.Values{}
+	query.Set("from", strconv.FormatInt(from, 10))
+	query.Set("to", strconv.FormatInt(to, 10))
+	if len(rangeIDs) > 0 {
+		query.Set("ipRangeIds", strings.Join(rangeIDs, ","))
+	}
+
+	path := fmt.Sprintf("%s?%s", endpointInfraProtectStatistics, query.Encode())
+	responseBody, _, err := c.doInfraProtectRequest(http.MethodGet, path, accountID, nil, ReadInfraProtectStatistics, "reading statistics")
+	if err != nil {
+		return nil, err
+	}
+
+	var statisticsResponse InfraProtectStatisticsResponse
+	err = decodeJSONResponse(fmt.Sprintf("%s/%s", endpointInfraProtect, endpointInfraProtectStatistics), responseBody, &statisticsResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing read statistics JSON response: %s\nresponse: %s", err, string(responseBody))
+	}
+
+	return &statisticsResponse, nil
+}
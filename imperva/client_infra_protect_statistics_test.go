@@ -0,0 +1,78 @@
+package imperva
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////
+// GetInfraProtectStatistics Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetInfraProtectStatisticsBadConnection(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+
+	statisticsResponse, err := client.GetInfraProtectStatistics(0, nil, 1000, 2000)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error from Incapsula service when reading statistics") {
+		t.Errorf("Should have received a client error, got: %s", err)
+	}
+	if statisticsResponse != nil {
+		t.Errorf("Should have received a nil statistics response instance")
+	}
+}
+
+func TestClientGetInfraProtectStatisticsBadJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	statisticsResponse, err := client.GetInfraProtectStatistics(0, nil, 1000, 2000)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error parsing read statistics JSON response") {
+		t.Errorf("Should have received a JSON parse error, got: %s", err)
+	}
+	if statisticsResponse != nil {
+		t.Errorf("Should have received a nil statistics response instance")
+	}
+}
+
+func TestClientGetInfraProtectStatisticsValid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/"+endpointInfraProtect+"/"+endpointInfraProtectStatistics {
+			t.Errorf("Should have have hit the statistics endpoint. Got: %s", req.URL.Path)
+		}
+		query := req.URL.Query()
+		if query.Get("from") != "1000" || query.Get("to") != "2000" || query.Get("ipRangeIds") != "1,2" || query.Get("caid") != "42" {
+			t.Errorf("Unexpected query: %s", req.URL.RawQuery)
+		}
+		rw.Write([]byte(`{"data":[{"ipRangeId":"1","prefix":"192.0.2.0/24","averageBps":1000,"peakBps":5000,"averagePps":10,"peakPps":50,"mitigatedBytes":0,"attacks":0},{"ipRangeId":"2","prefix":"198.51.100.0/24","peakBps":7000,"attacks":2}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	statisticsResponse, err := client.GetInfraProtectStatistics(42, []string{"1", "2"}, 1000, 2000)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if statisticsResponse == nil || len(statisticsResponse.Data) != 2 {
+		t.Fatalf("Should have received the statistics of 2 ranges, got: %+v", statisticsResponse)
+	}
+	if statisticsResponse.Data[0].PeakBps != 5000 || statisticsResponse.Data[1].Attacks != 2 {
+		t.Errorf("Unexpected statistics: %+v", statisticsResponse.Data)
+	}
+}
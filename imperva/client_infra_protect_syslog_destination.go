@@ -0,0 +1,93 @@
+package imperva
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+)
+
+// Endpoints (unexported consts)
+const endpointInfraProtectSyslogDestination = "syslog-destinations"
+
+// InfraProtectSyslogDestination is a syslog server Infrastructure Protection events are forwarded to
+type InfraProtectSyslogDestination struct {
+	ID                   string `json:"id,omitempty"`
+	Name                 string `json:"name"`
+	Host                 string `json:"host"`
+	Port                 int    `json:"port"`
+	Transport            string `json:"transport"`
+	Format               string `json:"format"`
+	Enabled              bool   `json:"enabled"`
+	TLSVerifyCertificate bool   `json:"tlsVerifyCertificate"`
+	TLSCACertificate     string `json:"tlsCaCertificate,omitempty"`
+	TLSClientCertificate string `json:"tlsClientCertificate,omitempty"`
+	TLSClientKey         string `json:"tlsClientKey,omitempty"`
+	Status               string `json:"status,omitempty"`
+}
+
+// InfraProtectSyslogDestinationResponse contains the syslog destination returned by the API
+type InfraProtectSyslogDestinationResponse struct {
+	Data []InfraProtectSyslogDestination `json:"data"`
+}
+
+// AddInfraProtectSyslogDestination adds a syslog destination to an account
+func (c *Client) AddInfraProtectSyslogDestination(accountID int, destination *InfraProtectSyslogDestination) (*InfraProtectSyslogDestination, error) {
+	log.Printf("[INFO] Adding Incapsula Infrastructure Protection syslog destination %s for account %d\n", destination.Name, accountID)
+
+	responseBody, _, err := c.doInfraProtectRequest(http.MethodPost, endpointInfraProtectSyslogDestination, accountID, destination, CreateInfraProtectSyslogDestination, "adding syslog destination")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseInfraProtectSyslogDestinationResponse(responseBody, "add syslog destination")
+}
+
+// GetInfraProtectSyslogDestination gets a syslog destination, along with the status code of the response
+func (c *Client) GetInfraProtectSyslogDestination(accountID int, destinationID string) (*InfraProtectSyslogDestination, int, error) {
+	log.Printf("[INFO] Getting Incapsula Infrastructure Protection syslog destination %s for account %d\n", destinationID, accountID)
+
+	path := fmt.Sprintf("%s/%s", endpointInfraProtectSyslogDestination, destinationID)
+	responseBody, statusCode, err := c.doInfraProtectRequest(http.MethodGet, path, accountID, nil, ReadInfraProtectSyslogDestination, fmt.Sprintf("reading syslog destination %s", destinationID))
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	destination, err := parseInfraProtectSyslogDestinationResponse(responseBody, fmt.Sprintf("read syslog destination %s", destinationID))
+	return destination, statusCode, err
+}
+
+// UpdateInfraProtectSyslogDestination updates a syslog destination
+func (c *Client) UpdateInfraProtectSyslogDestination(accountID int, destinationID string, destination *InfraProtectSyslogDestination) (*InfraProtectSyslogDestination, error) {
+	log.Printf("[INFO] Updating Incapsula Infrastructure Protection syslog destination %s for account %d\n", destinationID, accountID)
+
+	path := fmt.Sprintf("%s/%s", endpointInfraProtectSyslogDestination, destinationID)
+	responseBody, _, err := c.doInfraProtectRequest(http.MethodPut, path, accountID, destination, UpdateInfraProtectSyslogDestination, fmt.Sprintf("updating syslog destination %s", destinationID))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseInfraProtectSyslogDestinationResponse(responseBody, fmt.Sprintf("update syslog destination %s", destinationID))
+}
+
+// DeleteInfraProtectSyslogDestination deletes a syslog destination
+func (c *Client) DeleteInfraProtectSyslogDestination(accountID int, destinationID string) error {
+	log.Printf("[INFO] Deleting Incapsula Infrastructure Protection syslog destination %s for account %d\n", destinationID, accountID)
+
+	path := fmt.Sprintf("%s/%s", endpointInfraProtectSyslogDestination, destinationID)
+	_, _, err := c.doInfraProtectRequest(http.MethodDelete, path, accountID, nil, DeleteInfraProtectSyslogDestination, fmt.Sprintf("deleting syslog destination %s", destinationID))
+	return err
+}
+
+func parseInfraProtectSyslogDestinationResponse(responseBody []byte, action string) (*InfraProtectSyslogDestination, error) {
+	var destinationResponse InfraProtectSyslogDestinationResponse
+	err := decodeJSONResponse(fmt.Sprintf("%s/%s", endpointInfraProtect, endpointInfraProtectSyslogDestination), responseBody, &destinationResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %s JSON response: %s\nresponse: %s", action, err, string(responseBody))
+	}
+
+	if len(destinationResponse.Data) == 0 {
+		return nil, fmt.Errorf("Error parsing %s JSON response: no syslog destination returned\nresponse: %s", action, string(responseBody))
+	}
+
+	return &destinationResponse.Data[0], nil
+}
@@ -0,0 +1,132 @@
+package imperva
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////
+// AddInfraProtectSyslogDestination Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientAddInfraProtectSyslogDestinationBadConnection(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+
+	destination, err := client.AddInfraProtectSyslogDestination(0, &InfraProtectSyslogDestination{Name: "collector"})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error from Incapsula service when adding syslog destination") {
+		t.Errorf("Should have received a client error, got: %s", err)
+	}
+	if destination != nil {
+		t.Errorf("Should have received a nil syslog destination instance")
+	}
+}
+
+func TestClientAddInfraProtectSyslogDestinationBadJSON(t *testing.T) {
+	endpoint := fmt.Sprintf("/%s/%s?caid=42", endpointInfraProtect, endpointInfraProtectSyslogDestination)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		rw.Write([]byte(`{`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	destination, err := client.AddInfraProtectSyslogDestination(42, &InfraProtectSyslogDestination{Name: "collector"})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error parsing add syslog destination JSON response") {
+		t.Errorf("Should have received a JSON parse error, got: %s", err)
+	}
+	if destination != nil {
+		t.Errorf("Should have received a nil syslog destination instance")
+	}
+}
+
+func TestClientAddInfraProtectSyslogDestinationValid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		if !strings.Contains(string(body), `"tlsClientKey":"key"`) {
+			t.Errorf("Should have sent the client key. Got: %s", string(body))
+		}
+		rw.Write([]byte(`{"data":[{"id":"123","name":"collector","host":"syslog.example.com","port":6514,"transport":"TLS","format":"CEF","enabled":true,"tlsVerifyCertificate":true,"status":"CONNECTED"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	destination, err := client.AddInfraProtectSyslogDestination(0, &InfraProtectSyslogDestination{Name: "collector", Host: "syslog.example.com", Port: 6514, Transport: "TLS", Format: "CEF", TLSClientKey: "key"})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if destination == nil || destination.ID != "123" || destination.Status != "CONNECTED" || destination.TLSClientKey != "" {
+		t.Errorf("Unexpected syslog destination: %+v", destination)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// GetInfraProtectSyslogDestination Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetInfraProtectSyslogDestinationNotFound(t *testing.T) {
+	endpoint := fmt.Sprintf("/%s/%s/123", endpointInfraProtect, endpointInfraProtectSyslogDestination)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		rw.WriteHeader(404)
+		rw.Write([]byte(`{"errors":[{"status":"404","detail":"Syslog destination not found"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	destination, statusCode, err := client.GetInfraProtectSyslogDestination(0, "123")
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error status code 404 from Incapsula service when reading syslog destination 123") {
+		t.Errorf("Should have received a not found error, got: %s", err)
+	}
+	if statusCode != 404 {
+		t.Errorf("Should have received a 404 status code, got: %d", statusCode)
+	}
+	if destination != nil {
+		t.Errorf("Should have received a nil syslog destination instance")
+	}
+}
+
+func TestClientGetInfraProtectSyslogDestinationValid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"data":[{"id":"123","name":"collector","host":"192.0.2.10","port":514,"transport":"UDP","format":"JSON","enabled":false,"status":"DISABLED"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	destination, statusCode, err := client.GetInfraProtectSyslogDestination(0, "123")
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if statusCode != 200 {
+		t.Errorf("Should have received a 200 status code, got: %d", statusCode)
+	}
+	if destination == nil || destination.Port != 514 || destination.Transport != "UDP" || destination.Enabled {
+		t.Errorf("Unexpected syslog destination: %+v", destination)
+	}
+}
@@ -0,0 +1,55 @@
+package imperva
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestClientDoInfraProtectRequestErrors(t *testing.T) {
+	path := fmt.Sprintf("/%s/%s/123", endpointInfraProtect, endpointNetflowExporter)
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodGet, path,
+		mockAPIError(http.StatusInternalServerError, "Internal error"),
+		mockAPIError(http.StatusNotFound, "Exporter not found"),
+	)
+	client := api.client()
+
+	_, statusCode, err := client.GetNetflowExporter(42, "123")
+	if statusCode != 500 || err == nil || !strings.HasPrefix(err.Error(), "Error status code 500 from Incapsula service when reading netflow exporter 123") {
+		t.Errorf("Should have received a 500 error, got: %d, %s", statusCode, err)
+	}
+
+	_, statusCode, err = client.GetNetflowExporter(42, "123")
+	if statusCode != 404 || err == nil {
+		t.Errorf("Should have received a 404 error, got: %d, %s", statusCode, err)
+	}
+
+	for _, request := range api.requestsTo(http.MethodGet, path) {
+		if request.Query.Get("caid") != "42" {
+			t.Errorf("Should have sent the account ID as caid, got: %v", request.Query)
+		}
+	}
+}
+
+func TestClientDoInfraProtectRequestDoesNotLogSecrets(t *testing.T) {
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodPost, fmt.Sprintf("/%s/%s", endpointInfraProtect, endpointBGPConnection), mockJSON(`{"data":[{"id":"1","name":"router"}]}`))
+
+	md5Password := "secret"
+	_, err := api.client().AddBGPConnection(42, &BGPConnection{Name: "router", MD5Password: &md5Password})
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if strings.Contains(logs.String(), "secret") {
+		t.Errorf("Should not have logged the MD5 password, got: %s", logs.String())
+	}
+}
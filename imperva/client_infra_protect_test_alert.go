@@ -0,0 +1,51 @@
+package imperva
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+)
+
+// Endpoints (unexported consts)
+const endpointInfraProtectTestAlert = "test-alerts"
+
+// InfraProtectTestAlert is a request to send a simulated Infrastructure Protection alert
+type InfraProtectTestAlert struct {
+	AlertType string `json:"alertType"`
+	IPRangeID string `json:"ipRangeId,omitempty"`
+}
+
+// InfraProtectTestAlertResult is the outcome of a simulated Infrastructure Protection alert
+type InfraProtectTestAlertResult struct {
+	ID          string `json:"id"`
+	Status      string `json:"status"`
+	Message     string `json:"message"`
+	TriggeredAt int64  `json:"triggeredAt"`
+}
+
+// InfraProtectTestAlertResponse contains the result returned by the API
+type InfraProtectTestAlertResponse struct {
+	Data []InfraProtectTestAlertResult `json:"data"`
+}
+
+// TriggerInfraProtectTestAlert sends a simulated Infrastructure Protection alert to the notification recipients of an account
+func (c *Client) TriggerInfraProtectTestAlert(accountID int, alert *InfraProtectTestAlert) (*InfraProtectTestAlertResult, error) {
+	log.Printf("[INFO] Triggering Incapsula Infrastructure Protection test alert %s for account %d\n", alert.AlertType, accountID)
+
+	responseBody, _, err := c.doInfraProtectRequest(http.MethodPost, endpointInfraProtectTestAlert, accountID, alert, CreateInfraProtectTestAlert, "triggering test alert")
+	if err != nil {
+		return nil, err
+	}
+
+	var testAlertResponse InfraProtectTestAlertResponse
+	err = decodeJSONResponse(fmt.Sprintf("%s/%s", endpointInfraProtect, endpointInfraProtectTestAlert), responseBody, &testAlertResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing trigger test alert JSON response: %s\nresponse: %s", err, string(responseBody))
+	}
+
+	if len(testAlertResponse.Data) == 0 {
+		return nil, fmt.Errorf("Error parsing trigger test alert JSON response: no result returned\nresponse: %s", string(responseBody))
+	}
+
+	return &testAlertResponse.Data[0], nil
+}
@@ -0,0 +1,100 @@
+package imperva
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////
+// TriggerInfraProtectTestAlert Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientTriggerInfraProtectTestAlertBadConnection(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+
+	result, err := client.TriggerInfraProtectTestAlert(0, &InfraProtectTestAlert{AlertType: "DDOS_START"})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error from Incapsula service when triggering test alert") {
+		t.Errorf("Should have received a client error, got: %s", err)
+	}
+	if result != nil {
+		t.Errorf("Should have received a nil result instance")
+	}
+}
+
+func TestClientTriggerInfraProtectTestAlertBadJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	result, err := client.TriggerInfraProtectTestAlert(0, &InfraProtectTestAlert{AlertType: "DDOS_START"})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error parsing trigger test alert JSON response") {
+		t.Errorf("Should have received a JSON parse error, got: %s", err)
+	}
+	if result != nil {
+		t.Errorf("Should have received a nil result instance")
+	}
+}
+
+func TestClientTriggerInfraProtectTestAlertInvalid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(429)
+		rw.Write([]byte(`{"errors":[{"status":"429","detail":"Too many test alerts"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	result, err := client.TriggerInfraProtectTestAlert(0, &InfraProtectTestAlert{AlertType: "DDOS_START"})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error status code 429 from Incapsula service when triggering test alert") {
+		t.Errorf("Should have received a status code error, got: %s", err)
+	}
+	if result != nil {
+		t.Errorf("Should have received a nil result instance")
+	}
+}
+
+func TestClientTriggerInfraProtectTestAlertValid(t *testing.T) {
+	endpoint := fmt.Sprintf("/%s/%s?caid=42", endpointInfraProtect, endpointInfraProtectTestAlert)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		if string(body) != `{"alertType":"DDOS_STOP","ipRangeId":"7"}` {
+			t.Errorf("Unexpected request body: %s", string(body))
+		}
+		rw.Write([]byte(`{"data":[{"id":"abc","status":"SENT","message":"Alert sent to 2 recipients","triggeredAt":1700000000000}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	result, err := client.TriggerInfraProtectTestAlert(42, &InfraProtectTestAlert{AlertType: "DDOS_STOP", IPRangeID: "7"})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if result == nil || result.ID != "abc" || result.Status != "SENT" || result.TriggeredAt != 1700000000000 {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+}
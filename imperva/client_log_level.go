@@ -1,7 +1,6 @@
-package incapsula
+package imperva
 
 import (
-	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -44,14 +43,14 @@ func (c *Client) UpdateLogLevel(siteID, logLevel, logsAccountId string) error {
 
 	// Parse the JSON
 	var logLevelResponse LogLevelResponse
-	err = json.Unmarshal([]byte(responseBody), &logLevelResponse)
+	err = decodeJSONResponse(endpointSiteLogLevel, responseBody, &logLevelResponse)
 	if err != nil {
 		return fmt.Errorf("Error parsing update log level JSON response for siteID %s: %s", siteID, err)
 	}
 
 	// Look at the response status code from Incapsula
 	if logLevelResponse.Res != 0 {
-		return fmt.Errorf("Error from Incapsula service when updating log level for siteID %s: %s", siteID, string(responseBody))
+		return NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when updating log level for siteID %s: %s", siteID, string(responseBody))
 	}
 
 	return nil
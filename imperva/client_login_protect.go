@@ -0,0 +1,140 @@
+package imperva
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const endpointLoginProtect = "sites/configure/loginProtect"
+
+// LoginProtect contains the Login Protect (two-factor authentication) settings of a site
+type LoginProtect struct {
+	Enabled               bool
+	AllowAllUsers         bool
+	SpecificUsers         []string
+	SendLPNotifications   bool
+	AuthenticationMethods []string
+}
+
+// LoginProtectURL is a URL of the site protected by Login Protect, matched according to the pattern
+type LoginProtectURL struct {
+	URL     string
+	Pattern string
+}
+
+// LoginProtectResponse contains the response code when configuring Login Protect
+type LoginProtectResponse struct {
+	Res        FlexibleInt `json:"res"`
+	ResMessage string      `json:"res_message"`
+	DebugInfo  struct {
+		IDInfo string `json:"id-info"`
+	} `json:"debug_info"`
+}
+
+// ConfigureLoginProtect sets the Login Protect settings of the site, the protected URLs are left unchanged
+func (c *Client) ConfigureLoginProtect(siteID int, loginProtect *LoginProtect) error {
+	log.Printf("[INFO] Configuring Incapsula Login Protect for site id: %d\n", siteID)
+
+	values := url.Values{
+		"site_id":                {strconv.Itoa(siteID)},
+		"enabled":                {strconv.FormatBool(loginProtect.Enabled)},
+		"allow_all_users":        {strconv.FormatBool(loginProtect.AllowAllUsers)},
+		"specific_users_list":    {strings.Join(loginProtect.SpecificUsers, ",")},
+		"send_lp_notifications":  {strconv.FormatBool(loginProtect.SendLPNotifications)},
+		"authentication_methods": {strings.Join(loginProtect.AuthenticationMethods, ",")},
+	}
+	return c.postLoginProtect(siteID, values)
+}
+
+// ConfigureLoginProtectURLs sets the URLs of the site protected by Login Protect, replacing the current ones
+func (c *Client) ConfigureLoginProtectURLs(siteID int, loginProtectURLs []LoginProtectURL) error {
+	log.Printf("[INFO] Configuring Incapsula Login Protect URLs for site id: %d\n", siteID)
+
+	urls := make([]string, len(loginProtectURLs))
+	urlPatterns := make([]string, len(loginProtectURLs))
+	for i, loginProtectURL := range loginProtectURLs {
+		urls[i] = loginProtectURL.URL
+		urlPatterns[i] = loginProtectURL.Pattern
+	}
+
+	values := url.Values{
+		"site_id":      {strconv.Itoa(siteID)},
+		"urls":         {strings.Join(urls, ",")},
+		"url_patterns": {strings.Join(urlPatterns, ",")},
+	}
+	return c.postLoginProtect(siteID, values)
+}
+
+func (c *Client) postLoginProtect(siteID int, values url.Values) error {
+	// Post form to Incapsula
+	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointLoginProtect)
+	resp, err := c.PostFormWithHeaders(reqURL, values, UpdateLoginProtect)
+	if err != nil {
+		return fmt.Errorf("Error configuring Login Protect for site id %d: %s", siteID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula configure Login Protect JSON response: %s\n", string(responseBody))
+
+	// Parse the JSON
+	var loginProtectResponse LoginProtectResponse
+	err = decodeJSONResponse(endpointLoginProtect, responseBody, &loginProtectResponse)
+	if err != nil {
+		return fmt.Errorf("Error parsing configure Login Protect JSON response for site id %d: %s", siteID, err)
+	}
+
+	// Look at the response status code from Incapsula
+	if loginProtectResponse.Res != 0 {
+		return NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when configuring Login Protect for site id %d: %s", siteID, string(responseBody))
+	}
+
+	return nil
+}
+
+// LoginProtectUserEmails gets the emails of the specific users of the site status, listed as users or emails
+func LoginProtectUserEmails(specificUsersList []interface{}) []string {
+	emails := make([]string, 0, len(specificUsersList))
+	for _, user := range specificUsersList {
+		switch typedUser := user.(type) {
+		case string:
+			emails = append(emails, typedUser)
+		case map[string]interface{}:
+			if email, ok := typedUser["email"].(string); ok {
+				emails = append(emails, email)
+			}
+		}
+	}
+	return emails
+}
+
+// LoginProtectURLs gets the protected URLs of the site status, listed as URLs with the patterns in a separate list
+// or as objects with their pattern
+func LoginProtectURLs(urls, urlPatterns []interface{}) []LoginProtectURL {
+	loginProtectURLs := make([]LoginProtectURL, 0, len(urls))
+	for i, item := range urls {
+		var loginProtectURL LoginProtectURL
+		switch typedItem := item.(type) {
+		case string:
+			loginProtectURL.URL = typedItem
+			if i < len(urlPatterns) {
+				loginProtectURL.Pattern, _ = urlPatterns[i].(string)
+			}
+		case map[string]interface{}:
+			loginProtectURL.URL, _ = typedItem["value"].(string)
+			loginProtectURL.Pattern, _ = typedItem["pattern"].(string)
+		}
+		if loginProtectURL.URL != "" {
+			loginProtectURL.Pattern = strings.ToLower(loginProtectURL.Pattern)
+			loginProtectURLs = append(loginProtectURLs, loginProtectURL)
+		}
+	}
+	return loginProtectURLs
+}
@@ -0,0 +1,85 @@
+package imperva
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestClientConfigureLoginProtect(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodPost, "/"+endpointLoginProtect, mockRes(0, "OK"))
+
+	err := api.client().ConfigureLoginProtect(123, &LoginProtect{
+		Enabled:               true,
+		SpecificUsers:         []string{"a@example.com", "b@example.com"},
+		AuthenticationMethods: []string{"ga", "email"},
+	})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+
+	requests := api.requestsTo(http.MethodPost, "/"+endpointLoginProtect)
+	if len(requests) != 1 {
+		t.Fatalf("Should have configured Login Protect once, got: %d requests", len(requests))
+	}
+	form := requests[0].Form
+	if form.Get("site_id") != "123" || form.Get("enabled") != "true" || form.Get("allow_all_users") != "false" ||
+		form.Get("specific_users_list") != "a@example.com,b@example.com" || form.Get("authentication_methods") != "ga,email" {
+		t.Errorf("Should have sent the settings, got: %v", form)
+	}
+}
+
+func TestClientConfigureLoginProtectInvalidSite(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodPost, "/"+endpointLoginProtect, mockRes(9413, "Unknown/unauthorized site_id"))
+
+	err := api.client().ConfigureLoginProtect(123, &LoginProtect{})
+	if err == nil || !strings.HasPrefix(err.Error(), "Error from Incapsula service when configuring Login Protect for site id 123") {
+		t.Errorf("Should have received an error, got: %v", err)
+	}
+}
+
+func TestLoginProtectUserEmails(t *testing.T) {
+	emails := LoginProtectUserEmails([]interface{}{
+		map[string]interface{}{"email": "a@example.com", "name": "A", "status": "ACTIVATED"},
+		"b@example.com",
+		map[string]interface{}{"name": "No email"},
+	})
+	if !reflect.DeepEqual(emails, []string{"a@example.com", "b@example.com"}) {
+		t.Errorf("Should have received the emails, got: %v", emails)
+	}
+}
+
+func TestClientConfigureLoginProtectURLs(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodPost, "/"+endpointLoginProtect, mockRes(0, "OK"))
+
+	err := api.client().ConfigureLoginProtectURLs(123, []LoginProtectURL{{URL: "/admin", Pattern: "prefix"}, {URL: "/login.php", Pattern: "equals"}})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+
+	form := api.requestsTo(http.MethodPost, "/"+endpointLoginProtect)[0].Form
+	if form.Get("urls") != "/admin,/login.php" || form.Get("url_patterns") != "prefix,equals" || form.Get("enabled") != "" {
+		t.Errorf("Should have only sent the URLs, got: %v", form)
+	}
+}
+
+func TestLoginProtectURLs(t *testing.T) {
+	expected := []LoginProtectURL{{URL: "/admin", Pattern: "prefix"}, {URL: "/login.php", Pattern: "equals"}}
+
+	urls := LoginProtectURLs([]interface{}{"/admin", "/login.php"}, []interface{}{"PREFIX", "equals"})
+	if !reflect.DeepEqual(urls, expected) {
+		t.Errorf("Should have received the URLs, got: %+v", urls)
+	}
+
+	urls = LoginProtectURLs([]interface{}{
+		map[string]interface{}{"value": "/admin", "pattern": "prefix"},
+		map[string]interface{}{"value": "/login.php", "pattern": "EQUALS"},
+	}, nil)
+	if !reflect.DeepEqual(urls, expected) {
+		t.Errorf("Should have received the URLs, got: %+v", urls)
+	}
+}
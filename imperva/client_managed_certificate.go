@@ -0,0 +1,169 @@
+package imperva
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+)
+
+// Endpoints (unexported consts)
+const endpointManagedCertificates = "certificates-ui/v3/certificates"
+const endpointManagedCertificateInstructions = "certificates-ui/v3/instructions"
+
+// managedCertificateType is the type of the certificates Imperva generates and renews for the sites
+const managedCertificateType = "ATLAS"
+
+// ManagedCertificateDomain is a domain (SAN) of the certificate Imperva manages for a site
+type ManagedCertificateDomain struct {
+	SanID            int    `json:"sanId"`
+	SanValue         string `json:"sanValue"`
+	ValidationMethod string `json:"validationMethod"`
+	Status           string `json:"status"`
+}
+
+// ManagedCertificate is the certificate Imperva manages for a site, with its domains
+type ManagedCertificate struct {
+	ID   int                        `json:"id"`
+	Sans []ManagedCertificateDomain `json:"sans"`
+}
+
+// ManagedCertificatesDTO is the response listing the managed certificates of a site
+type ManagedCertificatesDTO struct {
+	Errors []ApiError           `json:"errors,omitempty"`
+	Data   []ManagedCertificate `json:"data"`
+}
+
+// ManagedCertificateValidationInstruction is what the owner of a domain has to do for its validation, depending on the
+// validation method: the DNS record to add, the file to serve or the addresses the approval email can be sent to
+type ManagedCertificateValidationInstruction struct {
+	Domain           string   `json:"domain"`
+	ValidationMethod string   `json:"validationMethod"`
+	RecordType       string   `json:"recordType,omitempty"`
+	RecordName       string   `json:"recordName,omitempty"`
+	VerificationCode string   `json:"verificationCode,omitempty"`
+	FilePath         string   `json:"filePath,omitempty"`
+	ApproverEmails   []string `json:"approverEmails,omitempty"`
+}
+
+// ManagedCertificateValidationInstructionsDTO is the response with the validation instructions of the domains of a site
+type ManagedCertificateValidationInstructionsDTO struct {
+	Errors []ApiError                                `json:"errors,omitempty"`
+	Data   []ManagedCertificateValidationInstruction `json:"data"`
+}
+
+// ListManagedCertificates gets the certificates Imperva manages for the site, along with the status code of the response
+func (c *Client) ListManagedCertificates(siteID int) ([]ManagedCertificate, int, error) {
+	log.Printf("[INFO] Listing Incapsula managed certificates for site id: %d\n", siteID)
+
+	values := url.Values{
+		"extSiteId": {fmt.Sprint(siteID)},
+		"certType":  {managedCertificateType},
+	}
+	reqURL := fmt.Sprintf("%s/%s?%s", c.config.BaseURLAPI, endpointManagedCertificates, values.Encode())
+	resp, err := c.DoJsonRequestWithHeaders(http.MethodGet, reqURL, nil, ReadManagedCertificates)
+	if err != nil {
+		return nil, 0, fmt.Errorf("Error from Incapsula service when listing managed certificates for site id %d: %s", siteID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula List managed certificates JSON response: %s\n", string(responseBody))
+
+	// Check the response code
+	if resp.StatusCode != 200 {
+		return nil, resp.StatusCode, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when listing managed certificates for site id %d: %s", resp.StatusCode, siteID, string(responseBody))
+	}
+
+	// Parse the JSON
+	var responseDTO ManagedCertificatesDTO
+	err = decodeJSONResponse(endpointManagedCertificates, responseBody, &responseDTO)
+	if err != nil {
+		return nil, resp.StatusCode, fmt.Errorf("Error parsing managed certificates JSON response for site id %d: %s\nresponse: %s", siteID, err, string(responseBody))
+	}
+
+	if len(responseDTO.Errors) > 0 {
+		return nil, resp.StatusCode, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when listing managed certificates for site id %d: %s", siteID, string(responseBody))
+	}
+
+	return responseDTO.Data, resp.StatusCode, nil
+}
+
+// UpdateManagedCertificateDomainValidationMethod sets the validation method (CNAME, DNS, EMAIL or HTML) of a domain
+// of the managed certificate, a new validation of the domain starts with it
+func (c *Client) UpdateManagedCertificateDomainValidationMethod(siteID, certificateID, sanID int, validationMethod string) error {
+	log.Printf("[INFO] Updating Incapsula managed certificate %d domain %d validation method (%s) for site id: %d\n", certificateID, sanID, validationMethod, siteID)
+
+	requestJSON, err := json.Marshal(map[string]string{"validationMethod": validationMethod})
+	if err != nil {
+		return fmt.Errorf("Failed to JSON marshal validation method: %s", err)
+	}
+
+	values := url.Values{"extSiteId": {fmt.Sprint(siteID)}}
+	reqURL := fmt.Sprintf("%s/%s/%d/sans/%d?%s", c.config.BaseURLAPI, endpointManagedCertificates, certificateID, sanID, values.Encode())
+	resp, err := c.DoJsonRequestWithHeaders(http.MethodPut, reqURL, requestJSON, UpdateManagedCertificateDomainValidation)
+	if err != nil {
+		return fmt.Errorf("Error from Incapsula service when updating managed certificate %d domain %d validation method for site id %d: %s", certificateID, sanID, siteID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula Update managed certificate domain validation method JSON response: %s\n", string(responseBody))
+
+	// Check the response code
+	if resp.StatusCode != 200 {
+		return NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when updating managed certificate %d domain %d validation method for site id %d: %s", resp.StatusCode, certificateID, sanID, siteID, string(responseBody))
+	}
+
+	return nil
+}
+
+// GetManagedCertificateValidationInstructions gets the validation instructions of the domains of the site validated
+// with the validation method
+func (c *Client) GetManagedCertificateValidationInstructions(siteID int, validationMethod string) ([]ManagedCertificateValidationInstruction, error) {
+	log.Printf("[INFO] Getting Incapsula managed certificate validation instructions (%s) for site id: %d\n", validationMethod, siteID)
+
+	values := url.Values{
+		"extSiteId":        {fmt.Sprint(siteID)},
+		"validationMethod": {validationMethod},
+		"certificateType":  {managedCertificateType},
+	}
+	reqURL := fmt.Sprintf("%s/%s?%s", c.config.BaseURLAPI, endpointManagedCertificateInstructions, values.Encode())
+	resp, err := c.DoJsonRequestWithHeaders(http.MethodGet, reqURL, nil, ReadManagedCertificateValidationInstructions)
+	if err != nil {
+		return nil, fmt.Errorf("Error from Incapsula service when getting managed certificate validation instructions for site id %d: %s", siteID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula Get managed certificate validation instructions JSON response: %s\n", string(responseBody))
+
+	// Check the response code
+	if resp.StatusCode != 200 {
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when getting managed certificate validation instructions for site id %d: %s", resp.StatusCode, siteID, string(responseBody))
+	}
+
+	// Parse the JSON
+	var responseDTO ManagedCertificateValidationInstructionsDTO
+	err = decodeJSONResponse(endpointManagedCertificateInstructions, responseBody, &responseDTO)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing managed certificate validation instructions JSON response for site id %d: %s\nresponse: %s", siteID, err, string(responseBody))
+	}
+
+	if len(responseDTO.Errors) > 0 {
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when getting managed certificate validation instructions for site id %d: %s", siteID, string(responseBody))
+	}
+
+	return responseDTO.Data, nil
+}
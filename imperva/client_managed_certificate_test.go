@@ -0,0 +1,95 @@
+package imperva
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const managedCertificatesPath = "/certificates-ui/v3/certificates"
+const managedCertificateInstructionsPath = "/certificates-ui/v3/instructions"
+
+func TestClientListManagedCertificates(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodGet, managedCertificatesPath, mockJSON(`{"data":[{"id":77,"sans":[
+		{"sanId":1,"sanValue":"www.example.com","validationMethod":"EMAIL","status":"PENDING_USER_ACTION"},
+		{"sanId":2,"sanValue":"*.example.com","validationMethod":"DNS","status":"VALIDATED"}]}]}`))
+
+	certificates, statusCode, err := api.client().ListManagedCertificates(123)
+	if err != nil || statusCode != http.StatusOK {
+		t.Fatalf("Should not have received an error, got: %d, %v", statusCode, err)
+	}
+	if len(certificates) != 1 || certificates[0].ID != 77 || len(certificates[0].Sans) != 2 || certificates[0].Sans[1].SanValue != "*.example.com" {
+		t.Errorf("Should have received the certificates, got: %+v", certificates)
+	}
+
+	query := api.requestsTo(http.MethodGet, managedCertificatesPath)[0].Query
+	if query.Get("extSiteId") != "123" || query.Get("certType") != "ATLAS" {
+		t.Errorf("Should have listed the managed certificates of the site, got: %v", query)
+	}
+}
+
+func TestClientListManagedCertificatesNotFound(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodGet, managedCertificatesPath, mockAPIError(http.StatusNotFound, "Site not found"))
+
+	certificates, statusCode, err := api.client().ListManagedCertificates(123)
+	if err == nil || statusCode != http.StatusNotFound || certificates != nil {
+		t.Errorf("Should have received a not found error, got: %+v, %d, %v", certificates, statusCode, err)
+	}
+}
+
+func TestClientUpdateManagedCertificateDomainValidationMethod(t *testing.T) {
+	path := managedCertificatesPath + "/77/sans/1"
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodPut, path, mockJSON(`{"data":[]}`))
+
+	err := api.client().UpdateManagedCertificateDomainValidationMethod(123, 77, 1, "DNS")
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+
+	request := api.requestsTo(http.MethodPut, path)[0]
+	if request.Body != `{"validationMethod":"DNS"}` || request.Query.Get("extSiteId") != "123" {
+		t.Errorf("Should have sent the validation method, got: %s, %v", request.Body, request.Query)
+	}
+}
+
+func TestClientUpdateManagedCertificateDomainValidationMethodError(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodPut, managedCertificatesPath+"/77/sans/1", mockAPIError(http.StatusBadRequest, "HTML validation is not supported for wildcard domains"))
+
+	err := api.client().UpdateManagedCertificateDomainValidationMethod(123, 77, 1, "HTML")
+	if err == nil || !strings.Contains(err.Error(), "not supported for wildcard domains") {
+		t.Errorf("Should have received the error, got: %v", err)
+	}
+}
+
+func TestClientGetManagedCertificateValidationInstructions(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodGet, managedCertificateInstructionsPath, mockJSON(`{"data":[
+		{"domain":"www.example.com","validationMethod":"DNS","recordType":"TXT","recordName":"example.com","verificationCode":"globalsign-domain-verification=abc"}]}`))
+
+	instructions, err := api.client().GetManagedCertificateValidationInstructions(123, "DNS")
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if len(instructions) != 1 || instructions[0].RecordType != "TXT" || instructions[0].VerificationCode != "globalsign-domain-verification=abc" {
+		t.Errorf("Should have received the instructions, got: %+v", instructions)
+	}
+
+	query := api.requestsTo(http.MethodGet, managedCertificateInstructionsPath)[0].Query
+	if query.Get("extSiteId") != "123" || query.Get("validationMethod") != "DNS" {
+		t.Errorf("Should have requested the instructions of the validation method, got: %v", query)
+	}
+}
+
+func TestClientGetManagedCertificateValidationInstructionsErrors(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodGet, managedCertificateInstructionsPath, mockJSON(`{"errors":[{"status":"400","message":"Invalid validation method"}],"data":[]}`))
+
+	_, err := api.client().GetManagedCertificateValidationInstructions(123, "FAX")
+	if err == nil || !strings.Contains(err.Error(), "Invalid validation method") {
+		t.Errorf("Should have received the error, got: %v", err)
+	}
+}
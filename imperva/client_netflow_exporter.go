@@ -0,0 +1,88 @@
+package imperva
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+)
+
+// Endpoints (unexported consts)
+const endpointNetflowExporter = "netflow-exporters"
+
+// NetflowExporter is a flow exporter sending Infrastructure Protection flow data to a collector
+type NetflowExporter struct {
+	ID              string `json:"id,omitempty"`
+	Name            string `json:"name"`
+	CollectorIP     string `json:"collectorIp"`
+	Port            int    `json:"port"`
+	SamplingRate    int    `json:"samplingRate"`
+	ProtocolVersion string `json:"protocolVersion"`
+	Enabled         bool   `json:"enabled"`
+}
+
+// NetflowExporterResponse contains the netflow exporter returned by the API
+type NetflowExporterResponse struct {
+	Data []NetflowExporter `json:"data"`
+}
+
+// AddNetflowExporter adds a netflow exporter to an account
+func (c *Client) AddNetflowExporter(accountID int, exporter *NetflowExporter) (*NetflowExporter, error) {
+	log.Printf("[INFO] Adding Incapsula netflow exporter %s for account %d\n", exporter.Name, accountID)
+
+	responseBody, _, err := c.doInfraProtectRequest(http.MethodPost, endpointNetflowExporter, accountID, exporter, CreateNetflowExporter, "adding netflow exporter")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseNetflowExporterResponse(responseBody, "add netflow exporter")
+}
+
+// GetNetflowExporter gets a netflow exporter, along with the status code of the response
+func (c *Client) GetNetflowExporter(accountID int, exporterID string) (*NetflowExporter, int, error) {
+	log.Printf("[INFO] Getting Incapsula netflow exporter %s for account %d\n", exporterID, accountID)
+
+	path := fmt.Sprintf("%s/%s", endpointNetflowExporter, exporterID)
+	responseBody, statusCode, err := c.doInfraProtectRequest(http.MethodGet, path, accountID, nil, ReadNetflowExporter, fmt.Sprintf("reading netflow exporter %s", exporterID))
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	exporter, err := parseNetflowExporterResponse(responseBody, fmt.Sprintf("read netflow exporter %s", exporterID))
+	return exporter, statusCode, err
+}
+
+// UpdateNetflowExporter updates a netflow exporter
+func (c *Client) UpdateNetflowExporter(accountID int, exporterID string, exporter *NetflowExporter) (*NetflowExporter, error) {
+	log.Printf("[INFO] Updating Incapsula netflow exporter %s for account %d\n", exporterID, accountID)
+
+	path := fmt.Sprintf("%s/%s", endpointNetflowExporter, exporterID)
+	responseBody, _, err := c.doInfraProtectRequest(http.MethodPut, path, accountID, exporter, UpdateNetflowExporter, fmt.Sprintf("updating netflow exporter %s", exporterID))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseNetflowExporterResponse(responseBody, fmt.Sprintf("update netflow exporter %s", exporterID))
+}
+
+// DeleteNetflowExporter deletes a netflow exporter
+func (c *Client) DeleteNetflowExporter(accountID int, exporterID string) error {
+	log.Printf("[INFO] Deleting Incapsula netflow exporter %s for account %d\n", exporterID, accountID)
+
+	path := fmt.Sprintf("%s/%s", endpointNetflowExporter, exporterID)
+	_, _, err := c.doInfraProtectRequest(http.MethodDelete, path, accountID, nil, DeleteNetflowExporter, fmt.Sprintf("deleting netflow exporter %s", exporterID))
+	return err
+}
+
+func parseNetflowExporterResponse(responseBody []byte, action string) (*NetflowExporter, error) {
+	var netflowExporterResponse NetflowExporterResponse
+	err := decodeJSONResponse(fmt.Sprintf("%s/%s", endpointInfraProtect, endpointNetflowExporter), responseBody, &netflowExporterResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %s JSON response: %s\nresponse: %s", action, err, string(responseBody))
+	}
+
+	if len(netflowExporterResponse.Data) == 0 {
+		return nil, fmt.Errorf("Error parsing %s JSON response: no netflow exporter returned\nresponse: %s", action, string(responseBody))
+	}
+
+	return &netflowExporterResponse.Data[0], nil
+}
@@ -0,0 +1,152 @@
+package imperva
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////
+// AddNetflowExporter Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientAddNetflowExporterBadConnection(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+
+	exporter, err := client.AddNetflowExporter(0, &NetflowExporter{Name: "collector"})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error from Incapsula service when adding netflow exporter") {
+		t.Errorf("Should have received a client error, got: %s", err)
+	}
+	if exporter != nil {
+		t.Errorf("Should have received a nil exporter instance")
+	}
+}
+
+func TestClientAddNetflowExporterBadJSON(t *testing.T) {
+	endpoint := fmt.Sprintf("/%s/%s?caid=42", endpointInfraProtect, endpointNetflowExporter)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		rw.Write([]byte(`{`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	exporter, err := client.AddNetflowExporter(42, &NetflowExporter{Name: "collector"})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error parsing add netflow exporter JSON response") {
+		t.Errorf("Should have received a JSON parse error, got: %s", err)
+	}
+	if exporter != nil {
+		t.Errorf("Should have received a nil exporter instance")
+	}
+}
+
+func TestClientAddNetflowExporterValid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			t.Errorf("Should have sent a POST request. Got: %s", req.Method)
+		}
+		rw.Write([]byte(`{"data":[{"id":"123","name":"collector","collectorIp":"1.2.3.4","port":2055,"samplingRate":1,"protocolVersion":"IPFIX","enabled":true}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	exporter, err := client.AddNetflowExporter(0, &NetflowExporter{Name: "collector"})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if exporter == nil || exporter.ID != "123" || exporter.Port != 2055 {
+		t.Errorf("Should have received exporter 123, got: %+v", exporter)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// GetNetflowExporter Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetNetflowExporterNotFound(t *testing.T) {
+	endpoint := fmt.Sprintf("/%s/%s/123", endpointInfraProtect, endpointNetflowExporter)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		rw.WriteHeader(404)
+		rw.Write([]byte(`{"errors":[{"status":"404","detail":"Exporter not found"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	exporter, statusCode, err := client.GetNetflowExporter(0, "123")
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error status code 404 from Incapsula service when reading netflow exporter 123") {
+		t.Errorf("Should have received a not found error, got: %s", err)
+	}
+	if statusCode != 404 {
+		t.Errorf("Should have received a 404 status code, got: %d", statusCode)
+	}
+	if exporter != nil {
+		t.Errorf("Should have received a nil exporter instance")
+	}
+}
+
+func TestClientGetNetflowExporterValid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"data":[{"id":"123","name":"collector","collectorIp":"1.2.3.4","port":2055,"samplingRate":100,"protocolVersion":"NETFLOW_V9","enabled":false}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	exporter, statusCode, err := client.GetNetflowExporter(0, "123")
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if statusCode != 200 {
+		t.Errorf("Should have received a 200 status code, got: %d", statusCode)
+	}
+	if exporter == nil || exporter.SamplingRate != 100 || exporter.ProtocolVersion != "NETFLOW_V9" || exporter.Enabled {
+		t.Errorf("Unexpected exporter: %+v", exporter)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// DeleteNetflowExporter Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientDeleteNetflowExporterValid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodDelete {
+			t.Errorf("Should have sent a DELETE request. Got: %s", req.Method)
+		}
+		rw.Write([]byte(`{"data":[]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	err := client.DeleteNetflowExporter(0, "123")
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
@@ -0,0 +1,64 @@
+package imperva
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+)
+
+// Endpoints (unexported consts)
+const endpointNetworkDDoSSettings = "ddos-settings"
+
+// NetworkDDoSSettings contains the DDoS mitigation settings of a protected IP range.
+// Thresholds are in bits per second and packets per second, 0 means the Imperva default.
+type NetworkDDoSSettings struct {
+	Mode                   string `json:"mode"`
+	BandwidthThresholdBps  int64  `json:"bandwidthThresholdBps,omitempty"`
+	PacketRateThresholdPps int64  `json:"packetRateThresholdPps,omitempty"`
+}
+
+// NetworkDDoSSettingsResponse contains the DDoS mitigation settings returned by the API
+type NetworkDDoSSettingsResponse struct {
+	Data []NetworkDDoSSettings `json:"data"`
+}
+
+// GetNetworkDDoSSettings gets the DDoS mitigation settings of a protected IP range, along with the status code of the response
+func (c *Client) GetNetworkDDoSSettings(accountID int, rangeID string) (*NetworkDDoSSettings, int, error) {
+	log.Printf("[INFO] Getting Incapsula network DDoS settings for protected IP range %s\n", rangeID)
+
+	path := fmt.Sprintf("%s/%s/%s", endpointProtectedIPRange, rangeID, endpointNetworkDDoSSettings)
+	responseBody, statusCode, err := c.doInfraProtectRequest(http.MethodGet, path, accountID, nil, ReadNetworkDDoSSettings, fmt.Sprintf("reading network DDoS settings for protected IP range %s", rangeID))
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	settings, err := parseNetworkDDoSSettingsResponse(responseBody, fmt.Sprintf("read network DDoS settings for protected IP range %s", rangeID))
+	return settings, statusCode, err
+}
+
+// UpdateNetworkDDoSSettings updates the DDoS mitigation settings of a protected IP range
+func (c *Client) UpdateNetworkDDoSSettings(accountID int, rangeID string, settings *NetworkDDoSSettings) (*NetworkDDoSSettings, error) {
+	log.Printf("[INFO] Updating Incapsula network DDoS settings for protected IP range %s\n", rangeID)
+
+	path := fmt.Sprintf("%s/%s/%s", endpointProtectedIPRange, rangeID, endpointNetworkDDoSSettings)
+	responseBody, _, err := c.doInfraProtectRequest(http.MethodPut, path, accountID, settings, UpdateNetworkDDoSSettings, fmt.Sprintf("updating network DDoS settings for protected IP range %s", rangeID))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseNetworkDDoSSettingsResponse(responseBody, fmt.Sprintf("update network DDoS settings for protected IP range %s", rangeID))
+}
+
+func parseNetworkDDoSSettingsResponse(responseBody []byte, action string) (*NetworkDDoSSettings, error) {
+	var settingsResponse NetworkDDoSSettingsResponse
+	err := decodeJSONResponse(fmt.Sprintf("%s/%s", endpointInfraProtect, endpointNetworkDDoSSettings), responseBody, &settingsResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %s JSON response: %s\nresponse: %s", action, err, string(responseBody))
+	}
+
+	if len(settingsResponse.Data) == 0 {
+		return nil, fmt.Errorf("Error parsing %s JSON response: no settings returned\nresponse: %s", action, string(responseBody))
+	}
+
+	return &settingsResponse.Data[0], nil
+}
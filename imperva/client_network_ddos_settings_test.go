@@ -0,0 +1,125 @@
+package imperva
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////
+// GetNetworkDDoSSettings Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetNetworkDDoSSettingsBadConnection(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+
+	settings, _, err := client.GetNetworkDDoSSettings(0, "123")
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error from Incapsula service when reading network DDoS settings for protected IP range 123") {
+		t.Errorf("Should have received a client error, got: %s", err)
+	}
+	if settings != nil {
+		t.Errorf("Should have received a nil settings instance")
+	}
+}
+
+func TestClientGetNetworkDDoSSettingsBadJSON(t *testing.T) {
+	endpoint := fmt.Sprintf("/%s/%s/123/%s", endpointInfraProtect, endpointProtectedIPRange, endpointNetworkDDoSSettings)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		rw.Write([]byte(`{`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	settings, _, err := client.GetNetworkDDoSSettings(0, "123")
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error parsing read network DDoS settings for protected IP range 123 JSON response") {
+		t.Errorf("Should have received a JSON parse error, got: %s", err)
+	}
+	if settings != nil {
+		t.Errorf("Should have received a nil settings instance")
+	}
+}
+
+func TestClientGetNetworkDDoSSettingsValid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"data":[{"mode":"ON_DEMAND","bandwidthThresholdBps":500000000,"packetRateThresholdPps":100000}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	settings, statusCode, err := client.GetNetworkDDoSSettings(0, "123")
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if statusCode != 200 {
+		t.Errorf("Should have received a 200 status code, got: %d", statusCode)
+	}
+	if settings == nil || settings.Mode != "ON_DEMAND" || settings.BandwidthThresholdBps != 500000000 || settings.PacketRateThresholdPps != 100000 {
+		t.Errorf("Unexpected settings: %+v", settings)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// UpdateNetworkDDoSSettings Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientUpdateNetworkDDoSSettingsInvalid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(400)
+		rw.Write([]byte(`{"errors":[{"status":"400","detail":"Threshold is too low"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	settings, err := client.UpdateNetworkDDoSSettings(0, "123", &NetworkDDoSSettings{Mode: "ALWAYS_ON", BandwidthThresholdBps: 1})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error status code 400 from Incapsula service when updating network DDoS settings for protected IP range 123") {
+		t.Errorf("Should have received a bad request error, got: %s", err)
+	}
+	if settings != nil {
+		t.Errorf("Should have received a nil settings instance")
+	}
+}
+
+func TestClientUpdateNetworkDDoSSettingsValid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		if string(body) != `{"mode":"ALWAYS_ON"}` {
+			t.Errorf("Should have omitted the default thresholds. Got: %s", string(body))
+		}
+		rw.Write([]byte(`{"data":[{"mode":"ALWAYS_ON"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	settings, err := client.UpdateNetworkDDoSSettings(0, "123", &NetworkDDoSSettings{Mode: "ALWAYS_ON"})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if settings == nil || settings.Mode != "ALWAYS_ON" {
+		t.Errorf("Unexpected settings: %+v", settings)
+	}
+}
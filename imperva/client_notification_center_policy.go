@@ -1,4 +1,4 @@
-package incapsula
+package imperva
 
 import (
 	"encoding/json"
@@ -75,12 +75,12 @@ func (c *Client) AddNotificationCenterPolicy(notificationPolicyFullDto *Notifica
 	responseBody, err := ioutil.ReadAll(resp.Body)
 	log.Printf("[DEBUG] Add NotificationCenterPolicy JSON response: %s\n", string(responseBody))
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Error status code %d from NotificationCenter service when adding policy: %s ", resp.StatusCode, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from NotificationCenter service when adding policy: %s ", resp.StatusCode, string(responseBody))
 	}
 
 	// Parse the JSON
 	var policy NotificationPolicy
-	err = json.Unmarshal(responseBody, &policy)
+	err = decodeJSONResponse(endPointNotificationCenterPolicy, responseBody, &policy)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing NotificationCenterPolicy JSON response: %s\nresponse: %s", err, string(responseBody))
 	}
@@ -112,12 +112,12 @@ func (c *Client) UpdateNotificationCenterPolicy(notificationPolicyFullDto *Notif
 	responseBody, err := ioutil.ReadAll(resp.Body)
 	log.Printf("[DEBUG] Update NotificationCenterPolicy JSON response: %s\n", string(responseBody))
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Error status code %d from NotificationCenter service when updateing policy: %s ", resp.StatusCode, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from NotificationCenter service when updateing policy: %s ", resp.StatusCode, string(responseBody))
 	}
 
 	// Parse the JSON
 	var policy NotificationPolicy
-	err = json.Unmarshal(responseBody, &policy)
+	err = decodeJSONResponse(endPointNotificationCenterPolicy, responseBody, &policy)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing NotificationCenterPolicy JSON response: %s\nresponse: %s", err, string(responseBody))
 	}
@@ -139,7 +139,7 @@ func (c *Client) DeleteNotificationCenterPolicy(policyId int, accountId int) err
 	responseBody, err := ioutil.ReadAll(resp.Body)
 	log.Printf("[DEBUG] NotificationCenter Delete policy JSON response: %s\n", string(responseBody))
 	if resp.StatusCode != 200 {
-		return fmt.Errorf("Error status code %d from NotificationCenter service when deleting policy with Id %d: %s ", resp.StatusCode, policyId, string(responseBody))
+		return NewAPIError(resp.StatusCode, responseBody, "Error status code %d from NotificationCenter service when deleting policy with Id %d: %s ", resp.StatusCode, policyId, string(responseBody))
 	}
 
 	return nil
@@ -173,14 +173,44 @@ func (c *Client) GetNotificationCenterPolicy(policyId int, accountId int) (*Noti
 	responseBody, err := ioutil.ReadAll(resp.Body)
 	log.Printf("[DEBUG] NotificationCenter Read policy JSON response: %s\n", string(responseBody))
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Error status code %d from NotificationCenter service when reading policy for ID %d: %s ", resp.StatusCode, policyId, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from NotificationCenter service when reading policy for ID %d: %s ", resp.StatusCode, policyId, string(responseBody))
 	}
 
 	var notificationCenterPolicy NotificationPolicy
-	err = json.Unmarshal(responseBody, &notificationCenterPolicy)
+	err = decodeJSONResponse(endPointNotificationCenterPolicy, responseBody, &notificationCenterPolicy)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing NotificationCenterPolicy JSON response with policy ID %d: %s\nresponse: %s", policyId, err, string(responseBody))
 	}
 
 	return &notificationCenterPolicy, nil
 }
+
+type NotificationPolicyList struct {
+	Data []NotificationPolicyFullDto `json:"data"`
+}
+
+func (c *Client) ListNotificationCenterPolicies(accountId int) (*NotificationPolicyList, error) {
+	log.Printf("[INFO] Listing NotificationCenterPolicies for accountId: %d", accountId)
+	requestUrl := getRequestUrl(c)
+
+	params := GetRequestParamsWithCaid(accountId)
+	resp, err := c.DoJsonAndQueryParamsRequestWithHeaders(http.MethodGet, requestUrl, nil, params, ReadNotificationCenterPolicy)
+	if err != nil {
+		return nil, fmt.Errorf("Error from NotificationCenter service when listing policies for account %d: %s ", accountId, err)
+	}
+
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+	log.Printf("[DEBUG] NotificationCenter List policies JSON response: %s\n", string(responseBody))
+	if resp.StatusCode != 200 {
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from NotificationCenter service when listing policies for account %d: %s ", resp.StatusCode, accountId, string(responseBody))
+	}
+
+	var notificationPolicyList NotificationPolicyList
+	err = decodeJSONResponse(endPointNotificationCenterPolicy, responseBody, &notificationPolicyList)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing NotificationCenterPolicy list JSON response for account %d: %s\nresponse: %s", accountId, err, string(responseBody))
+	}
+
+	return &notificationPolicyList, nil
+}
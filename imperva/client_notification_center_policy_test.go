@@ -1,4 +1,4 @@
-package incapsula
+package imperva
 
 import (
 	"fmt"
@@ -135,3 +135,75 @@ func TestAddNotificationCenterPolicyValidPolicy(t *testing.T) {
 		t.Errorf("Should not have received an empty policy Id")
 	}
 }
+
+func TestClientListNotificationCenterPoliciesInvalidResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(500)
+		if req.URL.String() != fmt.Sprintf("/%s?caid=777", endPointNotificationCenterPolicy) {
+			t.Errorf("Should have have hit /%s?caid=777 endpoint. Got: %s", endPointNotificationCenterPolicy, req.URL.String())
+		}
+		rw.Write([]byte(`{"error" : "cant list policies"}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	notificationPolicyList, err := client.ListNotificationCenterPolicies(777)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), fmt.Sprintf("Error status code 500 from NotificationCenter service when listing policies for account 777")) {
+		t.Errorf("Should have received a bad response error, got: %s", err)
+	}
+	if notificationPolicyList != nil {
+		t.Errorf("Should have received a nil notificationPolicyList instance")
+	}
+}
+
+func TestClientListNotificationCenterPoliciesValidAccount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s?caid=777", endPointNotificationCenterPolicy) {
+			t.Errorf("Should have have hit /%s?caid=777 endpoint. Got: %s", endPointNotificationCenterPolicy, req.URL.String())
+		}
+		rw.Write([]byte(`
+			{
+			    "data":
+			    [
+			        {
+			            "accountId": 777,
+			            "policyId": 888,
+			            "policyName": "first policy",
+			            "status": "ENABLE",
+			            "subCategory": "ACCOUNT_NOTIFICATIONS",
+			            "policyType": "ACCOUNT"
+			        },
+			        {
+			            "accountId": 777,
+			            "policyId": 889,
+			            "policyName": "second policy",
+			            "status": "DISABLE",
+			            "subCategory": "SITE_NOTIFICATIONS",
+			            "policyType": "ACCOUNT"
+			        }
+			    ]
+			}
+			`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	notificationPolicyList, err := client.ListNotificationCenterPolicies(777)
+	if err != nil {
+		t.Errorf("Should not have received an error, the error: %s", err)
+	}
+	if notificationPolicyList == nil {
+		t.Fatalf("Should not have received a nil notificationPolicyList instance")
+	}
+	if len(notificationPolicyList.Data) != 2 {
+		t.Errorf("Should have received 2 policies, got: %d", len(notificationPolicyList.Data))
+	}
+	if notificationPolicyList.Data[1].PolicyId != 889 {
+		t.Errorf("Policy ID doesn't match. Actual: %d", notificationPolicyList.Data[1].PolicyId)
+	}
+}
@@ -0,0 +1,69 @@
+package imperva
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+)
+
+// Endpoints (unexported consts)
+const endpointOriginConnectivityMonitoring = "connectivity-monitoring"
+
+// OriginConnectivityMonitoring contains the connectivity monitoring settings of a protected IP range.
+// Imperva probes the targets and alerts the notification policy once FailureThreshold consecutive probes failed.
+type OriginConnectivityMonitoring struct {
+	Enabled              bool     `json:"enabled"`
+	ProbeTargets         []string `json:"probeTargets"`
+	ProbeIntervalSeconds int      `json:"probeIntervalSeconds"`
+	FailureThreshold     int      `json:"failureThreshold"`
+	NotificationPolicyID int      `json:"notificationPolicyId,omitempty"`
+}
+
+// OriginConnectivityMonitoringResponse contains the connectivity monitoring settings returned by the API
+type OriginConnectivityMonitoringResponse struct {
+	Data []OriginConnectivityMonitoring `json:"data"`
+}
+
+// GetOriginConnectivityMonitoring gets the connectivity monitoring settings of a protected IP range,
+// along with the status code of the response
+func (c *Client) GetOriginConnectivityMonitoring(accountID int, rangeID string) (*OriginConnectivityMonitoring, int, error) {
+	log.Printf("[INFO] Getting Incapsula origin connectivity monitoring for protected IP range %s\n", rangeID)
+
+	path := fmt.Sprintf("%s/%s/%s", endpointProtectedIPRange, rangeID, endpointOriginConnectivityMonitoring)
+	action := fmt.Sprintf("reading origin connectivity monitoring for protected IP range %s", rangeID)
+	responseBody, statusCode, err := c.doInfraProtectRequest(http.MethodGet, path, accountID, nil, ReadOriginConnectivityMonitoring, action)
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	monitoring, err := parseOriginConnectivityMonitoringResponse(responseBody, fmt.Sprintf("read origin connectivity monitoring for protected IP range %s", rangeID))
+	return monitoring, statusCode, err
+}
+
+// UpdateOriginConnectivityMonitoring updates the connectivity monitoring settings of a protected IP range
+func (c *Client) UpdateOriginConnectivityMonitoring(accountID int, rangeID string, monitoring *OriginConnectivityMonitoring) (*OriginConnectivityMonitoring, error) {
+	log.Printf("[INFO] Updating Incapsula origin connectivity monitoring for protected IP range %s\n", rangeID)
+
+	path := fmt.Sprintf("%s/%s/%s", endpointProtectedIPRange, rangeID, endpointOriginConnectivityMonitoring)
+	action := fmt.Sprintf("updating origin connectivity monitoring for protected IP range %s", rangeID)
+	responseBody, _, err := c.doInfraProtectRequest(http.MethodPut, path, accountID, monitoring, UpdateOriginConnectivityMonitoring, action)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseOriginConnectivityMonitoringResponse(responseBody, fmt.Sprintf("update origin connectivity monitoring for protected IP range %s", rangeID))
+}
+
+func parseOriginConnectivityMonitoringResponse(responseBody []byte, action string) (*OriginConnectivityMonitoring, error) {
+	var monitoringResponse OriginConnectivityMonitoringResponse
+	err := decodeJSONResponse(fmt.Sprintf("%s/%s", endpointInfraProtect, endpointOriginConnectivityMonitoring), responseBody, &monitoringResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %s JSON response: %s\nresponse: %s", action, err, string(responseBody))
+	}
+
+	if len(monitoringResponse.Data) == 0 {
+		return nil, fmt.Errorf("Error parsing %s JSON response: no settings returned\nresponse: %s", action, string(responseBody))
+	}
+
+	return &monitoringResponse.Data[0], nil
+}
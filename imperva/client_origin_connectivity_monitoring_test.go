@@ -0,0 +1,125 @@
+package imperva
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////
+// GetOriginConnectivityMonitoring Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetOriginConnectivityMonitoringBadConnection(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+
+	monitoring, _, err := client.GetOriginConnectivityMonitoring(0, "123")
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error from Incapsula service when reading origin connectivity monitoring for protected IP range 123") {
+		t.Errorf("Should have received a client error, got: %s", err)
+	}
+	if monitoring != nil {
+		t.Errorf("Should have received a nil monitoring instance")
+	}
+}
+
+func TestClientGetOriginConnectivityMonitoringNotFound(t *testing.T) {
+	endpoint := fmt.Sprintf("/%s/%s/123/%s", endpointInfraProtect, endpointProtectedIPRange, endpointOriginConnectivityMonitoring)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		rw.WriteHeader(404)
+		rw.Write([]byte(`{"errors":[{"status":"404","detail":"Range not found"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	monitoring, statusCode, err := client.GetOriginConnectivityMonitoring(0, "123")
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if statusCode != 404 {
+		t.Errorf("Should have received a 404 status code, got: %d", statusCode)
+	}
+	if monitoring != nil {
+		t.Errorf("Should have received a nil monitoring instance")
+	}
+}
+
+func TestClientGetOriginConnectivityMonitoringValid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"data":[{"enabled":true,"probeTargets":["192.0.2.10","192.0.2.11"],"probeIntervalSeconds":30,"failureThreshold":5,"notificationPolicyId":77}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	monitoring, _, err := client.GetOriginConnectivityMonitoring(0, "123")
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if monitoring == nil || len(monitoring.ProbeTargets) != 2 || monitoring.FailureThreshold != 5 || monitoring.NotificationPolicyID != 77 {
+		t.Errorf("Unexpected monitoring settings: %+v", monitoring)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// UpdateOriginConnectivityMonitoring Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientUpdateOriginConnectivityMonitoringBadJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	monitoring, err := client.UpdateOriginConnectivityMonitoring(0, "123", &OriginConnectivityMonitoring{Enabled: true})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error parsing update origin connectivity monitoring for protected IP range 123 JSON response") {
+		t.Errorf("Should have received a JSON parse error, got: %s", err)
+	}
+	if monitoring != nil {
+		t.Errorf("Should have received a nil monitoring instance")
+	}
+}
+
+func TestClientUpdateOriginConnectivityMonitoringValid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPut {
+			t.Errorf("Should have sent a PUT request. Got: %s", req.Method)
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		if !strings.Contains(string(body), `"probeTargets":["192.0.2.10"]`) {
+			t.Errorf("Should have sent the probe targets. Got: %s", string(body))
+		}
+		rw.Write([]byte(`{"data":[{"enabled":true,"probeTargets":["192.0.2.10"],"probeIntervalSeconds":60,"failureThreshold":3}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	monitoring, err := client.UpdateOriginConnectivityMonitoring(0, "123", &OriginConnectivityMonitoring{Enabled: true, ProbeTargets: []string{"192.0.2.10"}, ProbeIntervalSeconds: 60, FailureThreshold: 3})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if monitoring == nil || !monitoring.Enabled {
+		t.Errorf("Should have received enabled monitoring settings, got: %+v", monitoring)
+	}
+}
@@ -1,13 +1,15 @@
-package incapsula
+package imperva
 
 import (
-	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"net/http"
 )
 
+// Endpoints (unexported consts)
+const endpointOriginPOPModify = "sites/datacenter/origin-pop/modify"
+
 // SetOriginPOPResponse contains the relevant site information when setting an Incapsula Origin POP
 type SetOriginPOPResponse struct {
 	Res        int    `json:"res"`
@@ -19,7 +21,7 @@ type SetOriginPOPResponse struct {
 
 // SetOriginPOP sets the origin POP for given data center
 func (c *Client) SetOriginPOP(dcID int, originPOP string) error {
-	reqURL := fmt.Sprintf("%s/sites/datacenter/origin-pop/modify?dc_id=%d", c.config.BaseURL, dcID)
+	reqURL := fmt.Sprintf("%s/%s?dc_id=%d", c.config.BaseURL, endpointOriginPOPModify, dcID)
 	if originPOP != "" {
 		reqURL = fmt.Sprintf("%s&origin_pop=%s", reqURL, originPOP)
 	}
@@ -38,14 +40,14 @@ func (c *Client) SetOriginPOP(dcID int, originPOP string) error {
 
 	// Parse the JSON
 	var originPOPResponse SetOriginPOPResponse
-	err = json.Unmarshal([]byte(responseBody), &originPOPResponse)
+	err = decodeJSONResponse(endpointOriginPOPModify, responseBody, &originPOPResponse)
 	if err != nil {
 		return fmt.Errorf("Error parsing origin POP JSON response for origin POP: %s for data center: %d: %s", originPOP, dcID, err)
 	}
 
 	// Look at the response status code from Incapsula
 	if originPOPResponse.Res != 0 {
-		return fmt.Errorf("Error from Incapsula service when updating origin POP: %s for data center: %d: %s", originPOP, dcID, string(responseBody))
+		return NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when updating origin POP: %s for data center: %d: %s", originPOP, dcID, string(responseBody))
 	}
 
 	return nil
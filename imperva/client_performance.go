@@ -1,4 +1,4 @@
-package incapsula
+package imperva
 
 import (
 	"encoding/json"
@@ -9,6 +9,9 @@ import (
 	"strconv"
 )
 
+// endpointPerformanceSettings names the performance settings endpoint of the v2 API in errors, its URLs include the site ID
+const endpointPerformanceSettings = "sites/{site_id}/settings/cache"
+
 const FORCE_RISKY_OP_HEADER_NAME = "force-risky-operation"
 
 // PerformanceSettings is a struct that encompasses all the properties for performance settings
@@ -72,12 +75,12 @@ func (c *Client) GetPerformanceSettings(siteID string) (*PerformanceSettings, in
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return nil, resp.StatusCode, fmt.Errorf("Error status code %d from Incapsula service when reading Incap Performance Settings for Site ID %s: %s", resp.StatusCode, siteID, string(responseBody))
+		return nil, resp.StatusCode, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when reading Incap Performance Settings for Site ID %s: %s", resp.StatusCode, siteID, string(responseBody))
 	}
 
 	// Parse the JSON
 	var performanceSettings PerformanceSettings
-	err = json.Unmarshal([]byte(responseBody), &performanceSettings)
+	err = decodeJSONResponse(endpointPerformanceSettings, responseBody, &performanceSettings)
 	if err != nil {
 		return nil, resp.StatusCode, fmt.Errorf("Error parsing Incap Performance Settings JSON response for Site ID %s: %s\nresponse: %s", siteID, err, string(responseBody))
 	}
@@ -118,12 +121,12 @@ func (c *Client) UpdatePerformanceSettings(siteID string, performanceSettings *P
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Error status code %d from Incapsula service when updating Incap Performance Settings for Site ID %s: %s", resp.StatusCode, siteID, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when updating Incap Performance Settings for Site ID %s: %s", resp.StatusCode, siteID, string(responseBody))
 	}
 
 	// Parse the JSON
 	var updatedPerformanceSettings PerformanceSettings
-	err = json.Unmarshal([]byte(responseBody), &updatedPerformanceSettings)
+	err = decodeJSONResponse(endpointPerformanceSettings, responseBody, &updatedPerformanceSettings)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing Incap Performance Settings JSON response for Site ID %s: %s\nresponse: %s", siteID, err, string(responseBody))
 	}
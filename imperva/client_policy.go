@@ -1,4 +1,4 @@
-package incapsula
+package imperva
 
 import (
 	"encoding/json"
@@ -8,36 +8,38 @@ import (
 	"net/http"
 )
 
+// Endpoints (unexported consts)
+const endpointPolicies = "policies/v2/policies"
+
 // PolicySubmitted is struct that encompasses all the properties of a policy object to submit
 type PolicySubmitted struct {
-	Name                string          `json:"name"`
-	Description         string          `json:"description"`
-	Enabled             bool            `json:"enabled"`
-	AccountID           int             `json:"accountId,omitempty"`
-	PolicyType          string          `json:"policyType"`
-	PolicySettings      []PolicySetting `json:"policySettings"`
-	DefaultPolicyConfig []struct {
-		AccountID int    `json:"accountId"`
-		AssetType string `json:"assetType"`
-		PolicyID  int    `json:"policyId"`
-	} `json:"defaultPolicyConfig,omitempty"`
+	Name                string                `json:"name"`
+	Description         string                `json:"description"`
+	Enabled             bool                  `json:"enabled"`
+	AccountID           int                   `json:"accountId,omitempty"`
+	PolicyType          string                `json:"policyType"`
+	PolicySettings      []PolicySetting       `json:"policySettings"`
+	DefaultPolicyConfig []PolicyDefaultConfig `json:"defaultPolicyConfig,omitempty"`
+}
+
+// PolicyDefaultConfig makes the policy the default one of the account for the asset type, applied to its new assets
+type PolicyDefaultConfig struct {
+	AccountID int    `json:"accountId"`
+	AssetType string `json:"assetType"`
+	PolicyID  int    `json:"policyId,omitempty"`
 }
 
 // PolicyExtended is a struct that encompasses all the properties of an extended policy setting
 type PolicyExtended struct {
 	Value struct {
-		ID                  int             `json:"id"`
-		Name                string          `json:"name"`
-		Description         string          `json:"description"`
-		Enabled             bool            `json:"enabled"`
-		AccountID           int             `json:"accountId,omitempty"`
-		PolicyType          string          `json:"policyType"`
-		PolicySettings      []PolicySetting `json:"policySettings"`
-		DefaultPolicyConfig []struct {
-			AccountID int    `json:"accountId"`
-			AssetType string `json:"assetType"`
-			PolicyID  int    `json:"policyId"`
-		} `json:"defaultPolicyConfig"`
+		ID                  int                   `json:"id"`
+		Name                string                `json:"name"`
+		Description         string                `json:"description"`
+		Enabled             bool                  `json:"enabled"`
+		AccountID           int                   `json:"accountId,omitempty"`
+		PolicyType          string                `json:"policyType"`
+		PolicySettings      []PolicySetting       `json:"policySettings"`
+		DefaultPolicyConfig []PolicyDefaultConfig `json:"defaultPolicyConfig"`
 	} `json:"value"`
 	IsError bool `json:"isError"`
 }
@@ -79,7 +81,7 @@ func (c *Client) AddPolicy(policySubmitted *PolicySubmitted) (*PolicyExtended, e
 
 	// Post form to Incapsula
 	log.Printf("[DEBUG] Incapsula Add Incap Policy JSON request: %s\n", string(policyJSON))
-	reqURL := fmt.Sprintf("%s/policies/v2/policies", c.config.BaseURLAPI)
+	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURLAPI, endpointPolicies)
 	resp, err := c.DoJsonRequestWithHeaders(http.MethodPost, reqURL, policyJSON, CreatePolicy)
 	if err != nil {
 		return nil, fmt.Errorf("Error from Incapsula service when adding Policy: %s", err)
@@ -94,12 +96,12 @@ func (c *Client) AddPolicy(policySubmitted *PolicySubmitted) (*PolicyExtended, e
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Error status code %d from Incapsula service when adding Policy: %s", resp.StatusCode, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when adding Policy: %s", resp.StatusCode, string(responseBody))
 	}
 
 	// Parse the JSON
 	var policyExtended PolicyExtended
-	err = json.Unmarshal([]byte(responseBody), &policyExtended)
+	err = decodeJSONResponse(endpointPolicies, responseBody, &policyExtended)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing Policy JSON response: %s\nresponse: %s", err, string(responseBody))
 	}
@@ -112,7 +114,7 @@ func (c *Client) GetPolicy(policyID string) (*PolicyExtended, error) {
 	log.Printf("[INFO] Getting Incapsula Policy: %s\n", policyID)
 
 	// Post form to Incapsula
-	reqURL := fmt.Sprintf("%s/policies/v2/policies/%s?extended=true", c.config.BaseURLAPI, policyID)
+	reqURL := fmt.Sprintf("%s/%s/%s?extended=true", c.config.BaseURLAPI, endpointPolicies, policyID)
 	resp, err := c.DoJsonRequestWithHeaders(http.MethodGet, reqURL, nil, ReadPolicy)
 	if err != nil {
 		return nil, fmt.Errorf("Error from Incapsula service when reading Policy for ID %s: %s", policyID, err)
@@ -127,12 +129,12 @@ func (c *Client) GetPolicy(policyID string) (*PolicyExtended, error) {
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Error status code %d from Incapsula service when reading Policy for ID %s: %s", resp.StatusCode, policyID, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when reading Policy for ID %s: %s", resp.StatusCode, policyID, string(responseBody))
 	}
 
 	// Parse the JSON
 	var policyExtended PolicyExtended
-	err = json.Unmarshal([]byte(responseBody), &policyExtended)
+	err = decodeJSONResponse(endpointPolicies, responseBody, &policyExtended)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing Policy JSON response for Policy ID %s: %s\nresponse: %s", policyID, err, string(responseBody))
 	}
@@ -151,7 +153,7 @@ func (c *Client) UpdatePolicy(policyID int, policySubmitted *PolicySubmitted) (*
 
 	// Post form to Incapsula
 	log.Printf("[DEBUG] Incapsula Update Incap Policy JSON request: %s\n", string(policyJSON))
-	reqURL := fmt.Sprintf("%s/policies/v2/policies/%d", c.config.BaseURLAPI, policyID)
+	reqURL := fmt.Sprintf("%s/%s/%d", c.config.BaseURLAPI, endpointPolicies, policyID)
 	resp, err := c.DoJsonRequestWithHeaders(http.MethodPut, reqURL, policyJSON, UpdatePolicy)
 	if err != nil {
 		return nil, fmt.Errorf("Error from Incapsula service when updating Policy: %s", err)
@@ -166,12 +168,12 @@ func (c *Client) UpdatePolicy(policyID int, policySubmitted *PolicySubmitted) (*
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Error status code %d from Incapsula service when updating Policy with ID %d: %s", resp.StatusCode, policyID, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when updating Policy with ID %d: %s", resp.StatusCode, policyID, string(responseBody))
 	}
 
 	// Parse the JSON
 	var updatedPolicyExtended PolicyExtended
-	err = json.Unmarshal([]byte(responseBody), &updatedPolicyExtended)
+	err = decodeJSONResponse(endpointPolicies, responseBody, &updatedPolicyExtended)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing Policy JSON response for Policy ID %d: %s\nresponse: %s", policyID, err, string(responseBody))
 	}
@@ -184,7 +186,7 @@ func (c *Client) DeletePolicy(policyID string) error {
 	log.Printf("[INFO] Deleting Incapsula Policy for ID %s\n", policyID)
 
 	// Delete request to Incapsula
-	reqURL := fmt.Sprintf("%s/policies/v2/policies/%s", c.config.BaseURLAPI, policyID)
+	reqURL := fmt.Sprintf("%s/%s/%s", c.config.BaseURLAPI, endpointPolicies, policyID)
 	resp, err := c.DoJsonRequestWithHeaders(http.MethodDelete, reqURL, nil, DeletePolicy)
 	if err != nil {
 		return fmt.Errorf("Error from Incapsula service when deleting Policy with ID %s: %s", policyID, err)
@@ -199,8 +201,57 @@ func (c *Client) DeletePolicy(policyID string) error {
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return fmt.Errorf("Error status code %d from Incapsula service when deleting Policy with ID %s: %s", resp.StatusCode, policyID, string(responseBody))
+		return NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when deleting Policy with ID %s: %s", resp.StatusCode, policyID, string(responseBody))
 	}
 
 	return nil
 }
+
+// PolicyListResponse is a struct that encompasses the policies returned when listing the policies of an account
+type PolicyListResponse struct {
+	Value []struct {
+		ID                  int                   `json:"id"`
+		Name                string                `json:"name"`
+		Description         string                `json:"description"`
+		Enabled             bool                  `json:"enabled"`
+		AccountID           int                   `json:"accountId,omitempty"`
+		PolicyType          string                `json:"policyType"`
+		PolicySettings      []PolicySetting       `json:"policySettings"`
+		DefaultPolicyConfig []PolicyDefaultConfig `json:"defaultPolicyConfig"`
+	} `json:"value"`
+	IsError bool `json:"isError"`
+}
+
+// ListPolicies gets all the policies of an account
+func (c *Client) ListPolicies(accountID int) (*PolicyListResponse, error) {
+	log.Printf("[INFO] Listing Incapsula Policies for account ID %d\n", accountID)
+
+	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURLAPI, endpointPolicies)
+	params := GetRequestParamsWithCaid(accountID)
+	params["extended"] = "true"
+	resp, err := c.DoJsonAndQueryParamsRequestWithHeaders(http.MethodGet, reqURL, nil, params, ReadPolicy)
+	if err != nil {
+		return nil, fmt.Errorf("Error from Incapsula service when listing Policies for account ID %d: %s", accountID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula List Policies JSON response: %s\n", string(responseBody))
+
+	// Check the response code
+	if resp.StatusCode != 200 {
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when listing Policies for account ID %d: %s", resp.StatusCode, accountID, string(responseBody))
+	}
+
+	// Parse the JSON
+	var policyListResponse PolicyListResponse
+	err = decodeJSONResponse(endpointPolicies, responseBody, &policyListResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Policies JSON response for account ID %d: %s\nresponse: %s", accountID, err, string(responseBody))
+	}
+
+	return &policyListResponse, nil
+}
@@ -1,4 +1,4 @@
-package incapsula
+package imperva
 
 import (
 	"encoding/json"
@@ -33,7 +33,7 @@ func (c *Client) AddPolicyAssetAssociation(policyID, assetID, assetType string)
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return fmt.Errorf("Error status code %d from Incapsula service when adding Policy Asset Association: %s", resp.StatusCode, string(responseBody))
+		return NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when adding Policy Asset Association: %s", resp.StatusCode, string(responseBody))
 	}
 
 	return nil
@@ -59,13 +59,14 @@ func (c *Client) DeletePolicyAssetAssociation(policyID, assetID, assetType strin
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return fmt.Errorf("Error status code %d from Incapsula service when deleting Policy Asset Association: %s", resp.StatusCode, string(responseBody))
+		return NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when deleting Policy Asset Association: %s", resp.StatusCode, string(responseBody))
 	}
 
 	return nil
 }
 
-func (c *Client) isPolicyAssetAssociated(policyID, assetID, assetType string) (bool, error) {
+// IsPolicyAssetAssociated checks the policy is associated with the asset, the API responds 404 when it isn't
+func (c *Client) IsPolicyAssetAssociated(policyID, assetID, assetType string) (bool, error) {
 	log.Printf("[INFO] Checking Policy Asset Association: %s/%s/%s\n", policyID, assetID, assetType)
 
 	// Check with Policies if the association exist
@@ -84,7 +85,7 @@ func (c *Client) isPolicyAssetAssociated(policyID, assetID, assetType string) (b
 	// Check the response code
 	// If policy asset is not associated 404 will be returned from policies
 	if resp.StatusCode != 200 {
-		return false, fmt.Errorf("Error status code %d from Incapsula service when checking the reading Policy Asset Association: %s/%s/%s, response is: %s", resp.StatusCode, policyID, assetID, assetType, string(responseBody))
+		return false, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when checking the reading Policy Asset Association: %s/%s/%s, response is: %s", resp.StatusCode, policyID, assetID, assetType, string(responseBody))
 	}
 
 	// Parse the JSON
@@ -1,4 +1,4 @@
-package incapsula
+package imperva
 
 import (
 	"fmt"
@@ -55,6 +55,6 @@ func ClientPolicyAssetAssociatedBase(t *testing.T, shouldBeAssociated bool) (boo
 	config := &Config{APIID: apiID, APIKey: apiKey, BaseURLAPI: server.URL}
 	client := &Client{config: config, httpClient: &http.Client{}}
 
-	return client.isPolicyAssetAssociated(policyID, assetID, assetType)
+	return client.IsPolicyAssetAssociated(policyID, assetID, assetType)
 
 }
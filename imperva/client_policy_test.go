@@ -0,0 +1,47 @@
+package imperva
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestClientAddPolicyDefaultPolicyConfig(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodPost, "/policies/v2/policies", mockJSON(`{"value":{"id":42,"accountId":123,"policyType":"WHITELIST","defaultPolicyConfig":[{"accountId":123,"assetType":"WEBSITE","policyId":42}]},"isError":false}`))
+
+	policySubmitted := &PolicySubmitted{
+		Name:                "Trusted IPs",
+		AccountID:           123,
+		PolicyType:          "WHITELIST",
+		DefaultPolicyConfig: []PolicyDefaultConfig{{AccountID: 123, AssetType: "WEBSITE"}},
+	}
+	policyExtended, err := api.client().AddPolicy(policySubmitted)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if len(policyExtended.Value.DefaultPolicyConfig) != 1 || policyExtended.Value.DefaultPolicyConfig[0].PolicyID != 42 {
+		t.Errorf("Should have received the default policy config, got: %+v", policyExtended.Value)
+	}
+
+	body := api.requestsTo(http.MethodPost, "/policies/v2/policies")[0].Body
+	expected := `{"name":"Trusted IPs","description":"","enabled":false,"accountId":123,"policyType":"WHITELIST","policySettings":null,"defaultPolicyConfig":[{"accountId":123,"assetType":"WEBSITE"}]}`
+	if body != expected {
+		t.Errorf("Should have sent the default policy config, got: %s", body)
+	}
+}
+
+func TestClientAddPolicyWithoutDefaultPolicyConfig(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodPost, "/policies/v2/policies", mockJSON(`{"value":{"id":42,"accountId":123,"policyType":"ACL"},"isError":false}`))
+
+	_, err := api.client().AddPolicy(&PolicySubmitted{Name: "ACL", PolicyType: "ACL"})
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+
+	body := api.requestsTo(http.MethodPost, "/policies/v2/policies")[0].Body
+	expected := `{"name":"ACL","description":"","enabled":false,"policyType":"ACL","policySettings":null}`
+	if body != expected {
+		t.Errorf("Should not have sent a default policy config, got: %s", body)
+	}
+}
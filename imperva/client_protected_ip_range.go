@@ -0,0 +1,86 @@
+package imperva
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+)
+
+// Endpoints (unexported consts)
+const endpointProtectedIPRange = "protected-ranges"
+
+// ProtectedIPRange is an IP prefix onboarded to Infrastructure Protection
+type ProtectedIPRange struct {
+	ID                 string `json:"id,omitempty"`
+	Prefix             string `json:"prefix"`
+	Description        string `json:"description"`
+	Enabled            bool   `json:"enabled"`
+	AnnouncementStatus string `json:"announcementStatus,omitempty"`
+}
+
+// ProtectedIPRangeResponse contains the protected IP range returned by the API
+type ProtectedIPRangeResponse struct {
+	Data []ProtectedIPRange `json:"data"`
+}
+
+// AddProtectedIPRange adds a protected IP range to an account
+func (c *Client) AddProtectedIPRange(accountID int, ipRange *ProtectedIPRange) (*ProtectedIPRange, error) {
+	log.Printf("[INFO] Adding Incapsula protected IP range %s for account %d\n", ipRange.Prefix, accountID)
+
+	responseBody, _, err := c.doInfraProtectRequest(http.MethodPost, endpointProtectedIPRange, accountID, ipRange, CreateProtectedIPRange, fmt.Sprintf("adding protected IP range %s", ipRange.Prefix))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseProtectedIPRangeResponse(responseBody, "add protected IP range")
+}
+
+// GetProtectedIPRange gets a protected IP range, along with the status code of the response
+func (c *Client) GetProtectedIPRange(accountID int, rangeID string) (*ProtectedIPRange, int, error) {
+	log.Printf("[INFO] Getting Incapsula protected IP range %s for account %d\n", rangeID, accountID)
+
+	path := fmt.Sprintf("%s/%s", endpointProtectedIPRange, rangeID)
+	responseBody, statusCode, err := c.doInfraProtectRequest(http.MethodGet, path, accountID, nil, ReadProtectedIPRange, fmt.Sprintf("reading protected IP range %s", rangeID))
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	ipRange, err := parseProtectedIPRangeResponse(responseBody, fmt.Sprintf("read protected IP range %s", rangeID))
+	return ipRange, statusCode, err
+}
+
+// UpdateProtectedIPRange updates the description of a protected IP range and enables or disables its protection
+func (c *Client) UpdateProtectedIPRange(accountID int, rangeID string, ipRange *ProtectedIPRange) (*ProtectedIPRange, error) {
+	log.Printf("[INFO] Updating Incapsula protected IP range %s for account %d\n", rangeID, accountID)
+
+	path := fmt.Sprintf("%s/%s", endpointProtectedIPRange, rangeID)
+	responseBody, _, err := c.doInfraProtectRequest(http.MethodPut, path, accountID, ipRange, UpdateProtectedIPRange, fmt.Sprintf("updating protected IP range %s", rangeID))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseProtectedIPRangeResponse(responseBody, fmt.Sprintf("update protected IP range %s", rangeID))
+}
+
+// DeleteProtectedIPRange removes a protected IP range from an account
+func (c *Client) DeleteProtectedIPRange(accountID int, rangeID string) error {
+	log.Printf("[INFO] Deleting Incapsula protected IP range %s for account %d\n", rangeID, accountID)
+
+	path := fmt.Sprintf("%s/%s", endpointProtectedIPRange, rangeID)
+	_, _, err := c.doInfraProtectRequest(http.MethodDelete, path, accountID, nil, DeleteProtectedIPRange, fmt.Sprintf("deleting protected IP range %s", rangeID))
+	return err
+}
+
+func parseProtectedIPRangeResponse(responseBody []byte, action string) (*ProtectedIPRange, error) {
+	var protectedIPRangeResponse ProtectedIPRangeResponse
+	err := decodeJSONResponse(fmt.Sprintf("%s/%s", endpointInfraProtect, endpointProtectedIPRange), responseBody, &protectedIPRangeResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %s JSON response: %s\nresponse: %s", action, err, string(responseBody))
+	}
+
+	if len(protectedIPRangeResponse.Data) == 0 {
+		return nil, fmt.Errorf("Error parsing %s JSON response: no protected IP range returned\nresponse: %s", action, string(responseBody))
+	}
+
+	return &protectedIPRangeResponse.Data[0], nil
+}
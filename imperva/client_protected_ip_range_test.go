@@ -0,0 +1,155 @@
+package imperva
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////
+// AddProtectedIPRange Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientAddProtectedIPRangeBadConnection(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+
+	ipRange, err := client.AddProtectedIPRange(0, &ProtectedIPRange{Prefix: "192.0.2.0/24"})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error from Incapsula service when adding protected IP range 192.0.2.0/24") {
+		t.Errorf("Should have received a client error, got: %s", err)
+	}
+	if ipRange != nil {
+		t.Errorf("Should have received a nil protected IP range instance")
+	}
+}
+
+func TestClientAddProtectedIPRangeBadJSON(t *testing.T) {
+	endpoint := fmt.Sprintf("/%s/%s?caid=42", endpointInfraProtect, endpointProtectedIPRange)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		rw.Write([]byte(`{`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	ipRange, err := client.AddProtectedIPRange(42, &ProtectedIPRange{Prefix: "192.0.2.0/24"})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error parsing add protected IP range JSON response") {
+		t.Errorf("Should have received a JSON parse error, got: %s", err)
+	}
+	if ipRange != nil {
+		t.Errorf("Should have received a nil protected IP range instance")
+	}
+}
+
+func TestClientAddProtectedIPRangeValid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			t.Errorf("Should have sent a POST request. Got: %s", req.Method)
+		}
+		rw.Write([]byte(`{"data":[{"id":"123","prefix":"192.0.2.0/24","description":"office","enabled":true,"announcementStatus":"NOT_ANNOUNCED"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	ipRange, err := client.AddProtectedIPRange(0, &ProtectedIPRange{Prefix: "192.0.2.0/24"})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if ipRange == nil || ipRange.ID != "123" || ipRange.AnnouncementStatus != "NOT_ANNOUNCED" {
+		t.Errorf("Should have received protected IP range 123, got: %+v", ipRange)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// GetProtectedIPRange Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetProtectedIPRangeNotFound(t *testing.T) {
+	endpoint := fmt.Sprintf("/%s/%s/123", endpointInfraProtect, endpointProtectedIPRange)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		rw.WriteHeader(404)
+		rw.Write([]byte(`{"errors":[{"status":"404","detail":"Range not found"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	ipRange, statusCode, err := client.GetProtectedIPRange(0, "123")
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error status code 404 from Incapsula service when reading protected IP range 123") {
+		t.Errorf("Should have received a not found error, got: %s", err)
+	}
+	if statusCode != 404 {
+		t.Errorf("Should have received a 404 status code, got: %d", statusCode)
+	}
+	if ipRange != nil {
+		t.Errorf("Should have received a nil protected IP range instance")
+	}
+}
+
+func TestClientGetProtectedIPRangeValid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"data":[{"id":"123","prefix":"192.0.2.0/24","description":"office","enabled":false,"announcementStatus":"ANNOUNCED"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	ipRange, statusCode, err := client.GetProtectedIPRange(0, "123")
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if statusCode != 200 {
+		t.Errorf("Should have received a 200 status code, got: %d", statusCode)
+	}
+	if ipRange == nil || ipRange.Enabled || ipRange.AnnouncementStatus != "ANNOUNCED" {
+		t.Errorf("Unexpected protected IP range: %+v", ipRange)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// UpdateProtectedIPRange Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientUpdateProtectedIPRangeValid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPut {
+			t.Errorf("Should have sent a PUT request. Got: %s", req.Method)
+		}
+		rw.Write([]byte(`{"data":[{"id":"123","prefix":"192.0.2.0/24","enabled":false,"announcementStatus":"NOT_ANNOUNCED"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	ipRange, err := client.UpdateProtectedIPRange(0, "123", &ProtectedIPRange{Prefix: "192.0.2.0/24", Enabled: false})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if ipRange == nil || ipRange.Enabled {
+		t.Errorf("Should have received a disabled protected IP range, got: %+v", ipRange)
+	}
+}
@@ -0,0 +1,52 @@
+package imperva
+
+import (
+	"fmt"
+	"log"
+)
+
+// CheckSiteReference checks the site exists, the result is cached so each site is only read once per client
+func (c *Client) CheckSiteReference(siteID int) error {
+	return c.checkReference(fmt.Sprintf("site/%d", siteID), func() error {
+		siteStatusResponse, err := c.SiteStatus("site-reference", siteID)
+		if siteStatusResponse != nil && fmt.Sprint(siteStatusResponse.Res) == "9413" {
+			return fmt.Errorf("site %d doesn't exist, it may have been deleted outside of Terraform", siteID)
+		}
+		if err != nil {
+			return fmt.Errorf("Error checking site %d exists: %s", siteID, err)
+		}
+		return nil
+	})
+}
+
+// CheckAccountReference checks the account exists, the result is cached so each account is only read once per client
+func (c *Client) CheckAccountReference(accountID int) error {
+	return c.checkReference(fmt.Sprintf("account/%d", accountID), func() error {
+		accountStatusResponse, err := c.AccountStatus(accountID)
+		if accountStatusResponse != nil && fmt.Sprint(accountStatusResponse.Res) == "9403" {
+			return fmt.Errorf("account %d doesn't exist or isn't accessible with the configured API credentials, it may have been deleted outside of Terraform", accountID)
+		}
+		if err != nil {
+			return fmt.Errorf("Error checking account %d exists: %s", accountID, err)
+		}
+		return nil
+	})
+}
+
+func (c *Client) checkReference(reference string, check func() error) error {
+	c.referenceChecksMutex.Lock()
+	defer c.referenceChecksMutex.Unlock()
+
+	if err, ok := c.referenceChecks[reference]; ok {
+		return err
+	}
+
+	log.Printf("[INFO] Checking the referenced %s exists\n", reference)
+	err := check()
+	if c.referenceChecks == nil {
+		c.referenceChecks = make(map[string]error)
+	}
+	c.referenceChecks[reference] = err
+
+	return err
+}
@@ -0,0 +1,52 @@
+package imperva
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCheckSiteReference(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodPost, "/"+endpointSiteStatus, mockJSON(`{"site_id":123,"res":0}`))
+
+	client := api.client()
+	for i := 0; i < 2; i++ {
+		if err := client.CheckSiteReference(123); err != nil {
+			t.Errorf("Should not have received an error, got: %s", err)
+		}
+	}
+	if requests := api.requestsTo(http.MethodPost, "/"+endpointSiteStatus); len(requests) != 1 {
+		t.Errorf("Should have checked the site once, got: %d requests", len(requests))
+	}
+}
+
+func TestCheckSiteReferenceDeleted(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodPost, "/"+endpointSiteStatus, mockRes(9413, "Unknown/unauthorized site_id"))
+
+	err := api.client().CheckSiteReference(123)
+	if err == nil || err.Error() != "site 123 doesn't exist, it may have been deleted outside of Terraform" {
+		t.Errorf("Should have received a pointed error, got: %v", err)
+	}
+}
+
+func TestCheckSiteReferenceError(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodPost, "/"+endpointSiteStatus, mockRes(1, "Unexpected error"))
+
+	err := api.client().CheckSiteReference(123)
+	if err == nil || !strings.HasPrefix(err.Error(), "Error checking site 123 exists") {
+		t.Errorf("Should have received an error, got: %v", err)
+	}
+}
+
+func TestCheckAccountReferenceDeleted(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodPost, "/"+endpointAccountStatus, mockRes(9403, "Unknown/unauthorized account_id"))
+
+	err := api.client().CheckAccountReference(456)
+	if err == nil || !strings.HasPrefix(err.Error(), "account 456 doesn't exist") {
+		t.Errorf("Should have received a pointed error, got: %v", err)
+	}
+}
@@ -1,7 +1,6 @@
-package incapsula
+package imperva
 
 import (
-	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -13,21 +12,34 @@ import (
 const endpointExceptionConfigure = "sites/configure/whitelists"
 const endpointExceptionList = "sites/status"
 
+// Security Rule Enumerations
+// NOTE: no whitelists for rule: api.acl.whitelisted_ips
+const BlacklistedCountriesExceptionRuleID = "api.acl.blacklisted_countries"
+const BlacklistedURLsExceptionRuleID = "api.acl.blacklisted_urls"
+const BlacklistedIPsExceptionRuleID = "api.acl.blacklisted_ips"
+const BackdoorExceptionRuleID = "api.threats.backdoor"
+const CrossSiteScriptingExceptionRuleID = "api.threats.cross_site_scripting"
+const IllegalResourceAccessExceptionRuleID = "api.threats.illegal_resource_access"
+const RemoteFileInclusionExceptionRuleID = "api.threats.remote_file_inclusion"
+const SQLInjectionExceptionRuleID = "api.threats.sql_injection"
+const DDoSExceptionRuleID = "api.threats.ddos"
+const BotAccessControlExceptionRuleID = "api.threats.bot_access_control"
+
 // Exception param mapping by ruleID
 // NOTE: no exceptions for whitelistedIPsExceptionRuleId
 var securityRuleExceptionParamMapping = map[string][]string{
 	// ACL RuleIDs
-	blacklistedCountriesExceptionRuleID: {"client_app_types", "ips", "url_patterns", "urls"},
-	blacklistedIPsExceptionRuleID:       {"client_apps", "countries", "continents", "ips", "url_patterns", "urls"},
-	blacklistedURLsExceptionRuleID:      {"client_apps", "countries", "continents", "ips", "url_patterns", "urls"},
+	BlacklistedCountriesExceptionRuleID: {"client_app_types", "ips", "url_patterns", "urls"},
+	BlacklistedIPsExceptionRuleID:       {"client_apps", "countries", "continents", "ips", "url_patterns", "urls"},
+	BlacklistedURLsExceptionRuleID:      {"client_apps", "countries", "continents", "ips", "url_patterns", "urls"},
 	// WAF RuleIDs
-	backdoorExceptionRuleID:              {"client_apps", "countries", "continents", "ips", "url_patterns", "urls", "user_agents", "parameters"},
-	botAccessControlExceptionRuleID:      {"client_app_types", "ips", "url_patterns", "urls", "user_agents"},
-	crossSiteScriptingExceptionRuleID:    {"client_apps", "countries", "continents", "url_patterns", "urls", "parameters"},
-	ddosExceptionRuleID:                  {"client_apps", "countries", "continents", "ips", "url_patterns", "urls"},
-	illegalResourceAccessExceptionRuleID: {"client_apps", "countries", "continents", "ips", "url_patterns", "urls", "parameters"},
-	remoteFileInclusionExceptionRuleID:   {"client_apps", "countries", "continents", "ips", "url_patterns", "urls", "user_agents", "parameters"},
-	sqlInjectionExceptionRuleID:          {"client_apps", "countries", "continents", "ips", "url_patterns", "urls", "parameters"},
+	BackdoorExceptionRuleID:              {"client_apps", "countries", "continents", "ips", "url_patterns", "urls", "user_agents", "parameters"},
+	BotAccessControlExceptionRuleID:      {"client_app_types", "ips", "url_patterns", "urls", "user_agents"},
+	CrossSiteScriptingExceptionRuleID:    {"client_apps", "countries", "continents", "url_patterns", "urls", "parameters"},
+	DDoSExceptionRuleID:                  {"client_apps", "countries", "continents", "ips", "url_patterns", "urls"},
+	IllegalResourceAccessExceptionRuleID: {"client_apps", "countries", "continents", "ips", "url_patterns", "urls", "parameters"},
+	RemoteFileInclusionExceptionRuleID:   {"client_apps", "countries", "continents", "ips", "url_patterns", "urls", "user_agents", "parameters"},
+	SQLInjectionExceptionRuleID:          {"client_apps", "countries", "continents", "ips", "url_patterns", "urls", "parameters"},
 }
 
 // SecurityRuleExceptionCreateResponse provides exception_id of rule exception
@@ -93,14 +105,14 @@ func (c *Client) AddSecurityRuleException(siteID int, ruleID, clientAppTypes, cl
 
 	// Parse the JSON
 	var securityRuleExceptionCreateResponse SecurityRuleExceptionCreateResponse
-	err = json.Unmarshal([]byte(responseBody), &securityRuleExceptionCreateResponse)
+	err = decodeJSONResponse(endpointExceptionConfigure, responseBody, &securityRuleExceptionCreateResponse)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing SecurityRuleExceptionCreateResponse JSON response for rule_id (%s) and site_id (%d)", ruleID, siteID)
 	}
 
 	// Look at the response status code from Incapsula
 	if securityRuleExceptionCreateResponse.Res != "0" {
-		return nil, fmt.Errorf("Error from Incapsula service when adding security rule exception for rule_id (%s) and site_id (%d): %s", ruleID, siteID, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when adding security rule exception for rule_id (%s) and site_id (%d): %s", ruleID, siteID, string(responseBody))
 	}
 
 	return &securityRuleExceptionCreateResponse, nil
@@ -162,14 +174,14 @@ func (c *Client) EditSecurityRuleException(siteID int, ruleID, clientAppTypes, c
 
 	// Parse the JSON
 	var siteStatusResponse SiteStatusResponse
-	err = json.Unmarshal([]byte(responseBody), &siteStatusResponse)
+	err = decodeJSONResponse(endpointExceptionConfigure, responseBody, &siteStatusResponse)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing configure security rule exception JSON response for rule_id (%s) and site_id (%d)", ruleID, siteID)
 	}
 
 	// Look at the response status code from Incapsula
 	if siteStatusResponse.Res != 0 {
-		return nil, fmt.Errorf("Error from Incapsula service when adding security rule exception for rule_id (%s) and site_id (%d): %s", ruleID, siteID, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when adding security rule exception for rule_id (%s) and site_id (%d): %s", ruleID, siteID, string(responseBody))
 	}
 
 	return &siteStatusResponse, nil
@@ -196,7 +208,7 @@ func (c *Client) ListSecurityRuleExceptions(siteID, ruleID string) (*SiteStatusR
 
 	// Parse the JSON
 	var siteStatusResponse SiteStatusResponse
-	err = json.Unmarshal([]byte(responseBody), &siteStatusResponse)
+	err = decodeJSONResponse(endpointExceptionList, responseBody, &siteStatusResponse)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing ListSecurityRuleExceptions JSON response for siteID: %s %s\nresponse: %s", siteID, err, string(responseBody))
 	}
@@ -213,7 +225,7 @@ func (c *Client) ListSecurityRuleExceptions(siteID, ruleID string) (*SiteStatusR
 
 	// Look at the response status code from Incapsula
 	if resString != "0" {
-		return &siteStatusResponse, fmt.Errorf("Error from Incapsula service when getting security rule exceptions (site_id: %s): %s", siteID, string(responseBody))
+		return &siteStatusResponse, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when getting security rule exceptions (site_id: %s): %s", siteID, string(responseBody))
 	}
 
 	return &siteStatusResponse, nil
@@ -251,14 +263,14 @@ func (c *Client) DeleteSecurityRuleException(siteID int, ruleID, whitelistID str
 
 	// Parse the JSON
 	var exceptionDeleteResponse ExceptionDeleteResponse
-	err = json.Unmarshal([]byte(responseBody), &exceptionDeleteResponse)
+	err = decodeJSONResponse(endpointExceptionConfigure, responseBody, &exceptionDeleteResponse)
 	if err != nil {
 		return fmt.Errorf("Error parsing delete security rule exception JSON response for rule_id (%s) and site_id (%d)", ruleID, siteID)
 	}
 
 	// Look at the response status code from Incapsula
 	if exceptionDeleteResponse.Res != 0 {
-		return fmt.Errorf("Error from Incapsula service when deleting security rule exception for rule_id (%s) and site_id (%d): %s", ruleID, siteID, string(responseBody))
+		return NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when deleting security rule exception for rule_id (%s) and site_id (%d): %s", ruleID, siteID, string(responseBody))
 	}
 
 	return nil
@@ -1,29 +1,31 @@
-package incapsula
+package imperva
 
 import (
-	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"net/url"
 	"strconv"
+	"strings"
 )
 
 const endpointSiteAdd = "sites/add"
 const endpointSiteStatus = "sites/status"
 const endpointSiteUpdate = "sites/configure"
 const endpointSiteDelete = "sites/delete"
+const endpointSiteMove = "sites/moveSite"
+const endpointSiteList = "sites/list"
 
 // SiteAddResponse contains the relevant site information when adding an Incapsula managed site
 type SiteAddResponse struct {
-	SiteID int `json:"site_id"`
-	Res    int `json:"res"`
+	SiteID int         `json:"site_id"`
+	Res    FlexibleInt `json:"res"`
 }
 
 // SiteUpdateResponse contains the relevant site information when updating an Incapsula managed site
 type SiteUpdateResponse struct {
-	SiteID int `json:"site_id"`
-	Res    int `json:"res"`
+	SiteID int         `json:"site_id"`
+	Res    FlexibleInt `json:"res"`
 }
 
 // SiteStatusDNSValidationData is DNS related validation data (HTML is a map[string][]string)
@@ -33,6 +35,25 @@ type SiteStatusDNSValidationData struct {
 	SetDataTo     []string `json:"set_data_to"`
 }
 
+// SecurityRuleExceptionValue is a condition of a security rule exception, e.g. the URLs or IPs it applies to
+type SecurityRuleExceptionValue struct {
+	ID   string   `json:"id"`
+	Name string   `json:"name"`
+	Ips  []string `json:"ips,omitempty"`
+	Urls []struct {
+		Value   string `json:"value"`
+		Pattern string `json:"pattern"`
+	} `json:"urls,omitempty"`
+	Geo struct {
+		Countries  []string `json:"countries"`
+		Continents []string `json:"continents"`
+	} `json:"geo,omitempty"`
+	ClientApps     []string `json:"client_apps,omitempty"`
+	ClientAppTypes []string `json:"client_app_types,omitempty"`
+	Parameters     []string `json:"parameters,omitempty"`
+	UserAgents     []string `json:"user_agents,omitempty"`
+}
+
 // SiteStatusResponse contains managed site information
 type SiteStatusResponse struct {
 	SiteID               int      `json:"site_id"`
@@ -75,24 +96,8 @@ type SiteStatusResponse struct {
 				ActivationModeText     string `json:"activation_mode_text,omitempty"`
 				DdosTrafficThreshold   int    `json:"ddos_traffic_threshold,omitempty"`
 				Exceptions             []struct {
-					Values []struct {
-						ID   string   `json:"id,omitempty"`
-						Name string   `json:"name,omitempty"`
-						Ips  []string `json:"ips,omitempty"`
-						Urls []struct {
-							Value   string `json:"value,omitempty"`
-							Pattern string `json:"pattern,omitempty"`
-						} `json:"urls,omitempty"`
-						Geo struct {
-							Countries  []string `json:"countries,omitempty"`
-							Continents []string `json:"continents,omitempty"`
-						} `json:"geo,omitempty"`
-						ClientApps     []string `json:"client_apps,omitempty"`
-						ClientAppTypes []string `json:"client_app_types,omitempty"`
-						Parameters     []string `json:"parameters,omitempty"`
-						UserAgents     []string `json:"user_agents,omitempty"`
-					} `json:"values,omitempty"`
-					ID int `json:"id,omitempty"`
+					Values []SecurityRuleExceptionValue `json:"values,omitempty"`
+					ID     int                          `json:"id,omitempty"`
 				} `json:"exceptions,omitempty"`
 			} `json:"rules"`
 		} `json:"waf"`
@@ -110,24 +115,8 @@ type SiteStatusResponse struct {
 					Pattern string `json:"pattern"`
 				} `json:"urls,omitempty"`
 				Exceptions []struct {
-					Values []struct {
-						ID   string   `json:"id"`
-						Name string   `json:"name"`
-						Ips  []string `json:"ips,omitempty"`
-						Urls []struct {
-							Value   string `json:"value"`
-							Pattern string `json:"pattern"`
-						} `json:"urls,omitempty"`
-						Geo struct {
-							Countries  []string `json:"countries"`
-							Continents []string `json:"continents"`
-						} `json:"geo,omitempty"`
-						ClientApps     []string `json:"client_apps,omitempty"`
-						ClientAppTypes []string `json:"client_app_types,omitempty"`
-						Parameters     []string `json:"parameters,omitempty"`
-						UserAgents     []string `json:"user_agents,omitempty"`
-					} `json:"values"`
-					ID int `json:"id"`
+					Values []SecurityRuleExceptionValue `json:"values"`
+					ID     int                          `json:"id"`
 				} `json:"exceptions"`
 			} `json:"rules"`
 		} `json:"acls"`
@@ -141,9 +130,7 @@ type SiteStatusResponse struct {
 			Detected        bool   `json:"detected"`
 			DetectionStatus string `json:"detectionStatus"`
 		} `json:"origin_server"`
-		CustomCertificate struct {
-			Active bool `json:"active"`
-		} `json:"custom_certificate"`
+		CustomCertificate    CustomCertificate `json:"custom_certificate"`
 		GeneratedCertificate struct {
 			Ca               string      `json:"ca"`
 			ValidationMethod string      `json:"validation_method"`
@@ -208,6 +195,13 @@ type SiteStatusResponse struct {
 	} `json:"debug_info"`
 }
 
+// SiteListResponse contains a page of managed sites
+type SiteListResponse struct {
+	Sites      []SiteStatusResponse `json:"sites"`
+	Res        FlexibleInt          `json:"res"`
+	ResMessage string               `json:"res_message"`
+}
+
 // AddSite adds a site to be managed by Incapsula
 func (c *Client) AddSite(domain, refID, sendSiteSetupEmails, siteIP, forceSSL string, accountID int, nakedDomainSan bool, wildcarSan bool, logsAccountId string) (*SiteAddResponse, error) {
 	log.Printf("[INFO] Adding Incapsula site for domain: %s (account ID %d)\n", domain, accountID)
@@ -242,14 +236,14 @@ func (c *Client) AddSite(domain, refID, sendSiteSetupEmails, siteIP, forceSSL st
 
 	// Parse the JSON
 	var siteAddResponse SiteAddResponse
-	err = json.Unmarshal([]byte(responseBody), &siteAddResponse)
+	err = decodeJSONResponse(endpointSiteAdd, responseBody, &siteAddResponse)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing add site JSON response for domain %s: %s", domain, err)
 	}
 
 	// Look at the response status code from Incapsula
 	if siteAddResponse.Res != 0 {
-		return nil, fmt.Errorf("Error from Incapsula service when adding site for domain %s: %s", domain, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when adding site for domain %s: %s", domain, string(responseBody))
 	}
 
 	return &siteAddResponse, nil
@@ -276,7 +270,7 @@ func (c *Client) SiteStatus(domain string, siteID int) (*SiteStatusResponse, err
 
 	// Parse the JSON
 	var siteStatusResponse SiteStatusResponse
-	err = json.Unmarshal([]byte(responseBody), &siteStatusResponse)
+	err = decodeJSONResponse(endpointSiteStatus, responseBody, &siteStatusResponse)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing site status JSON response for domain %s (site id: %d): %s", domain, siteID, err)
 	}
@@ -291,12 +285,91 @@ func (c *Client) SiteStatus(domain string, siteID int) (*SiteStatusResponse, err
 
 	// Look at the response status code from Incapsula
 	if resString != "0" {
-		return &siteStatusResponse, fmt.Errorf("Error from Incapsula service when getting site status for domain %s (site id: %d): %s", domain, siteID, string(responseBody))
+		return &siteStatusResponse, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when getting site status for domain %s (site id: %d): %s", domain, siteID, string(responseBody))
 	}
 
 	return &siteStatusResponse, nil
 }
 
+// ListSites gets a single page of the Incapsula managed sites of the account, with their full details
+func (c *Client) ListSites(accountID, pageNum int) (*SiteListResponse, error) {
+	log.Printf("[INFO] Listing Incapsula sites for account id: %d (page: %d)\n", accountID, pageNum)
+
+	// Post form to Incapsula
+	values := url.Values{
+		"page_size": {strconv.Itoa(PAGE_SIZE)},
+		"page_num":  {strconv.Itoa(pageNum)},
+		"extended":  {"true"},
+	}
+	if accountID != 0 {
+		values["account_id"] = []string{strconv.Itoa(accountID)}
+	}
+	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointSiteList)
+	resp, err := c.PostFormWithHeaders(reqURL, values, ReadSite)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing sites for account id %d: %s", accountID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula list sites JSON response: %s\n", string(responseBody))
+
+	// Parse the JSON
+	var siteListResponse SiteListResponse
+	err = decodeJSONResponse(endpointSiteList, responseBody, &siteListResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing list sites JSON response for account id %d: %s", accountID, err)
+	}
+
+	// Look at the response status code from Incapsula
+	if siteListResponse.Res != 0 {
+		return &siteListResponse, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when listing sites for account id %d: %s", accountID, string(responseBody))
+	}
+
+	return &siteListResponse, nil
+}
+
+// ListAllSites gets all the Incapsula managed sites of the account, page by page
+func (c *Client) ListAllSites(accountID int) ([]SiteStatusResponse, error) {
+	sites := make([]SiteStatusResponse, 0)
+
+	pageNum := 0
+	shouldFetch := true
+	for shouldFetch {
+		siteListResponse, err := c.ListSites(accountID, pageNum)
+		if err != nil {
+			return nil, err
+		}
+		sites = append(sites, siteListResponse.Sites...)
+		shouldFetch = len(siteListResponse.Sites) == PAGE_SIZE
+		pageNum++
+	}
+
+	return sites, nil
+}
+
+// FindSitesByDomain gets the Incapsula managed sites of the account with the given domain (case insensitive)
+func (c *Client) FindSitesByDomain(accountID int, domain string) ([]SiteStatusResponse, error) {
+	log.Printf("[INFO] Looking up Incapsula sites for domain: %s (account id: %d)\n", domain, accountID)
+
+	sites, err := c.ListAllSites(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]SiteStatusResponse, 0)
+	for _, site := range sites {
+		if strings.EqualFold(site.Domain, domain) {
+			matches = append(matches, site)
+		}
+	}
+
+	return matches, nil
+}
+
 // UpdateSite will update the specific param/value on the site resource
 func (c *Client) UpdateSite(siteID, param, value string) (*SiteUpdateResponse, error) {
 	log.Printf("[INFO] Updating Incapsula site for siteID: %s\n", siteID)
@@ -322,26 +395,63 @@ func (c *Client) UpdateSite(siteID, param, value string) (*SiteUpdateResponse, e
 
 	// Parse the JSON
 	var siteUpdateResponse SiteUpdateResponse
-	err = json.Unmarshal([]byte(responseBody), &siteUpdateResponse)
+	err = decodeJSONResponse(endpointSiteUpdate, responseBody, &siteUpdateResponse)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing update site JSON response for siteID %s: %s", siteID, err)
 	}
 
 	// Look at the response status code from Incapsula
 	if siteUpdateResponse.Res != 0 {
-		return nil, fmt.Errorf("Error from Incapsula service when updating site for siteID %s: %s", siteID, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when updating site for siteID %s: %s", siteID, string(responseBody))
 	}
 
 	return &siteUpdateResponse, nil
 }
 
+// MoveSite moves the site to a different account (e.g. from a parent account to one of its subaccounts)
+func (c *Client) MoveSite(siteID, destinationAccountID int) (*SiteUpdateResponse, error) {
+	log.Printf("[INFO] Moving Incapsula site %d to account: %d\n", siteID, destinationAccountID)
+
+	// Post form to Incapsula
+	values := url.Values{
+		"site_id":                {strconv.Itoa(siteID)},
+		"destination_account_id": {strconv.Itoa(destinationAccountID)},
+	}
+	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointSiteMove)
+	resp, err := c.PostFormWithHeaders(reqURL, values, MoveSite)
+	if err != nil {
+		return nil, fmt.Errorf("Error moving site_id: %d to account_id: %d: %s", siteID, destinationAccountID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula move site JSON response: %s\n", string(responseBody))
+
+	// Parse the JSON
+	var siteMoveResponse SiteUpdateResponse
+	err = decodeJSONResponse(endpointSiteMove, responseBody, &siteMoveResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing move site JSON response for siteID %d: %s", siteID, err)
+	}
+
+	// Look at the response status code from Incapsula
+	if siteMoveResponse.Res != 0 {
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when moving site for siteID %d to account_id: %d: %s", siteID, destinationAccountID, string(responseBody))
+	}
+
+	return &siteMoveResponse, nil
+}
+
 // DeleteSite deletes a site currently managed by Incapsula
 func (c *Client) DeleteSite(domain string, siteID int) error {
 	// Specifically shaded this struct, no need to share across funcs or export
 	// We only care about the response code and possibly the message
 	type SiteDeleteResponse struct {
-		Res        int    `json:"res"`
-		ResMessage string `json:"res_message"`
+		Res        FlexibleInt `json:"res"`
+		ResMessage string      `json:"res_message"`
 	}
 
 	log.Printf("[INFO] Deleting Incapsula site for domain: %s (site id: %d)\n", domain, siteID)
@@ -363,14 +473,14 @@ func (c *Client) DeleteSite(domain string, siteID int) error {
 
 	// Parse the JSON
 	var siteDeleteResponse SiteDeleteResponse
-	err = json.Unmarshal([]byte(responseBody), &siteDeleteResponse)
+	err = decodeJSONResponse(endpointSiteDelete, responseBody, &siteDeleteResponse)
 	if err != nil {
 		return fmt.Errorf("Error parsing delete site JSON response for domain %s (site id: %d): %s", domain, siteID, err)
 	}
 
 	// Look at the response status code from Incapsula
 	if siteDeleteResponse.Res != 0 {
-		return fmt.Errorf("Error from Incapsula service when deleting site for domain %s (site id: %d): %s", domain, siteID, string(responseBody))
+		return NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when deleting site for domain %s (site id: %d): %s", domain, siteID, string(responseBody))
 	}
 
 	return nil
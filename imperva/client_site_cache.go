@@ -0,0 +1,58 @@
+package imperva
+
+import (
+	"log"
+)
+
+// CachedSiteStatus gets the status of the site from the listing of its account with the full details of the sites,
+// listed once per provider run, so refreshing many sites takes a sites/list call per page instead of a status call
+// per site. It falls back to SiteStatus for the sites missing from the listing (e.g. deleted or in another account)
+// and when the listing fails. accountID is the site's account, 0 for the account of the API credentials.
+func (c *Client) CachedSiteStatus(domain string, siteID, accountID int) (*SiteStatusResponse, error) {
+	siteStatusResponse := c.getCachedSite(siteID, accountID)
+	if siteStatusResponse != nil {
+		log.Printf("[DEBUG] Using the listed details of Incapsula site for domain: %s (site id: %d)\n", domain, siteID)
+		return siteStatusResponse, nil
+	}
+
+	return c.SiteStatus(domain, siteID)
+}
+
+// InvalidateCachedSite removes the site from the cache after it was changed, the next read gets its status
+func (c *Client) InvalidateCachedSite(siteID int) {
+	c.siteCacheMutex.Lock()
+	defer c.siteCacheMutex.Unlock()
+
+	delete(c.siteCache, siteID)
+}
+
+func (c *Client) getCachedSite(siteID, accountID int) *SiteStatusResponse {
+	c.siteCacheMutex.Lock()
+	defer c.siteCacheMutex.Unlock()
+
+	if c.siteCache == nil {
+		c.siteCache = make(map[int]SiteStatusResponse)
+		c.siteCacheAccounts = make(map[int]bool)
+	}
+
+	// Each account is only listed once, even when the listing fails
+	if !c.siteCacheAccounts[accountID] {
+		c.siteCacheAccounts[accountID] = true
+
+		sites, err := c.ListAllSites(accountID)
+		if err != nil {
+			log.Printf("[WARN] Could not list Incapsula sites for account id %d, reading the sites one by one: %s\n", accountID, err)
+		}
+		for _, site := range sites {
+			// The listed sites don't have a res code, the readers check it as a site status response
+			site.Res = float64(0)
+			c.siteCache[site.SiteID] = site
+		}
+	}
+
+	site, ok := c.siteCache[siteID]
+	if !ok {
+		return nil
+	}
+	return &site
+}
@@ -0,0 +1,77 @@
+package imperva
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestClientCachedSiteStatus(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodPost, "/"+endpointSiteList, mockJSON(`{"sites":[{"site_id":1,"domain":"a.example.com","account_id":123},{"site_id":2,"domain":"b.example.com","account_id":123}],"res":0}`))
+	api.handle(http.MethodPost, "/"+endpointSiteStatus, mockJSON(`{"site_id":3,"domain":"c.example.com","res":0}`))
+
+	client := api.client()
+	for _, siteID := range []int{1, 2, 1} {
+		siteStatusResponse, err := client.CachedSiteStatus("example.com", siteID, 123)
+		if err != nil {
+			t.Errorf("Should not have received an error, got: %s", err)
+		}
+		if siteStatusResponse == nil || siteStatusResponse.SiteID != siteID || siteStatusResponse.Res != float64(0) {
+			t.Errorf("Should have received the listed site %d, got: %+v", siteID, siteStatusResponse)
+		}
+	}
+
+	listRequests := api.requestsTo(http.MethodPost, "/"+endpointSiteList)
+	if len(listRequests) != 1 {
+		t.Fatalf("Should have listed the sites once, got: %d requests", len(listRequests))
+	}
+	if listRequests[0].Form.Get("account_id") != "123" || listRequests[0].Form.Get("extended") != "true" {
+		t.Errorf("Should have listed the sites of the account with their details, got: %v", listRequests[0].Form)
+	}
+	if statusRequests := api.requestsTo(http.MethodPost, "/"+endpointSiteStatus); len(statusRequests) != 0 {
+		t.Errorf("Should not have read the listed sites, got: %d requests", len(statusRequests))
+	}
+
+	// Sites missing from the listing and changed ones are read
+	siteStatusResponse, err := client.CachedSiteStatus("c.example.com", 3, 123)
+	if err != nil || siteStatusResponse.SiteID != 3 {
+		t.Errorf("Should have read the missing site, got: %+v, %v", siteStatusResponse, err)
+	}
+	client.InvalidateCachedSite(1)
+	client.CachedSiteStatus("a.example.com", 1, 123)
+	if statusRequests := api.requestsTo(http.MethodPost, "/"+endpointSiteStatus); len(statusRequests) != 2 {
+		t.Errorf("Should have read the missing and changed sites, got: %d requests", len(statusRequests))
+	}
+}
+
+func TestClientCachedSiteStatusListingError(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodPost, "/"+endpointSiteList, mockRes(1, "Unexpected error"))
+	api.handle(http.MethodPost, "/"+endpointSiteStatus, mockJSON(`{"site_id":1,"domain":"a.example.com","res":0}`))
+
+	client := api.client()
+	for i := 0; i < 2; i++ {
+		siteStatusResponse, err := client.CachedSiteStatus("a.example.com", 1, 0)
+		if err != nil || siteStatusResponse.SiteID != 1 {
+			t.Errorf("Should have read the site, got: %+v, %v", siteStatusResponse, err)
+		}
+	}
+	if listRequests := api.requestsTo(http.MethodPost, "/"+endpointSiteList); len(listRequests) != 1 {
+		t.Errorf("Should not have listed the sites again, got: %d requests", len(listRequests))
+	}
+}
+
+func TestClientCachedSiteStatusCustomCertificate(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodPost, "/"+endpointSiteList, mockJSON(`{"sites":[{"site_id":1,"domain":"a.example.com","account_id":123,
+		"ssl":{"custom_certificate":{"active":true,"inputHash":"abc","expirationDate":1893456000000,"fingerprint":"AA:BB"}}}],"res":0}`))
+
+	siteStatusResponse, err := api.client().CachedSiteStatus("a.example.com", 1, 123)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	customCertificate := siteStatusResponse.Ssl.CustomCertificate
+	if !customCertificate.Active || customCertificate.InputHash != "abc" || customCertificate.ExpirationDate != 1893456000000 || customCertificate.Fingerprint != "AA:BB" {
+		t.Errorf("Should have received the custom certificate of the listed site, got: %+v", customCertificate)
+	}
+}
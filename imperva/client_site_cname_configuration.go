@@ -0,0 +1,86 @@
+package imperva
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+)
+
+// Endpoints (unexported consts)
+const endpointSiteCNAMEConfiguration = "sites/{site_id}/settings/cname"
+
+// SiteCNAMEConfiguration contains the CNAME settings of a site
+type SiteCNAMEConfiguration struct {
+	// The custom (branded) CNAME assigned to the site, empty for the CNAME generated by Imperva
+	CustomCNAME string `json:"customCname"`
+	// Allows other domains of the account to reuse the CNAME of the site, e.g. for wildcard onboarding
+	CNAMEReuse bool `json:"cnameReuse"`
+	// The hostname the domain must point to, returned by the API
+	EdgeHostname string `json:"edgeHostname,omitempty"`
+}
+
+// SiteCNAMEConfigurationDTO is the same DTO for the GET response, PUT request and PUT response
+type SiteCNAMEConfigurationDTO struct {
+	Errors []ApiError               `json:"errors,omitempty"`
+	Data   []SiteCNAMEConfiguration `json:"data"`
+}
+
+// GetSiteCNAMEConfiguration gets the CNAME settings of the site, along with the status code of the response
+func (c *Client) GetSiteCNAMEConfiguration(siteID int) (*SiteCNAMEConfiguration, int, error) {
+	log.Printf("[INFO] Getting Incapsula CNAME configuration for site id: %d\n", siteID)
+
+	return c.doSiteCNAMEConfigurationRequest(http.MethodGet, siteID, nil, ReadSiteCNAMEConfiguration, "getting CNAME configuration")
+}
+
+// UpdateSiteCNAMEConfiguration updates the CNAME settings of the site
+func (c *Client) UpdateSiteCNAMEConfiguration(siteID int, configuration *SiteCNAMEConfiguration) (*SiteCNAMEConfiguration, error) {
+	log.Printf("[INFO] Updating Incapsula CNAME configuration for site id: %d\n", siteID)
+
+	requestDTO := SiteCNAMEConfigurationDTO{Data: []SiteCNAMEConfiguration{*configuration}}
+	updatedConfiguration, _, err := c.doSiteCNAMEConfigurationRequest(http.MethodPut, siteID, &requestDTO, UpdateSiteCNAMEConfiguration, "updating CNAME configuration")
+	return updatedConfiguration, err
+}
+
+func (c *Client) doSiteCNAMEConfigurationRequest(method string, siteID int, requestDTO *SiteCNAMEConfigurationDTO, operation, action string) (*SiteCNAMEConfiguration, int, error) {
+	var requestJSON []byte
+	if requestDTO != nil {
+		var err error
+		requestJSON, err = json.Marshal(requestDTO)
+		if err != nil {
+			return nil, 0, fmt.Errorf("Failed to JSON marshal request when %s for site id %d: %s", action, siteID, err)
+		}
+	}
+
+	reqURL := fmt.Sprintf("%s/sites/%d/settings/cname", c.config.BaseURLRev2, siteID)
+	resp, err := c.DoJsonRequestWithHeaders(method, reqURL, requestJSON, operation)
+	if err != nil {
+		return nil, 0, fmt.Errorf("Error from Incapsula service when %s for site id %d: %s", action, siteID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula CNAME configuration JSON response: %s\n", string(responseBody))
+
+	// Check the response code
+	if resp.StatusCode != 200 {
+		return nil, resp.StatusCode, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when %s for site id %d: %s", resp.StatusCode, action, siteID, string(responseBody))
+	}
+
+	// Parse the JSON
+	var responseDTO SiteCNAMEConfigurationDTO
+	err = decodeJSONResponse(endpointSiteCNAMEConfiguration, responseBody, &responseDTO)
+	if err != nil {
+		return nil, resp.StatusCode, fmt.Errorf("Error parsing CNAME configuration JSON response for site id %d: %s\nresponse: %s", siteID, err, string(responseBody))
+	}
+
+	if len(responseDTO.Errors) > 0 || len(responseDTO.Data) == 0 {
+		return nil, resp.StatusCode, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when %s for site id %d: %s", action, siteID, string(responseBody))
+	}
+
+	return &responseDTO.Data[0], resp.StatusCode, nil
+}
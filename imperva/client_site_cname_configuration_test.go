@@ -0,0 +1,60 @@
+package imperva
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const siteCNAMEConfigurationPath = "/sites/123/settings/cname"
+
+func TestClientGetSiteCNAMEConfiguration(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodGet, siteCNAMEConfigurationPath, mockJSON(`{"data":[{"customCname":"shop.cdn.example.com","cnameReuse":true,"edgeHostname":"abcd.x.incapdns.net"}]}`))
+
+	configuration, statusCode, err := api.client().GetSiteCNAMEConfiguration(123)
+	if err != nil || statusCode != http.StatusOK {
+		t.Fatalf("Should not have received an error, got: %d, %v", statusCode, err)
+	}
+	if configuration.CustomCNAME != "shop.cdn.example.com" || !configuration.CNAMEReuse || configuration.EdgeHostname != "abcd.x.incapdns.net" {
+		t.Errorf("Should have received the configuration, got: %+v", configuration)
+	}
+}
+
+func TestClientGetSiteCNAMEConfigurationNotFound(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodGet, siteCNAMEConfigurationPath, mockAPIError(http.StatusNotFound, "Site not found"))
+
+	configuration, statusCode, err := api.client().GetSiteCNAMEConfiguration(123)
+	if err == nil || statusCode != http.StatusNotFound || configuration != nil {
+		t.Errorf("Should have received a not found error, got: %+v, %d, %v", configuration, statusCode, err)
+	}
+}
+
+func TestClientUpdateSiteCNAMEConfiguration(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodPut, siteCNAMEConfigurationPath, mockJSON(`{"data":[{"customCname":"","cnameReuse":true,"edgeHostname":"abcd.x.incapdns.net"}]}`))
+
+	configuration, err := api.client().UpdateSiteCNAMEConfiguration(123, &SiteCNAMEConfiguration{CNAMEReuse: true})
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if configuration.EdgeHostname != "abcd.x.incapdns.net" {
+		t.Errorf("Should have received the configuration, got: %+v", configuration)
+	}
+
+	body := api.requestsTo(http.MethodPut, siteCNAMEConfigurationPath)[0].Body
+	if body != `{"data":[{"customCname":"","cnameReuse":true}]}` {
+		t.Errorf("Should have sent the configuration, got: %s", body)
+	}
+}
+
+func TestClientUpdateSiteCNAMEConfigurationErrors(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodPut, siteCNAMEConfigurationPath, mockJSON(`{"errors":[{"status":"400","message":"CNAME is not available"}],"data":[]}`))
+
+	_, err := api.client().UpdateSiteCNAMEConfiguration(123, &SiteCNAMEConfiguration{CustomCNAME: "taken.example.com"})
+	if err == nil || !strings.Contains(err.Error(), "CNAME is not available") {
+		t.Errorf("Should have received the error, got: %v", err)
+	}
+}
@@ -1,4 +1,4 @@
-package incapsula
+package imperva
 
 import (
 	"encoding/json"
@@ -34,7 +34,7 @@ func (c *Client) GetMaskingSettings(siteID string) (*MaskingSettings, error) {
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Error status code %d from Incapsula service when reading masking settings for Site ID %s: %s", resp.StatusCode, siteID, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when reading masking settings for Site ID %s: %s", resp.StatusCode, siteID, string(responseBody))
 	}
 
 	// Parse the JSON
@@ -72,7 +72,7 @@ func (c *Client) UpdateMaskingSettings(siteID string, maskingSettings *MaskingSe
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return fmt.Errorf("Error status code %d from Incapsula service when updating masking settings for Site ID %s: %s", resp.StatusCode, siteID, string(responseBody))
+		return NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when updating masking settings for Site ID %s: %s", resp.StatusCode, siteID, string(responseBody))
 	}
 
 	return nil
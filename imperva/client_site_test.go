@@ -1,9 +1,10 @@
-package incapsula
+package imperva
 
 import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -380,3 +381,184 @@ func TestClientDeleteSiteValidSite(t *testing.T) {
 		t.Errorf("Should not have received an error")
 	}
 }
+
+////////////////////////////////////////////////////////////////
+// MoveSite Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientMoveSiteBadConnection(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+	siteID := 42
+	accountID := 123
+	moveSiteResponse, err := client.MoveSite(siteID, accountID)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), fmt.Sprintf("Error moving site_id: %d to account_id: %d", siteID, accountID)) {
+		t.Errorf("Should have received an client error, got: %s", err)
+	}
+	if moveSiteResponse != nil {
+		t.Errorf("Should have received a nil moveSiteResponse instance")
+	}
+}
+
+func TestClientMoveSiteInvalidAccount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointSiteMove) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointSiteMove, req.URL.String())
+		}
+		rw.Write([]byte(`{"res":9403,"res_message":"Unknown/unauthorized account_id"}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	siteID := 42
+	accountID := 123
+	moveSiteResponse, err := client.MoveSite(siteID, accountID)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), fmt.Sprintf("Error from Incapsula service when moving site for siteID %d to account_id: %d", siteID, accountID)) {
+		t.Errorf("Should have received a bad account error, got: %s", err)
+	}
+	if moveSiteResponse != nil {
+		t.Errorf("Should have received a nil moveSiteResponse instance")
+	}
+}
+
+func TestClientMoveSiteValidSite(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointSiteMove) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointSiteMove, req.URL.String())
+		}
+		if req.FormValue("destination_account_id") != "123" {
+			t.Errorf("Should have sent destination_account_id 123. Got: %s", req.FormValue("destination_account_id"))
+		}
+		rw.Write([]byte(`{"site_id":42,"res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	moveSiteResponse, err := client.MoveSite(42, 123)
+	if err != nil {
+		t.Errorf("Should not have received an error")
+	}
+	if moveSiteResponse == nil {
+		t.Fatalf("Should not have received a nil moveSiteResponse instance")
+	}
+	if moveSiteResponse.SiteID != 42 {
+		t.Errorf("Site ID doesn't match")
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// ListSites Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientListSitesBadConnection(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+	accountID := 123
+	siteListResponse, err := client.ListSites(accountID, 0)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), fmt.Sprintf("Error listing sites for account id %d", accountID)) {
+		t.Errorf("Should have received an client error, got: %s", err)
+	}
+	if siteListResponse != nil {
+		t.Errorf("Should have received a nil siteListResponse instance")
+	}
+}
+
+func TestClientListSitesBadJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointSiteList) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointSiteList, req.URL.String())
+		}
+		rw.Write([]byte(`{`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	accountID := 123
+	siteListResponse, err := client.ListSites(accountID, 0)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), fmt.Sprintf("Error parsing list sites JSON response for account id %d", accountID)) {
+		t.Errorf("Should have received a JSON parse error, got: %s", err)
+	}
+	if siteListResponse != nil {
+		t.Errorf("Should have received a nil siteListResponse instance")
+	}
+}
+
+func TestClientListAllSitesPagination(t *testing.T) {
+	sites := make([]string, 0)
+	for i := 0; i < PAGE_SIZE; i++ {
+		sites = append(sites, fmt.Sprintf(`{"site_id":%d,"domain":"www%d.example.com","res":0}`, i, i))
+	}
+
+	api := newMockIncapsulaAPI(t)
+	api.handlePages(http.MethodPost, "/"+endpointSiteList, "page_num",
+		mockJSON(fmt.Sprintf(`{"sites":[%s],"res":0}`, strings.Join(sites, ","))),
+		mockJSON(`{"sites":[{"site_id":1000,"domain":"last.example.com","res":0}],"res":0}`),
+	)
+
+	allSites, err := api.client().ListAllSites(123)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	requests := api.requestsTo(http.MethodPost, "/"+endpointSiteList)
+	if len(requests) != 2 {
+		t.Errorf("Should have requested 2 pages, got: %d", len(requests))
+	}
+	for _, request := range requests {
+		if request.Form.Get("account_id") != "123" || request.Form.Get("page_size") != strconv.Itoa(PAGE_SIZE) {
+			t.Errorf("Unexpected list sites request: %v", request.Form)
+		}
+	}
+	if len(allSites) != PAGE_SIZE+1 {
+		t.Fatalf("Should have received %d sites, got: %d", PAGE_SIZE+1, len(allSites))
+	}
+	if allSites[PAGE_SIZE].SiteID != 1000 {
+		t.Errorf("Site ID doesn't match")
+	}
+}
+
+func TestClientListAllSitesResError(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodPost, "/"+endpointSiteList, mockRes(9403, "Unknown/unauthorized account_id"))
+
+	sites, err := api.client().ListAllSites(123)
+	if err == nil {
+		t.Fatalf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error from Incapsula service when listing sites for account id 123") {
+		t.Errorf("Should have received a bad site error, got: %s", err)
+	}
+	if sites != nil {
+		t.Errorf("Should have received a nil sites instance")
+	}
+}
+
+func TestClientFindSitesByDomain(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodPost, "/"+endpointSiteList, mockJSON(`{"sites":[{"site_id":1,"domain":"www.example.com","res":0},{"site_id":2,"domain":"api.example.com","res":0}],"res":0}`))
+
+	sites, err := api.client().FindSitesByDomain(123, "API.example.com")
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if len(sites) != 1 {
+		t.Fatalf("Should have received 1 site, got: %d", len(sites))
+	}
+	if sites[0].SiteID != 2 {
+		t.Errorf("Site ID doesn't match")
+	}
+}
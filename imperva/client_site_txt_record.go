@@ -1,4 +1,4 @@
-package incapsula
+package imperva
 
 import (
 	"encoding/json"
@@ -54,7 +54,7 @@ func (c *Client) ReadTXTRecords(siteID int) (*TXTRecordResponse, error) {
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Error status code %d from Incapsula service when reading TXT record(s) for siteID: %d\n%s", resp.StatusCode, siteID, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when reading TXT record(s) for siteID: %d\n%s", resp.StatusCode, siteID, string(responseBody))
 	}
 
 	// Parse the JSON
@@ -102,7 +102,7 @@ func (c *Client) CreateTXTRecord(siteID int, txtRecordValueOne string, txtRecord
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Error status code %d from Incapsula service when updating TXT record(s) for siteID: %d\n%s", resp.StatusCode, siteID, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when updating TXT record(s) for siteID: %d\n%s", resp.StatusCode, siteID, string(responseBody))
 	}
 
 	// Parse the JSON
@@ -146,7 +146,7 @@ func (c *Client) UpdateTXTRecord(siteID int, txtRecordValueOne string, txtRecord
 
 	// Check the response code
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Error status code %d from Incapsula service when updating TXT record(s) for siteID: %d\n%s", resp.StatusCode, siteID, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when updating TXT record(s) for siteID: %d\n%s", resp.StatusCode, siteID, string(responseBody))
 	}
 
 	// Parse the JSON
@@ -182,7 +182,7 @@ func (c *Client) DeleteTXTRecord(siteID int, recordNumber string) error {
 	// Check the response code
 	// The response code of successful request is 400
 	if resp.StatusCode != 400 && !strings.Contains(string(response), "OK") {
-		return fmt.Errorf("Error status code %d from Incapsula service when deleting TXT record for siteID %d: %s", resp.StatusCode, siteID, string(responseBody))
+		return NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when deleting TXT record for siteID %d: %s", resp.StatusCode, siteID, string(responseBody))
 	}
 
 	return nil
@@ -206,7 +206,7 @@ func (c *Client) DeleteTXTRecordAll(siteID int) error {
 	response := []byte(responseBody)
 	// Check the response code
 	if resp.StatusCode != 400 && !strings.Contains(string(response), "OK") {
-		return fmt.Errorf("Error status code %d from Incapsula service when deleting all "+
+		return NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when deleting all "+
 			"TXT records for siteID %d: %s", resp.StatusCode, siteID, string(responseBody))
 	}
 
@@ -1,7 +1,6 @@
-package incapsula
+package imperva
 
 import (
-	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -89,14 +88,14 @@ func (c *Client) AddSubAccount(subAccountPayload *SubAccountPayload) (*SubAccoun
 
 	// Parse the JSON
 	var subAccountAddResponse SubAccountAddResponse
-	err = json.Unmarshal([]byte(responseBody), &subAccountAddResponse)
+	err = decodeJSONResponse(endpointSubAccountAdd, responseBody, &subAccountAddResponse)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing add subaccount JSON response for subaccount %s: %s", subAccountPayload.SubAccountName, err)
 	}
 
 	// Look at the response status code from Incapsula
 	if subAccountAddResponse.Res != 0 {
-		return nil, fmt.Errorf("Error from Incapsula service when adding subaccount %s: %s", subAccountPayload.SubAccountName, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when adding subaccount %s: %s", subAccountPayload.SubAccountName, string(responseBody))
 	}
 
 	return &subAccountAddResponse, nil
@@ -112,7 +111,7 @@ func (c *Client) GetSubAccount(parentAccountID int, subAccountID int) (*SubAccou
 	// Pagination (default page size 50)
 	for shouldFetch {
 		log.Printf("[DEBUG] looking for subaccount %d, fetching for page: %d", subAccountID, count)
-		var subAccounts, error = c.sendListSubAccountsRequest(parentAccountID, count)
+		var subAccounts, error = c.ListSubAccounts(parentAccountID, count)
 		if error != nil {
 			return nil, error
 		}
@@ -129,7 +128,8 @@ func (c *Client) GetSubAccount(parentAccountID int, subAccountID int) (*SubAccou
 	return nil, nil
 }
 
-func (c *Client) sendListSubAccountsRequest(accountId int, pageNum int) ([]SubAccount, error) {
+// ListSubAccounts lists a page of the subaccounts of the account (the account of the API credentials if 0)
+func (c *Client) ListSubAccounts(accountId int, pageNum int) ([]SubAccount, error) {
 	values := map[string][]string{}
 
 	if accountId != 0 {
@@ -158,7 +158,7 @@ func (c *Client) sendListSubAccountsRequest(accountId int, pageNum int) ([]SubAc
 
 	// Parse the JSON
 	var subAccountListResponse SubAccountListResponse
-	err = json.Unmarshal([]byte(responseBody), &subAccountListResponse)
+	err = decodeJSONResponse(endpointSubAccountList, responseBody, &subAccountListResponse)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing subaccounts list JSON response for accountid: %d %s\nresponse: %s", accountId, err, string(responseBody))
 	}
@@ -194,14 +194,14 @@ func (c *Client) DeleteSubAccount(subAccountID int) error {
 
 	// Parse the JSON
 	var subaccountDeleteResponse SubAccountDeleteResponse
-	err = json.Unmarshal([]byte(responseBody), &subaccountDeleteResponse)
+	err = decodeJSONResponse(endpointSubAccountDelete, responseBody, &subaccountDeleteResponse)
 	if err != nil {
 		return fmt.Errorf("Error parsing delete account JSON response for subaccount id: %d: %s", subAccountID, err)
 	}
 
 	// Look at the response status code from Incapsula
 	if subaccountDeleteResponse.Res != 0 {
-		return fmt.Errorf("Error from Incapsula service when deleting subaccount id: %d: %s", subAccountID, string(responseBody))
+		return NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when deleting subaccount id: %d: %s", subAccountID, string(responseBody))
 	}
 
 	return nil
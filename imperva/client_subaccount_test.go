@@ -1,4 +1,4 @@
-package incapsula
+package imperva
 
 import (
 	"fmt"
@@ -1,7 +1,9 @@
-package incapsula
+package imperva
 
 import (
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -80,3 +82,55 @@ func TestClientVerifyValidAccount(t *testing.T) {
 		t.Errorf("Should not have received an error, got: %s", err)
 	}
 }
+
+////////////////////////////////////////////////////////////////
+// Transport Tests
+////////////////////////////////////////////////////////////////
+func TestClientUsesConfiguredTransport(t *testing.T) {
+	var requests []*http.Request
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "https://my.incapsula.com/api/prov/v1"}
+	config.Transport = mockTransport(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader(`{"res":0,"res_message":"OK"}`)),
+			Header:     make(http.Header),
+			Request:    req,
+		}, nil
+	})
+
+	_, err := NewClient(config).Verify()
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if len(requests) != 1 {
+		t.Fatalf("Should have sent 1 request through the transport, got: %d", len(requests))
+	}
+	if requests[0].Header.Get("x-api-id") != "foo" || requests[0].Header.Get("x-tf-operation") != VerifyAccount {
+		t.Errorf("Unexpected request headers: %v", requests[0].Header)
+	}
+}
+
+func TestClientVerifyTransportError(t *testing.T) {
+	_, err := newMockTransportClient(errors.New("connection reset by peer")).Verify()
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error checking account") || !strings.Contains(err.Error(), "connection reset by peer") {
+		t.Errorf("Should have received a transport error, got: %s", err)
+	}
+}
+
+func TestClientVerifyMockAPI(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodPost, "/"+endpointAccountStatus, mockRes(0, "OK"))
+
+	_, err := api.client().Verify()
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	requests := api.requestsTo(http.MethodPost, "/"+endpointAccountStatus)
+	if len(requests) != 1 || requests[0].Header.Get("x-api-key") != "bar" {
+		t.Errorf("Unexpected account status requests: %+v", requests)
+	}
+}
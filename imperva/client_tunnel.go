@@ -0,0 +1,98 @@
+package imperva
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+)
+
+// Endpoints (unexported consts)
+const endpointTunnel = "tunnels"
+
+// Tunnel types
+const TunnelTypeGRE = "GRE"
+const TunnelTypeIPsec = "IPSEC"
+
+// Tunnel is a GRE or IPsec tunnel returning clean traffic of a protected IP range to the customer.
+// GREKey and PreSharedKey are only sent to the API, they are never returned.
+type Tunnel struct {
+	ID                 string `json:"id,omitempty"`
+	Type               string `json:"type"`
+	Name               string `json:"name"`
+	IPRangeID          string `json:"ipRangeId"`
+	CustomerEndpointIP string `json:"customerEndpointIp"`
+	GREKey             int    `json:"greKey,omitempty"`
+	PreSharedKey       string `json:"preSharedKey,omitempty"`
+	IKEVersion         string `json:"ikeVersion,omitempty"`
+	ImpervaEndpointIP  string `json:"impervaEndpointIp,omitempty"`
+	ImpervaInnerIP     string `json:"impervaInnerIp,omitempty"`
+	CustomerInnerIP    string `json:"customerInnerIp,omitempty"`
+	Status             string `json:"status,omitempty"`
+}
+
+// TunnelResponse contains the tunnel returned by the API
+type TunnelResponse struct {
+	Data []Tunnel `json:"data"`
+}
+
+// AddTunnel adds a tunnel to an account
+func (c *Client) AddTunnel(accountID int, tunnel *Tunnel) (*Tunnel, error) {
+	log.Printf("[INFO] Adding Incapsula %s tunnel %s for account %d\n", tunnel.Type, tunnel.Name, accountID)
+
+	responseBody, _, err := c.doInfraProtectRequest(http.MethodPost, endpointTunnel, accountID, tunnel, CreateTunnel, fmt.Sprintf("adding %s tunnel", tunnel.Type))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseTunnelResponse(responseBody, "add tunnel")
+}
+
+// GetTunnel gets a tunnel, along with the status code of the response
+func (c *Client) GetTunnel(accountID int, tunnelID string) (*Tunnel, int, error) {
+	log.Printf("[INFO] Getting Incapsula tunnel %s for account %d\n", tunnelID, accountID)
+
+	path := fmt.Sprintf("%s/%s", endpointTunnel, tunnelID)
+	responseBody, statusCode, err := c.doInfraProtectRequest(http.MethodGet, path, accountID, nil, ReadTunnel, fmt.Sprintf("reading tunnel %s", tunnelID))
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	tunnel, err := parseTunnelResponse(responseBody, fmt.Sprintf("read tunnel %s", tunnelID))
+	return tunnel, statusCode, err
+}
+
+// UpdateTunnel updates a tunnel
+func (c *Client) UpdateTunnel(accountID int, tunnelID string, tunnel *Tunnel) (*Tunnel, error) {
+	log.Printf("[INFO] Updating Incapsula tunnel %s for account %d\n", tunnelID, accountID)
+
+	path := fmt.Sprintf("%s/%s", endpointTunnel, tunnelID)
+	responseBody, _, err := c.doInfraProtectRequest(http.MethodPut, path, accountID, tunnel, UpdateTunnel, fmt.Sprintf("updating tunnel %s", tunnelID))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseTunnelResponse(responseBody, fmt.Sprintf("update tunnel %s", tunnelID))
+}
+
+// DeleteTunnel deletes a tunnel
+func (c *Client) DeleteTunnel(accountID int, tunnelID string) error {
+	log.Printf("[INFO] Deleting Incapsula tunnel %s for account %d\n", tunnelID, accountID)
+
+	path := fmt.Sprintf("%s/%s", endpointTunnel, tunnelID)
+	_, _, err := c.doInfraProtectRequest(http.MethodDelete, path, accountID, nil, DeleteTunnel, fmt.Sprintf("deleting tunnel %s", tunnelID))
+	return err
+}
+
+func parseTunnelResponse(responseBody []byte, action string) (*Tunnel, error) {
+	var tunnelResponse TunnelResponse
+	err := decodeJSONResponse(fmt.Sprintf("%s/%s", endpointInfraProtect, endpointTunnel), responseBody, &tunnelResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %s JSON response: %s\nresponse: %s", action, err, string(responseBody))
+	}
+
+	if len(tunnelResponse.Data) == 0 {
+		return nil, fmt.Errorf("Error parsing %s JSON response: no tunnel returned\nresponse: %s", action, string(responseBody))
+	}
+
+	return &tunnelResponse.Data[0], nil
+}
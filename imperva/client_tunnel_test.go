@@ -0,0 +1,132 @@
+package imperva
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////
+// AddTunnel Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientAddTunnelBadConnection(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+
+	tunnel, err := client.AddTunnel(0, &Tunnel{Type: TunnelTypeGRE, Name: "gre-1"})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error from Incapsula service when adding GRE tunnel") {
+		t.Errorf("Should have received a client error, got: %s", err)
+	}
+	if tunnel != nil {
+		t.Errorf("Should have received a nil tunnel instance")
+	}
+}
+
+func TestClientAddTunnelBadJSON(t *testing.T) {
+	endpoint := fmt.Sprintf("/%s/%s?caid=42", endpointInfraProtect, endpointTunnel)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		rw.Write([]byte(`{`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	tunnel, err := client.AddTunnel(42, &Tunnel{Type: TunnelTypeGRE, Name: "gre-1"})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error parsing add tunnel JSON response") {
+		t.Errorf("Should have received a JSON parse error, got: %s", err)
+	}
+	if tunnel != nil {
+		t.Errorf("Should have received a nil tunnel instance")
+	}
+}
+
+func TestClientAddTunnelValid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		if !strings.Contains(string(body), `"preSharedKey":"secret-key"`) {
+			t.Errorf("Should have sent the pre-shared key. Got: %s", string(body))
+		}
+		rw.Write([]byte(`{"data":[{"id":"123","type":"IPSEC","name":"ipsec-1","ipRangeId":"7","customerEndpointIp":"198.51.100.1","ikeVersion":"IKEV2","impervaEndpointIp":"203.0.113.1","impervaInnerIp":"10.0.0.1","customerInnerIp":"10.0.0.2","status":"DOWN"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	tunnel, err := client.AddTunnel(0, &Tunnel{Type: TunnelTypeIPsec, Name: "ipsec-1", IPRangeID: "7", CustomerEndpointIP: "198.51.100.1", PreSharedKey: "secret-key"})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if tunnel == nil || tunnel.ID != "123" || tunnel.ImpervaEndpointIP != "203.0.113.1" || tunnel.PreSharedKey != "" {
+		t.Errorf("Unexpected tunnel: %+v", tunnel)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// GetTunnel Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetTunnelNotFound(t *testing.T) {
+	endpoint := fmt.Sprintf("/%s/%s/123", endpointInfraProtect, endpointTunnel)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		rw.WriteHeader(404)
+		rw.Write([]byte(`{"errors":[{"status":"404","detail":"Tunnel not found"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	tunnel, statusCode, err := client.GetTunnel(0, "123")
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error status code 404 from Incapsula service when reading tunnel 123") {
+		t.Errorf("Should have received a not found error, got: %s", err)
+	}
+	if statusCode != 404 {
+		t.Errorf("Should have received a 404 status code, got: %d", statusCode)
+	}
+	if tunnel != nil {
+		t.Errorf("Should have received a nil tunnel instance")
+	}
+}
+
+func TestClientGetTunnelValid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"data":[{"id":"123","type":"GRE","name":"gre-1","ipRangeId":"7","customerEndpointIp":"198.51.100.1","impervaEndpointIp":"203.0.113.1","status":"UP"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	tunnel, statusCode, err := client.GetTunnel(0, "123")
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if statusCode != 200 {
+		t.Errorf("Should have received a 200 status code, got: %d", statusCode)
+	}
+	if tunnel == nil || tunnel.Type != TunnelTypeGRE || tunnel.Status != "UP" {
+		t.Errorf("Unexpected tunnel: %+v", tunnel)
+	}
+}
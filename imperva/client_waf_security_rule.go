@@ -1,7 +1,6 @@
-package incapsula
+package imperva
 
 import (
-	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -13,14 +12,14 @@ import (
 const endpointWAFRuleConfigure = "sites/configure/security"
 
 // WAF Rule Enumerations
-const backdoorRuleID = "api.threats.backdoor"
-const crossSiteScriptingRuleID = "api.threats.cross_site_scripting"
-const illegalResourceAccessRuleID = "api.threats.illegal_resource_access"
-const remoteFileInclusionRuleID = "api.threats.remote_file_inclusion"
-const sqlInjectionRuleID = "api.threats.sql_injection"
-const ddosRuleID = "api.threats.ddos"
-const botAccessControlRuleID = "api.threats.bot_access_control"
-const customRuleDefaultActionID = "api.threats.customRule"
+const BackdoorRuleID = "api.threats.backdoor"
+const CrossSiteScriptingRuleID = "api.threats.cross_site_scripting"
+const IllegalResourceAccessRuleID = "api.threats.illegal_resource_access"
+const RemoteFileInclusionRuleID = "api.threats.remote_file_inclusion"
+const SQLInjectionRuleID = "api.threats.sql_injection"
+const DDoSRuleID = "api.threats.ddos"
+const BotAccessControlRuleID = "api.threats.bot_access_control"
+const CustomRuleDefaultActionID = "api.threats.customRule"
 
 // ConfigureWAFSecurityRule adds an WAF rule
 func (c *Client) ConfigureWAFSecurityRule(siteID int, ruleID, securityRuleAction, activationMode, ddosTrafficThreshold, blockBadBots, challengeSuspectedBots string) (*SiteStatusResponse, error) {
@@ -31,14 +30,14 @@ func (c *Client) ConfigureWAFSecurityRule(siteID int, ruleID, securityRuleAction
 	}
 
 	// Additional URL values for specific rule ids
-	if ruleID == backdoorRuleID || ruleID == crossSiteScriptingRuleID || ruleID == illegalResourceAccessRuleID || ruleID == remoteFileInclusionRuleID || ruleID == sqlInjectionRuleID {
+	if ruleID == BackdoorRuleID || ruleID == CrossSiteScriptingRuleID || ruleID == IllegalResourceAccessRuleID || ruleID == RemoteFileInclusionRuleID || ruleID == SQLInjectionRuleID {
 		values.Add("security_rule_action", securityRuleAction)
 		log.Printf("[INFO] Configuring Incapsula WAF rule id (%s) with security rule action (%s) for site id (%d)\n", ruleID, securityRuleAction, siteID)
-	} else if ruleID == ddosRuleID {
+	} else if ruleID == DDoSRuleID {
 		values.Add("activation_mode", activationMode)
 		values.Add("ddos_traffic_threshold", ddosTrafficThreshold)
 		log.Printf("[INFO] Configuring Incapsula WAF rule id (%s) with activation mode (%s) and DDoS traffic threshold (%s) for site id (%d)\n", ruleID, activationMode, ddosTrafficThreshold, siteID)
-	} else if ruleID == botAccessControlRuleID {
+	} else if ruleID == BotAccessControlRuleID {
 		values.Add("block_bad_bots", blockBadBots)
 		values.Add("challenge_suspected_bots", challengeSuspectedBots)
 		log.Printf("[INFO] Configuring Incapsula WAF rule id (%s) with block_bad_bots (%s) and challenge suspected bots (%s) for site id (%d)\n", ruleID, blockBadBots, challengeSuspectedBots, siteID)
@@ -62,7 +61,7 @@ func (c *Client) ConfigureWAFSecurityRule(siteID int, ruleID, securityRuleAction
 
 	// Parse the JSON
 	var siteStatusResponse SiteStatusResponse
-	err = json.Unmarshal([]byte(responseBody), &siteStatusResponse)
+	err = decodeJSONResponse(endpointWAFRuleConfigure, responseBody, &siteStatusResponse)
 	if err != nil {
 		return nil, fmt.Errorf("Error parsing configure WAF rule JSON response for rule_id (%s) and site_id (%d)", ruleID, siteID)
 	}
@@ -79,7 +78,7 @@ func (c *Client) ConfigureWAFSecurityRule(siteID int, ruleID, securityRuleAction
 
 	// Look at the response status code from Incapsula
 	if resString != "0" {
-		return nil, fmt.Errorf("Error from Incapsula service when adding WAF rule for rule_id (%s) and site_id (%d): %s", ruleID, siteID, string(responseBody))
+		return nil, NewAPIError(resp.StatusCode, responseBody, "Error from Incapsula service when adding WAF rule for rule_id (%s) and site_id (%d): %s", ruleID, siteID, string(responseBody))
 	}
 
 	return &siteStatusResponse, nil
@@ -1,4 +1,4 @@
-package incapsula
+package imperva
 
 import (
 	"fmt"
@@ -132,7 +132,7 @@ func TestClientConfigureWAFSecurityRuleInvalidRule_activationMode(t *testing.T)
 	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
 	client := &Client{config: config, httpClient: &http.Client{}}
 	siteID := 1234
-	ruleID := backdoorRuleID
+	ruleID := BackdoorRuleID
 	activationMode := "api.threats.ddos.activation_mode.on"
 	ddosTrafficThreshold := "123"
 	configureWAFSecurityRuleResponse, err := client.ConfigureWAFSecurityRule(siteID, ruleID, "", activationMode, ddosTrafficThreshold, "", "")
@@ -161,7 +161,7 @@ func TestClientConfigureWAFSecurityRuleInvalidRule_ddosThreshold(t *testing.T) {
 	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
 	client := &Client{config: config, httpClient: &http.Client{}}
 	siteID := 1234
-	ruleID := ddosRuleID
+	ruleID := DDoSRuleID
 	activationMode := "api.threats.ddos.activation_mode.on"
 	ddosTrafficThreshold := "123"
 	configureWAFSecurityRuleResponse, err := client.ConfigureWAFSecurityRule(siteID, ruleID, "", activationMode, ddosTrafficThreshold, "", "")
@@ -190,7 +190,7 @@ func TestClientConfigureWAFSecurityRuleInvalidRule_blockBadBots(t *testing.T) {
 	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
 	client := &Client{config: config, httpClient: &http.Client{}}
 	siteID := 1234
-	ruleID := botAccessControlRuleID
+	ruleID := BotAccessControlRuleID
 	challengeSuspectedBots := "true"
 	blockBadBots := "123"
 	configureWAFSecurityRuleResponse, err := client.ConfigureWAFSecurityRule(siteID, ruleID, "", "", "", blockBadBots, challengeSuspectedBots)
@@ -219,7 +219,7 @@ func TestClientConfigureWAFSecurityRuleInvalidRule_challengeSuspectedBots(t *tes
 	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
 	client := &Client{config: config, httpClient: &http.Client{}}
 	siteID := 1234
-	ruleID := botAccessControlRuleID
+	ruleID := BotAccessControlRuleID
 	challengeSuspectedBots := "123"
 	blockBadBots := "true"
 	configureWAFSecurityRuleResponse, err := client.ConfigureWAFSecurityRule(siteID, ruleID, "", "", "", blockBadBots, challengeSuspectedBots)
@@ -252,7 +252,7 @@ func TestClientConfigureWAFSecurityRuleValidRule(t *testing.T) {
 	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
 	client := &Client{config: config, httpClient: &http.Client{}}
 	siteID := 1234
-	ruleID := backdoorRuleID
+	ruleID := BackdoorRuleID
 	securityRuleAction := "api.threats.action.quarantine_url"
 	configureWAFSecurityRuleResponse, err := client.ConfigureWAFSecurityRule(siteID, ruleID, securityRuleAction, "", "", "", "")
 	if err != nil {
@@ -277,7 +277,7 @@ func TestClientConfigureWAFSecurityRuleResultCodeStringValidRule(t *testing.T) {
 	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
 	client := &Client{config: config, httpClient: &http.Client{}}
 	siteID := 1234
-	ruleID := backdoorRuleID
+	ruleID := BackdoorRuleID
 	securityRuleAction := "api.threats.action.quarantine_url"
 	configureWAFSecurityRuleResponse, err := client.ConfigureWAFSecurityRule(siteID, ruleID, securityRuleAction, "", "", "", "")
 	if err != nil {
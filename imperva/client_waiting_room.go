@@ -0,0 +1,140 @@
+package imperva
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+)
+
+// Endpoints (unexported consts)
+const endpointWaitingRoom = "waiting-room-settings/v3/sites/{site_id}/waiting-rooms"
+
+// WaitingRoom is a waiting room of a site, queuing the visitors when the traffic exceeds its thresholds.
+// StartTime and EndTime, in milliseconds since the epoch, restrict the waiting room to an activation window
+type WaitingRoom struct {
+	ID                          int64  `json:"id,omitempty"`
+	AccountID                   int64  `json:"accountId,omitempty"`
+	Name                        string `json:"name"`
+	Description                 string `json:"description,omitempty"`
+	Enabled                     bool   `json:"enabled"`
+	HTMLTemplateBase64          string `json:"htmlTemplateBase64,omitempty"`
+	Filter                      string `json:"filter,omitempty"`
+	BotsActionInQueuingMode     string `json:"botsActionInQueuingMode,omitempty"`
+	QueueInactivityTimeout      int    `json:"queueInactivityTimeout,omitempty"`
+	IsEntranceRateEnabled       bool   `json:"isEntranceRateEnabled"`
+	EntranceRateThreshold       int    `json:"entranceRateThreshold,omitempty"`
+	IsConcurrentSessionsEnabled bool   `json:"isConcurrentSessionsEnabled"`
+	ConcurrentSessionsThreshold int    `json:"concurrentSessionsThreshold,omitempty"`
+	InactivityTimeout           int    `json:"inactivityTimeout,omitempty"`
+	HidePositionInLine          bool   `json:"hidePositionInLine"`
+	StartTime                   int64  `json:"startTime,omitempty"`
+	EndTime                     int64  `json:"endTime,omitempty"`
+	Mode                        string `json:"mode,omitempty"`
+	CreatedAt                   int64  `json:"createdAt,omitempty"`
+	LastModifiedAt              int64  `json:"lastModifiedAt,omitempty"`
+	LastModifiedBy              string `json:"lastModifiedBy,omitempty"`
+}
+
+// WaitingRoomResponse contains the waiting rooms returned by the API
+type WaitingRoomResponse struct {
+	Value   []WaitingRoom `json:"value"`
+	IsError bool          `json:"isError"`
+}
+
+// AddWaitingRoom adds a waiting room to a site
+func (c *Client) AddWaitingRoom(accountID, siteID int, waitingRoom *WaitingRoom) (*WaitingRoom, error) {
+	log.Printf("[INFO] Adding Incapsula waiting room %s for site id %d\n", waitingRoom.Name, siteID)
+
+	responseBody, _, err := c.doWaitingRoomRequest(http.MethodPost, siteID, "", accountID, waitingRoom, CreateWaitingRoom, "adding waiting room")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseWaitingRoomResponse(responseBody, "add waiting room")
+}
+
+// GetWaitingRoom gets a waiting room of a site, along with the status code of the response
+func (c *Client) GetWaitingRoom(accountID, siteID int, waitingRoomID string) (*WaitingRoom, int, error) {
+	log.Printf("[INFO] Getting Incapsula waiting room %s for site id %d\n", waitingRoomID, siteID)
+
+	responseBody, statusCode, err := c.doWaitingRoomRequest(http.MethodGet, siteID, waitingRoomID, accountID, nil, ReadWaitingRoom, fmt.Sprintf("reading waiting room %s", waitingRoomID))
+	if err != nil {
+		return nil, statusCode, err
+	}
+
+	waitingRoom, err := parseWaitingRoomResponse(responseBody, fmt.Sprintf("read waiting room %s", waitingRoomID))
+	return waitingRoom, statusCode, err
+}
+
+// UpdateWaitingRoom updates a waiting room of a site
+func (c *Client) UpdateWaitingRoom(accountID, siteID int, waitingRoomID string, waitingRoom *WaitingRoom) (*WaitingRoom, error) {
+	log.Printf("[INFO] Updating Incapsula waiting room %s for site id %d\n", waitingRoomID, siteID)
+
+	responseBody, _, err := c.doWaitingRoomRequest(http.MethodPut, siteID, waitingRoomID, accountID, waitingRoom, UpdateWaitingRoom, fmt.Sprintf("updating waiting room %s", waitingRoomID))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseWaitingRoomResponse(responseBody, fmt.Sprintf("update waiting room %s", waitingRoomID))
+}
+
+// DeleteWaitingRoom deletes a waiting room of a site, along with the status code of the response
+func (c *Client) DeleteWaitingRoom(accountID, siteID int, waitingRoomID string) (int, error) {
+	log.Printf("[INFO] Deleting Incapsula waiting room %s for site id %d\n", waitingRoomID, siteID)
+
+	_, statusCode, err := c.doWaitingRoomRequest(http.MethodDelete, siteID, waitingRoomID, accountID, nil, DeleteWaitingRoom, fmt.Sprintf("deleting waiting room %s", waitingRoomID))
+	return statusCode, err
+}
+
+// doWaitingRoomRequest sends a JSON request to the waiting rooms API of the site, for the waiting room when its ID
+// is set, and returns the response body and status code. action describes the request for error messages.
+func (c *Client) doWaitingRoomRequest(method string, siteID int, waitingRoomID string, accountID int, body interface{}, operation, action string) ([]byte, int, error) {
+	var requestJSON []byte
+	if body != nil {
+		var err error
+		requestJSON, err = json.Marshal(body)
+		if err != nil {
+			return nil, 0, fmt.Errorf("Failed to JSON marshal request when %s for site id %d: %s", action, siteID, err)
+		}
+		log.Printf("[DEBUG] Incapsula waiting room JSON request when %s for site id %d: %s\n", action, siteID, string(requestJSON))
+	}
+
+	reqURL := fmt.Sprintf("%s/waiting-room-settings/v3/sites/%d/waiting-rooms", c.config.BaseURLAPI, siteID)
+	if waitingRoomID != "" {
+		reqURL = fmt.Sprintf("%s/%s", reqURL, waitingRoomID)
+	}
+	resp, err := c.DoJsonAndQueryParamsRequestWithHeaders(method, reqURL, requestJSON, GetRequestParamsWithCaid(accountID), operation)
+	if err != nil {
+		return nil, 0, fmt.Errorf("Error from Incapsula service when %s for site id %d: %s", action, siteID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula waiting room JSON response when %s for site id %d: %s\n", action, siteID, string(responseBody))
+
+	// Check the response code
+	if resp.StatusCode != 200 {
+		return responseBody, resp.StatusCode, NewAPIError(resp.StatusCode, responseBody, "Error status code %d from Incapsula service when %s for site id %d: %s", resp.StatusCode, action, siteID, string(responseBody))
+	}
+
+	return responseBody, resp.StatusCode, nil
+}
+
+func parseWaitingRoomResponse(responseBody []byte, action string) (*WaitingRoom, error) {
+	var waitingRoomResponse WaitingRoomResponse
+	err := decodeJSONResponse(endpointWaitingRoom, responseBody, &waitingRoomResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %s JSON response: %s\nresponse: %s", action, err, string(responseBody))
+	}
+
+	if waitingRoomResponse.IsError || len(waitingRoomResponse.Value) == 0 {
+		return nil, fmt.Errorf("Error parsing %s JSON response: no waiting room returned\nresponse: %s", action, string(responseBody))
+	}
+
+	return &waitingRoomResponse.Value[0], nil
+}
@@ -0,0 +1,83 @@
+package imperva
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const waitingRoomPath = "/waiting-room-settings/v3/sites/123/waiting-rooms"
+
+func TestClientAddWaitingRoom(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodPost, waitingRoomPath, mockJSON(`{"value":[{"id":42,"accountId":456,"name":"checkout","enabled":true,"isEntranceRateEnabled":true,"entranceRateThreshold":600,"mode":"NOT_QUEUING"}],"isError":false}`))
+
+	waitingRoom, err := api.client().AddWaitingRoom(456, 123, &WaitingRoom{Name: "checkout", Enabled: true, IsEntranceRateEnabled: true, EntranceRateThreshold: 600})
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if waitingRoom.ID != 42 || waitingRoom.Mode != "NOT_QUEUING" {
+		t.Errorf("Should have received the waiting room, got: %+v", waitingRoom)
+	}
+
+	requests := api.requestsTo(http.MethodPost, waitingRoomPath)
+	if len(requests) != 1 || requests[0].Query.Get("caid") != "456" {
+		t.Fatalf("Should have added the waiting room to the account, got: %+v", requests)
+	}
+	var sent map[string]interface{}
+	if err := json.Unmarshal([]byte(requests[0].Body), &sent); err != nil || sent["entranceRateThreshold"] != float64(600) || sent["isConcurrentSessionsEnabled"] != false || sent["startTime"] != nil {
+		t.Errorf("Should have sent the waiting room, got: %s", requests[0].Body)
+	}
+}
+
+func TestClientGetWaitingRoomNotFound(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodGet, waitingRoomPath+"/42", mockAPIError(http.StatusNotFound, "Waiting room not found"))
+
+	waitingRoom, statusCode, err := api.client().GetWaitingRoom(0, 123, "42")
+	if err == nil || statusCode != http.StatusNotFound || waitingRoom != nil {
+		t.Errorf("Should have received a not found error, got: %+v, %d, %v", waitingRoom, statusCode, err)
+	}
+	if err != nil && !strings.HasPrefix(err.Error(), "Error status code 404 from Incapsula service when reading waiting room 42 for site id 123") {
+		t.Errorf("Should have described the request, got: %s", err)
+	}
+}
+
+func TestClientUpdateWaitingRoomNoValue(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodPut, waitingRoomPath+"/42", mockJSON(`{"value":[],"isError":true}`))
+
+	_, err := api.client().UpdateWaitingRoom(0, 123, "42", &WaitingRoom{Name: "checkout"})
+	if err == nil || !strings.Contains(err.Error(), "no waiting room returned") {
+		t.Errorf("Should have received an error, got: %v", err)
+	}
+}
+
+func TestClientDeleteWaitingRoom(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodDelete, waitingRoomPath+"/42", mockJSON(`{"value":[],"isError":false}`))
+
+	statusCode, err := api.client().DeleteWaitingRoom(0, 123, "42")
+	if err != nil || statusCode != http.StatusOK {
+		t.Errorf("Should have deleted the waiting room, got: %d, %v", statusCode, err)
+	}
+}
+
+func TestClientUpdateWaitingRoomActivationWindow(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodPut, waitingRoomPath+"/42", mockJSON(`{"value":[{"id":42,"name":"checkout","startTime":1921824000000,"endTime":1922140800000}],"isError":false}`))
+
+	waitingRoom, err := api.client().UpdateWaitingRoom(0, 123, "42", &WaitingRoom{Name: "checkout", StartTime: 1921824000000, EndTime: 1922140800000})
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if waitingRoom.StartTime != 1921824000000 || waitingRoom.EndTime != 1922140800000 {
+		t.Errorf("Should have received the activation window, got: %+v", waitingRoom)
+	}
+
+	body := api.requestsTo(http.MethodPut, waitingRoomPath+"/42")[0].Body
+	if !strings.Contains(body, `"startTime":1921824000000,"endTime":1922140800000`) {
+		t.Errorf("Should have sent the activation window, got: %s", body)
+	}
+}
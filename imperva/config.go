@@ -1,8 +1,9 @@
-package incapsula
+package imperva
 
 import (
 	"errors"
 	"log"
+	"net/http"
 	"strings"
 )
 
@@ -28,6 +29,10 @@ type Config struct {
 	// API V2
 	// Same as revision 2 but with a different subdomain
 	BaseURLAPI string
+
+	// HTTP transport (optional)
+	// Defaults to http.DefaultTransport, tests inject their own to stub the API
+	Transport http.RoundTripper
 }
 
 var missingAPIIDMessage = "API Identifier (api_id) must be provided"
@@ -37,7 +42,7 @@ var missingBaseURLRev2Message = "Base URL Revision 2 must be provided"
 var missingBaseURLAPIMessage = "Base URL API must be provided"
 
 // Client configures and returns a fully initialized Incapsula Client
-func (c *Config) Client() (interface{}, error) {
+func (c *Config) Client() (*Client, error) {
 	log.Println("[INFO] Checking API credentials for client instantiation")
 
 	// Check API Identifier
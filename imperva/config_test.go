@@ -1,4 +1,4 @@
-package incapsula
+package imperva
 
 import (
 	"net/http"
@@ -11,7 +11,7 @@ func TestMissingCredentials(t *testing.T) {
 	config := Config{}
 	client, err := config.Client()
 	if err == nil {
-		t.Errorf("Should have received an error, got a client: %q", client)
+		t.Errorf("Should have received an error, got a client: %v", client)
 	}
 	if err.Error() != missingAPIIDMessage {
 		t.Errorf("Should have received missing API ID message, got: %s", err)
@@ -22,7 +22,7 @@ func TestMissingAPIID(t *testing.T) {
 	config := Config{APIID: "", APIKey: "foo"}
 	client, err := config.Client()
 	if err == nil {
-		t.Errorf("Should have received an error, got a client: %q", client)
+		t.Errorf("Should have received an error, got a client: %v", client)
 	}
 	if err.Error() != missingAPIIDMessage {
 		t.Errorf("Should have received missing API ID message, got: %s", err)
@@ -33,7 +33,7 @@ func TestMissingAPIKey(t *testing.T) {
 	config := Config{APIID: "foo", APIKey: ""}
 	client, err := config.Client()
 	if err == nil {
-		t.Errorf("Should have received an error, got a client: %q", client)
+		t.Errorf("Should have received an error, got a client: %v", client)
 	}
 	if err.Error() != missingAPIKeyMessage {
 		t.Errorf("Should have received missing API key message, got: %s", err)
@@ -44,7 +44,7 @@ func TestMissingBaseURL(t *testing.T) {
 	config := Config{APIID: "foo", APIKey: "bar", BaseURL: ""}
 	client, err := config.Client()
 	if err == nil {
-		t.Errorf("Should have received an error, got a client: %q", client)
+		t.Errorf("Should have received an error, got a client: %v", client)
 	}
 	if err.Error() != missingBaseURLMessage {
 		t.Errorf("Should have received missing base URL message, got: %s", err)
@@ -55,7 +55,7 @@ func TestMissingBaseURLRev2(t *testing.T) {
 	config := Config{APIID: "foo", APIKey: "bar", BaseURL: "foobar.com", BaseURLRev2: ""}
 	client, err := config.Client()
 	if err == nil {
-		t.Errorf("Should have received an error, got a client: %q", client)
+		t.Errorf("Should have received an error, got a client: %v", client)
 	}
 	if err.Error() != missingBaseURLRev2Message {
 		t.Errorf("Should have received missing Base URL Revision 2 message, got: %s", err)
@@ -66,7 +66,7 @@ func TestMissingBaseURLAPI(t *testing.T) {
 	config := Config{APIID: "foo", APIKey: "bar", BaseURL: "foobar.com", BaseURLRev2: "foobar.com", BaseURLAPI: ""}
 	client, err := config.Client()
 	if err == nil {
-		t.Errorf("Should have received an error, got a client: %q", client)
+		t.Errorf("Should have received an error, got a client: %v", client)
 	}
 	if err.Error() != missingBaseURLAPIMessage {
 		t.Errorf("Should have received missing Base URL API message, got: %s", err)
@@ -85,7 +85,7 @@ func TestInvalidCredentials(t *testing.T) {
 	config := Config{APIID: "bad", APIKey: "bad", BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLAPI: server.URL}
 	client, err := config.Client()
 	if err == nil {
-		t.Errorf("Should have received an error, got a client: %q", client)
+		t.Errorf("Should have received an error, got a client: %v", client)
 	}
 	if !strings.HasPrefix(err.Error(), "Error from Incapsula service when checking account") {
 		t.Errorf("Should have received Incapsula service error, got: %s", err)
@@ -0,0 +1,19 @@
+// Package imperva is the client of the Incapsula (Imperva Cloud WAF) API used by the Terraform provider.
+//
+// It has no Terraform dependencies, so other tools can manage the same objects with the same requests:
+//
+//	config := &imperva.Config{
+//		APIID:       os.Getenv("INCAPSULA_API_ID"),
+//		APIKey:      os.Getenv("INCAPSULA_API_KEY"),
+//		BaseURL:     "https://my.incapsula.com/api/prov/v1",
+//		BaseURLRev2: "https://my.imperva.com/api/prov/v2",
+//		BaseURLAPI:  "https://api.imperva.com",
+//	}
+//	client, err := config.Client()
+//	if err != nil {
+//		return err
+//	}
+//
+// The methods return an *APIError when the API rejects a request, IsConflictError and IsNotFoundError tell the
+// errors worth retrying or ignoring apart.
+package imperva
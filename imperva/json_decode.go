@@ -0,0 +1,110 @@
+package imperva
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// responseSnippetLength is the number of bytes of the response quoted around a decoding error
+const responseSnippetLength = 40
+
+// decodeJSONResponse parses the JSON response of the endpoint into v. When the response doesn't match v,
+// e.g. Imperva changed the type of a field, the error names the endpoint, the offending field and quotes
+// the part of the response around it, instead of the bare json.Unmarshal message.
+func decodeJSONResponse(endpoint string, responseBody []byte, v interface{}) error {
+	err := json.Unmarshal(responseBody, v)
+	if err == nil {
+		return nil
+	}
+
+	var typeError *json.UnmarshalTypeError
+	var syntaxError *json.SyntaxError
+	switch {
+	case errors.As(err, &typeError):
+		field, offset := typeError.Field, typeError.Offset
+		if field == "" && offset == 0 {
+			// Errors of custom unmarshalers have neither, look for the offending value instead
+			field, offset = locateJSONValue(responseBody, typeError.Value)
+		}
+		if field == "" {
+			field = "(root)"
+		}
+		return fmt.Errorf("endpoint %s: field %s expects %s but got %s, near: %s", endpoint, field, typeError.Type, typeError.Value, responseSnippet(responseBody, offset))
+	case errors.As(err, &syntaxError):
+		return fmt.Errorf("endpoint %s: invalid JSON at offset %d (%s), near: %s", endpoint, syntaxError.Offset, syntaxError, responseSnippet(responseBody, syntaxError.Offset))
+	default:
+		return fmt.Errorf("endpoint %s: %s", endpoint, err)
+	}
+}
+
+// jsonKeyBeforeValue matches the key at the end of the response preceding a value
+var jsonKeyBeforeValue = regexp.MustCompile(`"([^"]*)"\s*:\s*$`)
+
+// locateJSONValue returns the key and offset of the first occurrence of the raw JSON value in the response
+func locateJSONValue(responseBody []byte, value string) (string, int64) {
+	offset := bytes.Index(responseBody, []byte(value))
+	if value == "" || offset < 0 {
+		return "", 0
+	}
+	if match := jsonKeyBeforeValue.FindSubmatch(responseBody[:offset]); match != nil {
+		return string(match[1]), int64(offset)
+	}
+	return "", int64(offset)
+}
+
+// responseSnippet quotes the part of the response around the offset
+func responseSnippet(responseBody []byte, offset int64) string {
+	if offset < 0 {
+		offset = 0
+	}
+	start := offset - responseSnippetLength
+	if start < 0 {
+		start = 0
+	}
+	end := offset + responseSnippetLength
+	if end > int64(len(responseBody)) {
+		end = int64(len(responseBody))
+	}
+	if start > end {
+		start = end
+	}
+	return strconv.Quote(string(responseBody[start:end]))
+}
+
+// FlexibleInt is an int field the API returns either as a number or as a string holding a number,
+// e.g. the res code of the v1 API, which oscillates between 0 and "0"
+type FlexibleInt int
+
+// UnmarshalJSON accepts a number, a string holding a number, an empty string or null
+func (i *FlexibleInt) UnmarshalJSON(data []byte) error {
+	value := strings.TrimSpace(string(data))
+	if value == "null" {
+		return nil
+	}
+
+	if strings.HasPrefix(value, `"`) {
+		unquoted, err := strconv.Unquote(value)
+		if err != nil {
+			return &json.UnmarshalTypeError{Value: string(data), Type: reflect.TypeOf(*i)}
+		}
+		unquoted = strings.TrimSpace(unquoted)
+		if unquoted == "" {
+			*i = 0
+			return nil
+		}
+		value = unquoted
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return &json.UnmarshalTypeError{Value: string(data), Type: reflect.TypeOf(*i)}
+	}
+	*i = FlexibleInt(number)
+	return nil
+}
@@ -0,0 +1,120 @@
+package imperva
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestDecodeJSONResponseValid(t *testing.T) {
+	var siteListResponse SiteListResponse
+	err := decodeJSONResponse(endpointSiteList, []byte(`{"sites":[{"site_id":123,"domain":"example.com"}],"res":"0"}`), &siteListResponse)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if len(siteListResponse.Sites) != 1 || siteListResponse.Sites[0].SiteID != 123 || siteListResponse.Res != 0 {
+		t.Errorf("Should have decoded the response, got: %+v", siteListResponse)
+	}
+}
+
+func TestDecodeJSONResponseTypeMismatch(t *testing.T) {
+	var siteListResponse SiteListResponse
+	err := decodeJSONResponse(endpointSiteList, []byte(`{"sites":[{"site_id":"abc","domain":"example.com"}],"res":0}`), &siteListResponse)
+	if err == nil {
+		t.Fatal("Should have received an error")
+	}
+	for _, expected := range []string{"endpoint sites/list", "field sites.", "site_id expects int", "got string", `"site_id\":\"abc\"`} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("Error should contain %q, got: %s", expected, err)
+		}
+	}
+}
+
+func TestDecodeJSONResponseFlexibleIntMismatch(t *testing.T) {
+	var siteListResponse SiteListResponse
+	err := decodeJSONResponse(endpointSiteList, []byte(`{"sites":[],"res":"oops"}`), &siteListResponse)
+	if err == nil {
+		t.Fatal("Should have received an error")
+	}
+	for _, expected := range []string{"endpoint sites/list", "field res expects imperva.FlexibleInt but got \"oops\"", `\"res\":\"oops\"`} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("Error should contain %q, got: %s", expected, err)
+		}
+	}
+}
+
+func TestDecodeJSONResponseSyntaxError(t *testing.T) {
+	var siteListResponse SiteListResponse
+	err := decodeJSONResponse(endpointSiteList, []byte(`{"sites":[],"res":0`), &siteListResponse)
+	if err == nil {
+		t.Fatal("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "endpoint sites/list: invalid JSON") {
+		t.Errorf("Should have received a syntax error, got: %s", err)
+	}
+
+	err = decodeJSONResponse(endpointSiteList, []byte(`<html>Service Unavailable</html>`), &siteListResponse)
+	if err == nil || !strings.Contains(err.Error(), "<html>Service Unavailable") {
+		t.Errorf("Should have quoted the response, got: %s", err)
+	}
+}
+
+func TestFlexibleIntUnmarshalJSON(t *testing.T) {
+	cases := map[string]FlexibleInt{`0`: 0, `"0"`: 0, `9413`: 9413, `"9413"`: 9413, `" 1 "`: 1, `""`: 0, `null`: 0}
+	for data, expected := range cases {
+		var value FlexibleInt
+		err := value.UnmarshalJSON([]byte(data))
+		if err != nil {
+			t.Errorf("Should not have received an error for %s, got: %s", data, err)
+		}
+		if value != expected {
+			t.Errorf("Should have decoded %s to %d, got: %d", data, expected, value)
+		}
+	}
+
+	for _, data := range []string{`"abc"`, `1.5`, `true`, `{}`} {
+		var value FlexibleInt
+		if err := value.UnmarshalJSON([]byte(data)); err == nil {
+			t.Errorf("Should have received an error for %s", data)
+		}
+	}
+}
+
+func TestClientAddSiteStringifiedRes(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodPost, "/"+endpointSiteAdd, mockJSON(`{"site_id":123,"res":"0"}`))
+
+	siteAddResponse, err := api.client().AddSite("example.com", "", "", "", "", 0, false, false, "")
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if siteAddResponse == nil || siteAddResponse.SiteID != 123 {
+		t.Errorf("Should have received the site, got: %+v", siteAddResponse)
+	}
+}
+
+func TestClientSiteStatusMalformedField(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodPost, "/"+endpointSiteStatus, mockJSON(`{"site_id":123,"account_id":"not-a-number","res":0}`))
+
+	_, err := api.client().SiteStatus("example.com", 123)
+	if err == nil {
+		t.Fatal("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error parsing site status JSON response for domain example.com (site id: 123): endpoint sites/status: field account_id") {
+		t.Errorf("Should have named the endpoint and field, got: %s", err)
+	}
+}
+
+func TestClientGetPerformanceSettingsMalformedField(t *testing.T) {
+	api := newMockIncapsulaAPI(t)
+	api.handle(http.MethodGet, "/sites/42/settings/cache", mockJSON(`{"mode":{"level":"standard","time":"soon"}}`))
+
+	_, _, err := api.client().GetPerformanceSettings("42")
+	if err == nil {
+		t.Fatal("Should have received an error")
+	}
+	if !strings.Contains(err.Error(), "endpoint sites/{site_id}/settings/cache: field mode.time expects int") {
+		t.Errorf("Should have named the endpoint and field, got: %s", err)
+	}
+}
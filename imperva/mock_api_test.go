@@ -0,0 +1,173 @@
+package imperva
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// mockResponse is a canned response of the mock API
+type mockResponse struct {
+	StatusCode int
+	Body       string
+}
+
+// mockRequest is a request received by the mock API
+type mockRequest struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Form   url.Values
+	Header http.Header
+	Body   string
+}
+
+// mockIncapsulaAPI is an httptest server serving canned Incapsula API responses, so client methods
+// can be unit tested without hitting the real API. Requests to unregistered routes fail the test.
+type mockIncapsulaAPI struct {
+	t        *testing.T
+	server   *httptest.Server
+	mutex    sync.Mutex
+	routes   map[string]http.HandlerFunc
+	requests []mockRequest
+}
+
+func newMockIncapsulaAPI(t *testing.T) *mockIncapsulaAPI {
+	api := &mockIncapsulaAPI{t: t, routes: make(map[string]http.HandlerFunc)}
+	api.server = httptest.NewServer(http.HandlerFunc(api.serveHTTP))
+	t.Cleanup(api.server.Close)
+	return api
+}
+
+// client returns a client pointing all the base URLs at the mock API
+func (api *mockIncapsulaAPI) client() *Client {
+	return NewClient(&Config{
+		APIID:       "foo",
+		APIKey:      "bar",
+		BaseURL:     api.server.URL,
+		BaseURLRev2: api.server.URL,
+		BaseURLAPI:  api.server.URL,
+		Transport:   api.server.Client().Transport,
+	})
+}
+
+// handleFunc registers a handler for the method and path, e.g. ("POST", "/sites/list")
+func (api *mockIncapsulaAPI) handleFunc(method, path string, handler http.HandlerFunc) {
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+	api.routes[method+" "+path] = handler
+}
+
+// handle serves the responses in order for the method and path, the last one is repeated
+func (api *mockIncapsulaAPI) handle(method, path string, responses ...mockResponse) {
+	calls := 0
+	api.handleFunc(method, path, func(rw http.ResponseWriter, req *http.Request) {
+		response := responses[len(responses)-1]
+		if calls < len(responses) {
+			response = responses[calls]
+		}
+		calls++
+		writeMockResponse(rw, response)
+	})
+}
+
+// handlePages serves the page matching the pageParam query or form value (starting at 0) for the method and path
+func (api *mockIncapsulaAPI) handlePages(method, path, pageParam string, pages ...mockResponse) {
+	api.handleFunc(method, path, func(rw http.ResponseWriter, req *http.Request) {
+		pageNum, err := strconv.Atoi(req.FormValue(pageParam))
+		if err != nil || pageNum < 0 || pageNum >= len(pages) {
+			api.t.Errorf("Unexpected %s %q for %s %s", pageParam, req.FormValue(pageParam), method, path)
+			writeMockResponse(rw, mockAPIError(http.StatusNotFound, "Page not found"))
+			return
+		}
+		writeMockResponse(rw, pages[pageNum])
+	})
+}
+
+// requestsTo returns the requests received for the method and path
+func (api *mockIncapsulaAPI) requestsTo(method, path string) []mockRequest {
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+	requests := make([]mockRequest, 0)
+	for _, request := range api.requests {
+		if request.Method == method && request.Path == path {
+			requests = append(requests, request)
+		}
+	}
+	return requests
+}
+
+func (api *mockIncapsulaAPI) serveHTTP(rw http.ResponseWriter, req *http.Request) {
+	body, _ := ioutil.ReadAll(req.Body)
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	req.ParseForm()
+
+	api.mutex.Lock()
+	api.requests = append(api.requests, mockRequest{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.Query(),
+		Form:   req.PostForm,
+		Header: req.Header,
+		Body:   string(body),
+	})
+	handler, ok := api.routes[req.Method+" "+req.URL.Path]
+	api.mutex.Unlock()
+
+	if !ok {
+		api.t.Errorf("Unexpected request to the mock API: %s %s", req.Method, req.URL.String())
+		writeMockResponse(rw, mockAPIError(http.StatusNotFound, "Route not mocked"))
+		return
+	}
+	handler(rw, req)
+}
+
+func writeMockResponse(rw http.ResponseWriter, response mockResponse) {
+	rw.Header().Set("Content-Type", contentTypeApplicationJson)
+	if response.StatusCode != 0 {
+		rw.WriteHeader(response.StatusCode)
+	}
+	rw.Write([]byte(response.Body))
+}
+
+// mockJSON is a 200 response with the given body
+func mockJSON(body string) mockResponse {
+	return mockResponse{StatusCode: http.StatusOK, Body: body}
+}
+
+// mockRes is a response of the v1 API with the given res code, e.g. 0 for success or 9413 for an unknown site
+func mockRes(res int, message string) mockResponse {
+	return mockJSON(fmt.Sprintf(`{"res":%d,"res_message":%q,"debug_info":{"id-info":"13007"}}`, res, message))
+}
+
+// mockAPIError is an error response of the v2 and v3 APIs
+func mockAPIError(statusCode int, detail string) mockResponse {
+	return mockResponse{StatusCode: statusCode, Body: fmt.Sprintf(`{"errors":[{"status":"%d","detail":%q}]}`, statusCode, detail)}
+}
+
+// mockTransport is an http.RoundTripper answering requests with a function, for failures a server can't produce
+type mockTransport func(req *http.Request) (*http.Response, error)
+
+func (f mockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// newMockTransportClient returns a client whose requests all fail with the given error
+func newMockTransportClient(err error) *Client {
+	return NewClient(&Config{
+		APIID:       "foo",
+		APIKey:      "bar",
+		BaseURL:     "https://my.incapsula.com/api/prov/v1",
+		BaseURLRev2: "https://my.imperva.com/api/prov/v2",
+		BaseURLAPI:  "https://api.imperva.com",
+		Transport: mockTransport(func(req *http.Request) (*http.Response, error) {
+			return nil, err
+		}),
+	})
+}
@@ -1,4 +1,4 @@
-package incapsula
+package imperva
 
 const VerifyAccount = "verify_account"
 
@@ -6,6 +6,7 @@ const CreateSite = "create_site"
 const ReadSite = "read_site"
 const UpdateSite = "update_site"
 const DeleteSite = "delete_site"
+const MoveSite = "move_site"
 
 const CreatePolicy = "create_policy"
 const ReadPolicy = "read_policy"
@@ -33,6 +34,19 @@ const UpdateSiteMasking = "update_site_masking"
 
 const UpdateLogLevel = "update_log_level"
 
+const UpdateLoginProtect = "update_login_protect"
+
+const ReadClientApps = "read_client_apps"
+
+const ReadGeoInfo = "read_geo_info"
+
+const ReadSiteCNAMEConfiguration = "read_site_cname_configuration"
+const UpdateSiteCNAMEConfiguration = "update_site_cname_configuration"
+
+const ReadManagedCertificates = "read_managed_certificates"
+const UpdateManagedCertificateDomainValidation = "update_managed_certificate_domain_validation"
+const ReadManagedCertificateValidationInstructions = "read_managed_certificate_validation_instructions"
+
 const ReadSitePerformance = "read_site_performance"
 const UpdateSitePerformance = "update_site_performance"
 
@@ -54,6 +68,7 @@ const DeleteCacheRule = "delete_cache_rule"
 
 const CreateIncapRule = "create_incap_rule"
 const ReadIncapRule = "read_incap_rule"
+const ListIncapRules = "list_incap_rules"
 const UpdateIncapRule = "update_incap_rule"
 const DeleteIncapRule = "delete_incap_rule"
 
@@ -103,3 +118,56 @@ const CreateNotificationCenterPolicy = "create_notification_center_policy"
 const ReadNotificationCenterPolicy = "read_notification_center_policy"
 const UpdateNotificationCenterPolicy = "update_notification_center_policy"
 const DeleteNotificationCenterPolicy = "delete_notification_center_policy"
+
+const CreateNetflowExporter = "create_netflow_exporter"
+const ReadNetflowExporter = "read_netflow_exporter"
+const UpdateNetflowExporter = "update_netflow_exporter"
+const DeleteNetflowExporter = "delete_netflow_exporter"
+
+const CreateProtectedIPRange = "create_protected_ip_range"
+const ReadProtectedIPRange = "read_protected_ip_range"
+const UpdateProtectedIPRange = "update_protected_ip_range"
+const DeleteProtectedIPRange = "delete_protected_ip_range"
+
+const CreateBGPConnection = "create_bgp_connection"
+const ReadBGPConnection = "read_bgp_connection"
+const UpdateBGPConnection = "update_bgp_connection"
+const DeleteBGPConnection = "delete_bgp_connection"
+
+const ReadOriginConnectivityMonitoring = "read_origin_connectivity_monitoring"
+const UpdateOriginConnectivityMonitoring = "update_origin_connectivity_monitoring"
+
+const ReadNetworkDDoSSettings = "read_network_ddos_settings"
+const UpdateNetworkDDoSSettings = "update_network_ddos_settings"
+
+const CreateInfraProtectTestAlert = "create_infra_protect_test_alert"
+
+const CreateDNSProtectionZone = "create_dns_protection_zone"
+const ReadDNSProtectionZone = "read_dns_protection_zone"
+const UpdateDNSProtectionZone = "update_dns_protection_zone"
+const DeleteDNSProtectionZone = "delete_dns_protection_zone"
+
+const CreateFlowMonitoringDevice = "create_flow_monitoring_device"
+const ReadFlowMonitoringDevice = "read_flow_monitoring_device"
+const UpdateFlowMonitoringDevice = "update_flow_monitoring_device"
+const DeleteFlowMonitoringDevice = "delete_flow_monitoring_device"
+
+const CreateTunnel = "create_tunnel"
+const ReadTunnel = "read_tunnel"
+const UpdateTunnel = "update_tunnel"
+const DeleteTunnel = "delete_tunnel"
+
+const ReadInfraProtectAccessList = "read_infra_protect_access_list"
+const UpdateInfraProtectAccessList = "update_infra_protect_access_list"
+
+const ReadInfraProtectStatistics = "read_infra_protect_statistics"
+
+const CreateInfraProtectSyslogDestination = "create_infra_protect_syslog_destination"
+const ReadInfraProtectSyslogDestination = "read_infra_protect_syslog_destination"
+const UpdateInfraProtectSyslogDestination = "update_infra_protect_syslog_destination"
+const DeleteInfraProtectSyslogDestination = "delete_infra_protect_syslog_destination"
+
+const CreateWaitingRoom = "create_waiting_room"
+const ReadWaitingRoom = "read_waiting_room"
+const UpdateWaitingRoom = "update_waiting_room"
+const DeleteWaitingRoom = "delete_waiting_room"
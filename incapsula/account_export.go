@@ -0,0 +1,188 @@
+package incapsula
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+// Resource types supported by the account export
+const exportTypeSite = "incapsula_site"
+const exportTypeCustomCertificate = "incapsula_custom_certificate"
+const exportTypeDataCentersConfiguration = "incapsula_data_centers_configuration"
+const exportTypeWAFSecurityRule = "incapsula_waf_security_rule"
+const exportTypeSecurityRuleException = "incapsula_security_rule_exception"
+const exportTypeIncapRule = "incapsula_incap_rule"
+const exportTypePolicy = "incapsula_policy"
+
+var exportResourceTypes = []string{
+	exportTypeSite,
+	exportTypeCustomCertificate,
+	exportTypeDataCentersConfiguration,
+	exportTypeWAFSecurityRule,
+	exportTypeSecurityRuleException,
+	exportTypeIncapRule,
+	exportTypePolicy,
+}
+
+var exportInvalidNameCharacters = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// exportedResource is an existing object of the account along with the ID to import it with
+type exportedResource struct {
+	Type     string
+	Name     string
+	ImportID string
+}
+
+// accountExporter collects the resources of an account and gives each one a unique resource name
+type accountExporter struct {
+	client    *imperva.Client
+	types     map[string]bool
+	resources []exportedResource
+	names     map[string]int
+
+	// unsupported lists the objects of the account which can't be exported, reported as warnings
+	unsupported []string
+}
+
+func newAccountExporter(client *imperva.Client, types []string) *accountExporter {
+	exporter := &accountExporter{
+		client: client,
+		types:  make(map[string]bool),
+		names:  make(map[string]int),
+	}
+	for _, resourceType := range types {
+		exporter.types[resourceType] = true
+	}
+	return exporter
+}
+
+func (e *accountExporter) add(resourceType, name, importID string) {
+	if !e.types[resourceType] {
+		return
+	}
+
+	name = exportResourceName(name)
+	key := resourceType + "." + name
+	e.names[key]++
+	if e.names[key] > 1 {
+		name = fmt.Sprintf("%s_%d", name, e.names[key])
+	}
+
+	e.resources = append(e.resources, exportedResource{
+		Type:     resourceType,
+		Name:     name,
+		ImportID: importID,
+	})
+}
+
+// exportSites adds the sites of the account and the objects configured on them
+func (e *accountExporter) exportSites(sites []imperva.SiteStatusResponse) error {
+	for _, site := range sites {
+		siteID := strconv.Itoa(site.SiteID)
+		siteName := site.Domain
+
+		e.add(exportTypeSite, siteName, siteID)
+		e.add(exportTypeDataCentersConfiguration, siteName, siteID)
+
+		if site.Ssl.CustomCertificate.Active {
+			e.add(exportTypeCustomCertificate, siteName, siteID)
+		}
+
+		for _, rule := range site.Security.Waf.Rules {
+			ruleName := siteName + "_" + strings.TrimPrefix(rule.ID, "api.threats.")
+			if isWAFRuleCatalogID(rule.ID) {
+				e.add(exportTypeWAFSecurityRule, ruleName, fmt.Sprintf("%s/%s", siteID, rule.ID))
+			} else if e.types[exportTypeWAFSecurityRule] {
+				e.unsupported = append(e.unsupported, fmt.Sprintf("WAF rule %s of site %s (site id: %s)", rule.ID, siteName, siteID))
+			}
+			for _, exception := range rule.Exceptions {
+				e.add(exportTypeSecurityRuleException, fmt.Sprintf("%s_exception_%d", ruleName, exception.ID), fmt.Sprintf("%s/%s/%d", siteID, rule.ID, exception.ID))
+			}
+		}
+
+		for _, rule := range site.Security.Acls.Rules {
+			ruleName := siteName + "_" + strings.TrimPrefix(rule.ID, "api.acl.")
+			for _, exception := range rule.Exceptions {
+				e.add(exportTypeSecurityRuleException, fmt.Sprintf("%s_exception_%d", ruleName, exception.ID), fmt.Sprintf("%s/%s/%d", siteID, rule.ID, exception.ID))
+			}
+		}
+
+		if e.types[exportTypeIncapRule] {
+			incapRuleListResponse, err := e.client.ListIncapRules(siteID)
+			if err != nil {
+				return err
+			}
+			for _, rules := range []map[string][]imperva.IncapRuleListItem{incapRuleListResponse.IncapRules, incapRuleListResponse.DeliveryRules} {
+				categories := make([]string, 0, len(rules))
+				for category := range rules {
+					categories = append(categories, category)
+				}
+				sort.Strings(categories)
+
+				for _, category := range categories {
+					for _, rule := range rules[category] {
+						e.add(exportTypeIncapRule, siteName+"_"+rule.Name, fmt.Sprintf("%s/%s", siteID, rule.ID.String()))
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// exportPolicies adds the policies of the account
+func (e *accountExporter) exportPolicies(accountID int) error {
+	if !e.types[exportTypePolicy] {
+		return nil
+	}
+
+	policyListResponse, err := e.client.ListPolicies(accountID)
+	if err != nil {
+		return err
+	}
+	for _, policy := range policyListResponse.Value {
+		e.add(exportTypePolicy, policy.Name, strconv.Itoa(policy.ID))
+	}
+
+	return nil
+}
+
+// exportResourceName turns a domain or an object name into a valid Terraform resource name
+func exportResourceName(name string) string {
+	name = strings.Trim(exportInvalidNameCharacters.ReplaceAllString(strings.ToLower(name), "_"), "_-")
+	if name == "" {
+		return "unnamed"
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+	return name
+}
+
+// renderImportBlocks renders the resources as Terraform import blocks,
+// ready for `terraform plan -generate-config-out`
+func renderImportBlocks(resources []exportedResource) string {
+	var sb strings.Builder
+	for i, resource := range resources {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		fmt.Fprintf(&sb, "import {\n  to = %s.%s\n  id = %q\n}\n", resource.Type, resource.Name, resource.ImportID)
+	}
+	return sb.String()
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+func TestExportResourceName(t *testing.T) {
+	cases := map[string]string{
+		"www.example.com":     "www_example_com",
+		"My Policy (prod)":    "my_policy_prod",
+		"1.example.com":       "_1_example_com",
+		"...":                 "unnamed",
+		"site-with-dashes.io": "site-with-dashes_io",
+	}
+	for name, expected := range cases {
+		if actual := exportResourceName(name); actual != expected {
+			t.Errorf("exportResourceName(%q) should be %q, got: %q", name, expected, actual)
+		}
+	}
+}
+
+func TestAccountExporterExportSites(t *testing.T) {
+	var sites []imperva.SiteStatusResponse
+	err := json.Unmarshal([]byte(`[
+		{"site_id":1234,"domain":"www.example.com",
+		 "ssl":{"custom_certificate":{"active":true}},
+		 "security":{
+			"waf":{"rules":[
+				{"id":"api.threats.sql_injection","exceptions":[{"id":55}]},
+				{"id":"api.threats.unknown"}]},
+			"acls":{"rules":[{"id":"api.acl.blacklisted_countries","exceptions":[{"id":66}]}]}}},
+		{"site_id":5678,"domain":"WWW.EXAMPLE.COM"}
+	]`), &sites)
+	if err != nil {
+		t.Fatalf("Could not parse test sites: %s", err)
+	}
+
+	exporter := newAccountExporter(nil, []string{exportTypeSite, exportTypeCustomCertificate, exportTypeWAFSecurityRule, exportTypeSecurityRuleException})
+	err = exporter.exportSites(sites)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+
+	expected := []exportedResource{
+		{Type: exportTypeSite, Name: "www_example_com", ImportID: "1234"},
+		{Type: exportTypeCustomCertificate, Name: "www_example_com", ImportID: "1234"},
+		{Type: exportTypeWAFSecurityRule, Name: "www_example_com_sql_injection", ImportID: "1234/api.threats.sql_injection"},
+		{Type: exportTypeSecurityRuleException, Name: "www_example_com_sql_injection_exception_55", ImportID: "1234/api.threats.sql_injection/55"},
+		{Type: exportTypeSecurityRuleException, Name: "www_example_com_blacklisted_countries_exception_66", ImportID: "1234/api.acl.blacklisted_countries/66"},
+		{Type: exportTypeSite, Name: "www_example_com_2", ImportID: "5678"},
+	}
+	if len(exporter.resources) != len(expected) {
+		t.Fatalf("Should have exported %d resources, got: %v", len(expected), exporter.resources)
+	}
+	for i := range expected {
+		if exporter.resources[i] != expected[i] {
+			t.Errorf("Resource %d should be %v, got: %v", i, expected[i], exporter.resources[i])
+		}
+	}
+
+	if len(exporter.unsupported) != 1 || !strings.Contains(exporter.unsupported[0], "api.threats.unknown") {
+		t.Errorf("Should have reported the unsupported WAF rule, got: %v", exporter.unsupported)
+	}
+}
+
+func TestRenderImportBlocks(t *testing.T) {
+	actual := renderImportBlocks([]exportedResource{
+		{Type: exportTypeSite, Name: "www_example_com", ImportID: "1234"},
+		{Type: exportTypeIncapRule, Name: "www_example_com_block", ImportID: "1234/5678"},
+	})
+	expected := `import {
+  to = incapsula_site.www_example_com
+  id = "1234"
+}
+
+import {
+  to = incapsula_incap_rule.www_example_com_block
+  id = "1234/5678"
+}
+`
+	if actual != expected {
+		t.Errorf("Unexpected import blocks:\n%s\nexpected:\n%s", actual, expected)
+	}
+}
@@ -0,0 +1,144 @@
+package incapsula
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+func dataSourceAccountExport() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAccountExportRead,
+		Description: "Enumerates the existing sites, rules, policies and certificates of an account and renders them as Terraform import blocks.",
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "Numeric identifier of the account to export. If not specified, the account identified by the authentication parameters is used.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"resource_types": {
+				Description: "The resource types to export. If not specified, all supported resource types are exported.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(exportResourceTypes, false),
+				},
+			},
+			"filter_by_domain_contains": {
+				Description: "Only export sites whose domain contains this substring (case insensitive), along with their rules.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			// Computed Attributes
+			"resources": {
+				Description: "The exported resources.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Description: "The resource type, e.g. incapsula_site.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The generated resource name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"import_id": {
+							Description: "The ID to import the resource with.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"import_blocks": {
+				Description: "The exported resources rendered as Terraform import blocks.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceAccountExportRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	types := exportResourceTypes
+	if v, ok := d.GetOk("resource_types"); ok {
+		types = make([]string, 0)
+		for _, resourceType := range v.(*schema.Set).List() {
+			types = append(types, resourceType.(string))
+		}
+	}
+
+	sites, err := client.ListAllSites(accountID)
+	if err != nil {
+		return diag.Errorf("Error listing sites for account (%d): %s", accountID, err)
+	}
+
+	if v, ok := d.GetOk("filter_by_domain_contains"); ok {
+		filteredSites := make([]imperva.SiteStatusResponse, 0)
+		for _, site := range sites {
+			if strings.Contains(strings.ToLower(site.Domain), strings.ToLower(v.(string))) {
+				filteredSites = append(filteredSites, site)
+			}
+		}
+		sites = filteredSites
+	}
+
+	exporter := newAccountExporter(client, types)
+
+	err = exporter.exportSites(sites)
+	if err != nil {
+		return diag.Errorf("Error exporting sites for account (%d): %s", accountID, err)
+	}
+
+	err = exporter.exportPolicies(accountID)
+	if err != nil {
+		return diag.Errorf("Error exporting policies for account (%d): %s", accountID, err)
+	}
+
+	resources := make([]map[string]interface{}, 0, len(exporter.resources))
+	for _, resource := range exporter.resources {
+		resources = append(resources, map[string]interface{}{
+			"type":      resource.Type,
+			"name":      resource.Name,
+			"import_id": resource.ImportID,
+		})
+	}
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.Set("resources", resources)
+	d.Set("import_blocks", renderImportBlocks(exporter.resources))
+
+	return unsupportedExportDiagnostics(exporter.unsupported)
+}
+
+// unsupportedExportDiagnostics warns about the objects left out of the export
+func unsupportedExportDiagnostics(unsupported []string) diag.Diagnostics {
+	if len(unsupported) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%d objects of the account are not supported by the provider and were not exported", len(unsupported)),
+			Detail:   strings.Join(unsupported, "\n"),
+		},
+	}
+}
@@ -0,0 +1,40 @@
+package incapsula
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const dataSourceAccountExportName = "data.incapsula_account_export.testacc-terraform-account-export"
+
+func TestAccIncapsulaDataSourceAccountExport_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIncapsulaSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaDataSourceAccountExportConfigBasic(GenerateTestDomain(nil)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceAccountExportName, "resources.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceAccountExportName, "resources.0.type", "incapsula_site"),
+					resource.TestCheckResourceAttrPair(dataSourceAccountExportName, "resources.0.import_id", siteResourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceAccountExportName, "import_blocks"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIncapsulaDataSourceAccountExportConfigBasic(domain string) string {
+	return testAccCheckIncapsulaSiteConfigBasic(domain) + fmt.Sprintf(`
+	data "incapsula_account_export" "testacc-terraform-account-export" {
+		account_id                = %s.account_id
+		resource_types            = ["incapsula_site"]
+		filter_by_domain_contains = %s.domain
+	}`,
+		siteResourceName, siteResourceName,
+	)
+}
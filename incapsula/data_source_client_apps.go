@@ -0,0 +1,84 @@
+package incapsula
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceClientApps() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceClientAppsRead,
+		Description: "Provides the numeric IDs of the client applications (e.g. Googlebot) used by security rules and exceptions.",
+
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Description: "Names of the client applications to look up, e.g. [\"Googlebot\", \"Bingbot\"]. An error is raised for an unknown name.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+			},
+
+			// Computed Attributes
+			"ids": {
+				Description: "The IDs of the client applications in `filter`, sorted. Empty if `filter` isn't specified.",
+				Type:        schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Computed: true,
+			},
+			"map": {
+				Description: "Map of all the client application names to their IDs.",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceClientAppsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+
+	clientAppsResponse, err := client.GetClientApps()
+	if err != nil {
+		return diag.Errorf("Error getting client applications: %s", err)
+	}
+
+	clientAppsMap := make(map[string]interface{})
+	for idStr, name := range clientAppsResponse.ClientApps {
+		id, err := strconv.Atoi(idStr)
+		if err != nil {
+			return diag.Errorf("Error parsing client application ID %s for %s: %s", idStr, name, err)
+		}
+		// The same name may appear more than once, keep the lowest ID so results are stable
+		if existing, ok := clientAppsMap[name]; !ok || id < existing.(int) {
+			clientAppsMap[name] = id
+		}
+	}
+
+	ids := make([]int, 0)
+	for _, name := range d.Get("filter").(*schema.Set).List() {
+		id, ok := clientAppsMap[name.(string)]
+		if !ok {
+			return diag.Errorf("Unknown client application: %s", name)
+		}
+		ids = append(ids, id.(int))
+	}
+	sort.Ints(ids)
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.Set("ids", ids)
+	d.Set("map", clientAppsMap)
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package incapsula
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const dataSourceClientAppsName = "data.incapsula_client_apps.testacc-terraform-client-apps"
+
+func TestAccIncapsulaDataSourceClientApps_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaDataSourceClientAppsConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceClientAppsName, "ids.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceClientAppsName, "map.Googlebot"),
+					resource.TestCheckResourceAttrPair(dataSourceClientAppsName, "ids.0", dataSourceClientAppsName, "map.Googlebot"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIncapsulaDataSourceClientAppsConfigBasic() string {
+	return `
+	data "incapsula_client_apps" "testacc-terraform-client-apps" {
+		filter = ["Googlebot"]
+	}`
+}
@@ -0,0 +1,122 @@
+package incapsula
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceCustomCertificate() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCustomCertificateRead,
+		Description: "Provides the metadata of the custom certificate of a site.",
+
+		Schema: map[string]*schema.Schema{
+			"site_id": {
+				Description: "Numeric identifier of the site.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"expiration_warning_days": {
+				Description:  "Raise a warning when the certificate expires within this number of days. Set to 0 to disable the warning.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			// Computed Attributes
+			"active": {
+				Description: "Whether a custom certificate is active on the site.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"subject": {
+				Description: "The subject of the certificate.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"sans": {
+				Description: "The subject alternative names of the certificate.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"expiration_date": {
+				Description: "The expiration date of the certificate, in RFC 3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"fingerprint": {
+				Description: "The fingerprint of the certificate.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"input_hash": {
+				Description: "The hash of the certificate input, as set by the incapsula_custom_certificate resource.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceCustomCertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	siteID := d.Get("site_id").(string)
+
+	siteIDInt, err := strconv.Atoi(siteID)
+	if err != nil {
+		return diag.Errorf("Error getting custom certificate for site (%s): %s", siteID, err)
+	}
+
+	siteStatusResponse, err := client.CachedSiteStatus("custom-certificate", siteIDInt, 0)
+	if err != nil {
+		return diag.Errorf("Error getting custom certificate for site (%s): %s", siteID, err)
+	}
+
+	customCertificate := siteStatusResponse.Ssl.CustomCertificate
+
+	d.SetId(siteID)
+
+	d.Set("active", customCertificate.Active)
+	d.Set("subject", customCertificate.Subject)
+	d.Set("sans", customCertificate.San)
+	d.Set("fingerprint", customCertificate.Fingerprint)
+	d.Set("input_hash", customCertificate.InputHash)
+
+	if customCertificate.ExpirationDate == 0 {
+		d.Set("expiration_date", "")
+		return nil
+	}
+
+	// The expiration date is returned in milliseconds since epoch
+	expirationDate := time.Unix(0, customCertificate.ExpirationDate*int64(time.Millisecond)).UTC()
+	d.Set("expiration_date", expirationDate.Format(time.RFC3339))
+
+	return customCertificateExpirationDiagnostics(siteID, expirationDate, d.Get("expiration_warning_days").(int), time.Now())
+}
+
+func customCertificateExpirationDiagnostics(siteID string, expirationDate time.Time, warningDays int, now time.Time) diag.Diagnostics {
+	if warningDays == 0 || expirationDate.Sub(now) > time.Duration(warningDays)*24*time.Hour {
+		return nil
+	}
+
+	summary := fmt.Sprintf("Custom certificate of site (%s) expires on %s", siteID, expirationDate.Format(time.RFC3339))
+	if expirationDate.Before(now) {
+		summary = fmt.Sprintf("Custom certificate of site (%s) expired on %s", siteID, expirationDate.Format(time.RFC3339))
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  summary,
+			Detail:   fmt.Sprintf("The certificate expires within %d days. Upload a renewed certificate with the incapsula_custom_certificate resource.", warningDays),
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package incapsula
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestCustomCertificateExpirationDiagnostics(t *testing.T) {
+	now := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	diags := customCertificateExpirationDiagnostics("1234", now.AddDate(0, 2, 0), 30, now)
+	if len(diags) != 0 {
+		t.Errorf("Should not have received a warning for a certificate expiring in 2 months, got: %v", diags)
+	}
+
+	diags = customCertificateExpirationDiagnostics("1234", now.AddDate(0, 0, 10), 30, now)
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Errorf("Should have received a warning for a certificate expiring in 10 days, got: %v", diags)
+	}
+
+	diags = customCertificateExpirationDiagnostics("1234", now.AddDate(0, 0, -1), 30, now)
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Errorf("Should have received a warning for an expired certificate, got: %v", diags)
+	}
+
+	diags = customCertificateExpirationDiagnostics("1234", now.AddDate(0, 0, 10), 0, now)
+	if len(diags) != 0 {
+		t.Errorf("Should not have received a warning when warnings are disabled, got: %v", diags)
+	}
+}
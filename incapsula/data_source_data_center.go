@@ -3,10 +3,12 @@ package incapsula
 import (
 	"context"
 	"encoding/json"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"strconv"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
 )
 
 func dataSourceDataCenter() *schema.Resource {
@@ -116,7 +118,7 @@ func dataSourceDataCenter() *schema.Resource {
 }
 
 func dataSourceDataCenterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	responseDTO, err := client.GetDataCentersConfiguration(d.Get("site_id").(string))
 	if err != nil {
@@ -131,7 +133,7 @@ func dataSourceDataCenterRead(ctx context.Context, d *schema.ResourceData, m int
 		return diag.Errorf("Error getting Data Centers configuration for site (%s): %s", d.Get("site_id"), string(out))
 	}
 
-	var matchedDC DataCenterStruct
+	var matchedDC imperva.DataCenterStruct
 	for _, dc := range responseDTO.Data[0].DataCenters {
 		if v, ok := d.GetOk("filter_by_geo_location"); ok {
 			found := false
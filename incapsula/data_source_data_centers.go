@@ -0,0 +1,141 @@
+package incapsula
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDataCenters() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDataCentersRead,
+		Description: "Provides the list of Data Centers of a site, along with their origin servers.",
+
+		Schema: map[string]*schema.Schema{
+			"site_id": {
+				Description: "Site ID",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			// Computed Attributes
+			"data_centers": {
+				Description: "The Data Centers of the site.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Data Center internal ID",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Data Center name",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"is_enabled": {
+							Description: "When true, Data Center is enabled",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"is_active": {
+							Description: "When false, Data Center in standby mode",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"is_content": {
+							Description: "When true, Data Center will only accept traffic routed by Application Delivery Forward-to-DC rule.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"origin_pop": {
+							Description: "The ID of the PoP that serves as an access point between Imperva and the customer’s origin server.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"origin_servers": {
+							Description: "The origin servers of the Data Center.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Description: "Origin server internal ID",
+										Type:        schema.TypeInt,
+										Computed:    true,
+									},
+									"address": {
+										Description: "The server's address. IP or CNAME.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"is_enabled": {
+										Description: "When true, the server is enabled",
+										Type:        schema.TypeBool,
+										Computed:    true,
+									},
+									"is_standby": {
+										Description: "When true, the server is in standby mode",
+										Type:        schema.TypeBool,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDataCentersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	siteID := d.Get("site_id").(string)
+
+	listDataCentersResponse, err := client.ListDataCenters(siteID)
+	if err != nil {
+		return diag.Errorf("Error getting Data Centers for site (%s): %s", siteID, err)
+	}
+
+	dataCenters := make([]map[string]interface{}, 0, len(listDataCentersResponse.DCs))
+	for _, dc := range listDataCentersResponse.DCs {
+		dcID, err := strconv.Atoi(dc.ID)
+		if err != nil {
+			return diag.Errorf("Error parsing Data Center ID (%s) for site (%s): %s", dc.ID, siteID, err)
+		}
+
+		originServers := make([]map[string]interface{}, 0, len(dc.Servers))
+		for _, server := range dc.Servers {
+			serverID, err := strconv.Atoi(server.ID)
+			if err != nil {
+				return diag.Errorf("Error parsing origin server ID (%s) of Data Center (%s) for site (%s): %s", server.ID, dc.ID, siteID, err)
+			}
+			originServers = append(originServers, map[string]interface{}{
+				"id":         serverID,
+				"address":    server.Address,
+				"is_enabled": server.Enabled == "true",
+				"is_standby": server.IsStandBy == "true",
+			})
+		}
+
+		dataCenters = append(dataCenters, map[string]interface{}{
+			"id":             dcID,
+			"name":           dc.Name,
+			"is_enabled":     dc.Enabled == "true",
+			"is_active":      dc.IsActive == "true",
+			"is_content":     dc.ContentOnly == "true",
+			"origin_pop":     dc.OriginPop,
+			"origin_servers": originServers,
+		})
+	}
+
+	d.SetId(siteID)
+	d.Set("data_centers", dataCenters)
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package incapsula
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const dataSourceDataCentersName = "data.incapsula_data_centers.testacc-terraform-data-centers"
+
+func TestAccIncapsulaDataSourceDataCenters_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaDataSourceDataCentersConfigBasic(GenerateTestDomain(nil)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceDataCentersName, "site_id", siteResourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceDataCentersName, "data_centers.0.id"),
+					resource.TestCheckResourceAttrSet(dataSourceDataCentersName, "data_centers.0.origin_servers.0.id"),
+					resource.TestCheckResourceAttrSet(dataSourceDataCentersName, "data_centers.0.origin_servers.0.address"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIncapsulaDataSourceDataCentersConfigBasic(domain string) string {
+	return testAccCheckIncapsulaSiteConfigBasic(domain) + fmt.Sprintf(`
+	data "incapsula_data_centers" "testacc-terraform-data-centers" {
+		site_id = %s.id
+	}`,
+		siteResourceName,
+	)
+}
@@ -0,0 +1,87 @@
+package incapsula
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+func dataSourceGeoLocations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGeoLocationsRead,
+		Description: "Provides the country and continent codes accepted by ACL rules and exceptions.",
+
+		Schema: map[string]*schema.Schema{
+			// Computed Attributes
+			"countries": {
+				Description: "Map of the ISO country codes to the country names.",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+			"continents": {
+				Description: "Map of the continent codes to the continent names.",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+			"country_codes": {
+				Description: "Sorted list of the ISO country codes.",
+				Type:        schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+			"continent_codes": {
+				Description: "Sorted list of the continent codes.",
+				Type:        schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGeoLocationsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+
+	geoInfoResponse, err := client.GetCachedGeoInfo()
+	if err != nil {
+		return diag.Errorf("Error getting geo info: %s", err)
+	}
+
+	countries, countryCodes := flattenGeoCodes(geoInfoResponse.Countries)
+	continents, continentCodes := flattenGeoCodes(geoInfoResponse.Continents)
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.Set("countries", countries)
+	d.Set("continents", continents)
+	d.Set("country_codes", countryCodes)
+	d.Set("continent_codes", continentCodes)
+
+	return nil
+}
+
+func flattenGeoCodes(geoCodes []imperva.GeoCode) (map[string]interface{}, []string) {
+	names := make(map[string]interface{}, len(geoCodes))
+	codes := make([]string, 0, len(geoCodes))
+	for _, geoCode := range geoCodes {
+		names[geoCode.ID] = geoCode.Name
+		codes = append(codes, geoCode.ID)
+	}
+	sort.Strings(codes)
+
+	return names, codes
+}
@@ -0,0 +1,25 @@
+package incapsula
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const dataSourceGeoLocationsName = "data.incapsula_geo_locations.testacc-terraform-geo-locations"
+
+func TestAccIncapsulaDataSourceGeoLocations_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "incapsula_geo_locations" "testacc-terraform-geo-locations" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceGeoLocationsName, "countries.US"),
+					resource.TestCheckResourceAttrSet(dataSourceGeoLocationsName, "continents.EU"),
+				),
+			},
+		},
+	})
+}
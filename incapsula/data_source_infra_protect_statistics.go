@@ -0,0 +1,163 @@
+package incapsula
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The statistics API keeps 90 days of data
+const infraProtectStatisticsMaxTimeRange = 90 * 24 * time.Hour
+
+func dataSourceInfraProtectStatistics() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceInfraProtectStatisticsRead,
+		Description: "Provides the traffic statistics of the protected IP ranges of an account over a time range.",
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "Numeric identifier of the account to operate on. If not specified, operation will be performed on the account identified by the authentication parameters.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"protected_ip_range_ids": {
+				Description: "The IDs of the protected IP ranges. If not specified, all the ranges of the account are returned.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"time_range": {
+				Description:  "The length of the time range ending at end_time, as a duration such as 1h or 168h. Maximum 90 days.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "24h",
+				ValidateFunc: validateInfraProtectStatisticsTimeRange,
+			},
+			"end_time": {
+				Description:  "The end of the time range in RFC 3339 format. If not specified, the current time is used.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			// Computed Attributes
+			"start_time": {
+				Description: "The start of the time range in RFC 3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"ranges": {
+				Description: "The statistics of the protected IP ranges.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the protected IP range.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"prefix": {
+							Description: "The protected IP range in CIDR notation.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"average_bps": {
+							Description: "The average bandwidth in bits per second.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"peak_bps": {
+							Description: "The peak bandwidth in bits per second.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"average_pps": {
+							Description: "The average packet rate in packets per second.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"peak_pps": {
+							Description: "The peak packet rate in packets per second.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"mitigated_bytes": {
+							Description: "The number of bytes which were mitigated.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"attacks": {
+							Description: "The number of attacks.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceInfraProtectStatisticsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	// The arguments were validated at plan time
+	timeRange, _ := time.ParseDuration(d.Get("time_range").(string))
+	endTime := time.Now().UTC()
+	if v, ok := d.GetOk("end_time"); ok {
+		endTime, _ = time.Parse(time.RFC3339, v.(string))
+	}
+	startTime := endTime.Add(-timeRange)
+
+	rangeIDs := make([]string, 0)
+	if v, ok := d.GetOk("protected_ip_range_ids"); ok {
+		for _, rangeID := range v.(*schema.Set).List() {
+			rangeIDs = append(rangeIDs, rangeID.(string))
+		}
+	}
+
+	statisticsResponse, err := client.GetInfraProtectStatistics(accountID, rangeIDs, startTime.UnixNano()/int64(time.Millisecond), endTime.UnixNano()/int64(time.Millisecond))
+	if err != nil {
+		return diag.Errorf("Error getting Infrastructure Protection statistics for account (%d): %s", accountID, err)
+	}
+
+	ranges := make([]map[string]interface{}, 0, len(statisticsResponse.Data))
+	for _, statistics := range statisticsResponse.Data {
+		ranges = append(ranges, map[string]interface{}{
+			"id":              statistics.IPRangeID,
+			"prefix":          statistics.Prefix,
+			"average_bps":     statistics.AverageBps,
+			"peak_bps":        statistics.PeakBps,
+			"average_pps":     statistics.AveragePps,
+			"peak_pps":        statistics.PeakPps,
+			"mitigated_bytes": statistics.MitigatedBytes,
+			"attacks":         statistics.Attacks,
+		})
+	}
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.Set("start_time", startTime.Format(time.RFC3339))
+	d.Set("end_time", endTime.Format(time.RFC3339))
+	d.Set("ranges", ranges)
+
+	return nil
+}
+
+func validateInfraProtectStatisticsTimeRange(i interface{}, k string) ([]string, []error) {
+	timeRange, err := time.ParseDuration(i.(string))
+	if err != nil {
+		return nil, []error{fmt.Errorf("%s must be a duration such as 1h or 168h: %s", k, err)}
+	}
+	if timeRange <= 0 || timeRange > infraProtectStatisticsMaxTimeRange {
+		return nil, []error{fmt.Errorf("%s must be positive and at most %s, got: %s", k, infraProtectStatisticsMaxTimeRange, timeRange)}
+	}
+	return nil, nil
+}
@@ -0,0 +1,53 @@
+package incapsula
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const dataSourceInfraProtectStatisticsName = "data.incapsula_infra_protect_statistics.testacc-terraform-infra-protect-statistics"
+
+func TestValidateInfraProtectStatisticsTimeRange(t *testing.T) {
+	for _, timeRange := range []string{"1h", "30m", "168h", "2160h"} {
+		_, errs := validateInfraProtectStatisticsTimeRange(timeRange, "time_range")
+		if len(errs) > 0 {
+			t.Errorf("Should not have received an error for %s, got: %v", timeRange, errs)
+		}
+	}
+
+	for _, timeRange := range []string{"", "7d", "-1h", "0s", "2161h"} {
+		_, errs := validateInfraProtectStatisticsTimeRange(timeRange, "time_range")
+		if len(errs) == 0 {
+			t.Errorf("Should have received an error for %q", timeRange)
+		}
+	}
+}
+
+func TestAccIncapsulaDataSourceInfraProtectStatistics_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaDataSourceInfraProtectStatisticsConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceInfraProtectStatisticsName, "ranges.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceInfraProtectStatisticsName, "ranges.0.id", protectedIPRangeResource, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceInfraProtectStatisticsName, "start_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIncapsulaDataSourceInfraProtectStatisticsConfigBasic() string {
+	return testAccCheckIncapsulaProtectedIPRangeConfigBasic(true) + fmt.Sprintf(`
+	data "incapsula_infra_protect_statistics" "testacc-terraform-infra-protect-statistics" {
+		protected_ip_range_ids = [%s.id]
+		time_range             = "1h"
+	}`,
+		protectedIPRangeResource,
+	)
+}
@@ -0,0 +1,97 @@
+package incapsula
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourcePolicy() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePolicyRead,
+		Description: "Provides the properties of a single policy, looked up by its exact name.",
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The exact name of the policy.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"account_id": {
+				Description: "Numeric identifier of the account to look up the policy in. If not specified, the account identified by the authentication parameters is used.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+
+			// Computed Attributes
+			"policy_type": {
+				Description: "The policy type. Possible values: ACL, WHITELIST, WAF_RULES",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"enabled": {
+				Description: "Whether the policy is enabled.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"description": {
+				Description: "The policy description.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"policy_settings": {
+				Description: "The policy settings as JSON string, in the same format as the incapsula_policy resource.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourcePolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	name := d.Get("name").(string)
+	accountID := d.Get("account_id").(int)
+
+	policyListResponse, err := client.ListPolicies(accountID)
+	if err != nil {
+		return diag.Errorf("Error looking up policy with name %s (account id: %d): %s", name, accountID, err)
+	}
+
+	matches := make([]int, 0)
+	for i, policy := range policyListResponse.Value {
+		if policy.Name == name {
+			matches = append(matches, i)
+		}
+	}
+
+	if len(matches) == 0 {
+		return diag.Errorf("No policy matched name %s (account id: %d)", name, accountID)
+	}
+
+	if len(matches) > 1 {
+		return diag.Errorf("More than one policy matched name %s (account id: %d). First two matches are policy ids: %d and %d. Specify account_id to narrow the search", name, accountID, policyListResponse.Value[matches[0]].ID, policyListResponse.Value[matches[1]].ID)
+	}
+
+	policy := policyListResponse.Value[matches[0]]
+
+	// Same format as the incapsula_policy resource, so the value can be compared or copied over
+	policySettingsJSONBytes, err := json.MarshalIndent(policy.PolicySettings, "", "    ")
+	if err != nil {
+		return diag.Errorf("Could not marshal the settings of policy %d: %s", policy.ID, err)
+	}
+
+	d.SetId(strconv.Itoa(policy.ID))
+
+	d.Set("account_id", policy.AccountID)
+	d.Set("policy_type", policy.PolicyType)
+	d.Set("enabled", policy.Enabled)
+	d.Set("description", policy.Description)
+	d.Set("policy_settings", string(policySettingsJSONBytes))
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package incapsula
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const dataSourcePolicyName = "data.incapsula_policy.testacc-terraform-policy"
+const dataSourcePolicyResourceName = "incapsula_policy.testacc-terraform-policy"
+
+func TestAccIncapsulaDataSourcePolicy_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaDataSourcePolicyConfigBasic(fmt.Sprintf("testacc-terraform-policy-%s", GenerateTestDomain(nil))),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourcePolicyName, "id", dataSourcePolicyResourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourcePolicyName, "policy_type", "WHITELIST"),
+					resource.TestCheckResourceAttr(dataSourcePolicyName, "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIncapsulaDataSourcePolicyConfigBasic(name string) string {
+	return fmt.Sprintf(`
+	resource "incapsula_policy" "testacc-terraform-policy" {
+		name            = "%s"
+		enabled         = true
+		policy_type     = "WHITELIST"
+		description     = "Policy for the incapsula_policy data source acceptance test"
+		policy_settings = "[{\"settingsAction\":\"ALLOW\",\"policySettingType\":\"IP\",\"data\":{\"ips\":[\"1.2.3.4\"]}}]"
+	}
+
+	data "incapsula_policy" "testacc-terraform-policy" {
+		name = %s.name
+	}`,
+		name, dataSourcePolicyResourceName,
+	)
+}
@@ -0,0 +1,106 @@
+package incapsula
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSite() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSiteRead,
+		Description: "Provides the properties of a single site, looked up by its domain.",
+
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Description: "The fully qualified domain name of the site. For example: www.example.com.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"account_id": {
+				Description: "Numeric identifier of the account to look up the site in. If not specified, the account identified by the authentication parameters is used.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+
+			// Computed Attributes
+			"site_id": {
+				Description: "Numeric identifier of the site.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"status": {
+				Description: "The site status.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"active": {
+				Description: "active or bypass.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"acceleration_level": {
+				Description: "none | standard | aggressive.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"site_creation_date": {
+				Description: "Numeric representation of the site creation date.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"dns_cname_record_name": {
+				Description: "CNAME record name.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"dns_cname_record_value": {
+				Description: "CNAME record value.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceSiteRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	domain := d.Get("domain").(string)
+	accountID := d.Get("account_id").(int)
+
+	sites, err := client.FindSitesByDomain(accountID, domain)
+	if err != nil {
+		return diag.Errorf("Error looking up site for domain %s (account id: %d): %s", domain, accountID, err)
+	}
+
+	if len(sites) == 0 {
+		return diag.Errorf("No site matched domain %s (account id: %d)", domain, accountID)
+	}
+
+	if len(sites) > 1 {
+		return diag.Errorf("More than one site matched domain %s (account id: %d). First two matches are site ids: %d and %d. Specify account_id to narrow the search", domain, accountID, sites[0].SiteID, sites[1].SiteID)
+	}
+
+	site := sites[0]
+
+	d.SetId(strconv.Itoa(site.SiteID))
+
+	d.Set("site_id", site.SiteID)
+	d.Set("domain", site.Domain)
+	d.Set("account_id", site.AccountID)
+	d.Set("status", site.Status)
+	d.Set("active", site.Active)
+	d.Set("acceleration_level", site.AccelerationLevelRaw)
+	d.Set("site_creation_date", site.SiteCreationDate)
+	for _, entry := range site.DNS {
+		if entry.SetTypeTo == "CNAME" && len(entry.SetDataTo) > 0 {
+			d.Set("dns_cname_record_name", entry.DNSRecordName)
+			d.Set("dns_cname_record_value", entry.SetDataTo[0])
+		}
+	}
+
+	return nil
+}
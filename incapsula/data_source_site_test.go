@@ -0,0 +1,38 @@
+package incapsula
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const dataSourceSiteName = "data.incapsula_site.testacc-terraform-site"
+
+func TestAccIncapsulaDataSourceSite_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIncapsulaSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaDataSourceSiteConfigBasic(GenerateTestDomain(nil)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceSiteName, "site_id", siteResourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceSiteName, "account_id", siteResourceName, "account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceSiteName, "dns_cname_record_value", siteResourceName, "dns_cname_record_value"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIncapsulaDataSourceSiteConfigBasic(domain string) string {
+	return testAccCheckIncapsulaSiteConfigBasic(domain) + fmt.Sprintf(`
+	data "incapsula_site" "testacc-terraform-site" {
+		domain     = %s.domain
+		account_id = %s.account_id
+	}`,
+		siteResourceName, siteResourceName,
+	)
+}
@@ -0,0 +1,151 @@
+package incapsula
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+func dataSourceSites() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSitesRead,
+		Description: "Provides the list of sites in an account. All 'filter_by_' arguments are optional. When specified, a logical AND operator is assumed.",
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "Numeric identifier of the account to list the sites of. If not specified, the account identified by the authentication parameters is used.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"filter_by_status": {
+				Description: "Filter by site status. For example: fully_configured, pending-dns-changes.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"filter_by_active": {
+				Description: "Filter by whether the site is active or bypassed. Options are `active` and `bypass`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"filter_by_plan": {
+				Description: "Filter by the plan ID or plan name of the account the site belongs to.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"filter_by_domain_contains": {
+				Description: "Filter by sites whose domain contains this substring (case insensitive).",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			// Computed Attributes
+			"sites": {
+				Description: "The sites matching the filters.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"site_id": {
+							Description: "Numeric identifier of the site.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"domain": {
+							Description: "The domain of the site.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"account_id": {
+							Description: "Numeric identifier of the account the site belongs to.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"status": {
+							Description: "The site status.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"active": {
+							Description: "active or bypass.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"dns_cname_record_value": {
+							Description: "The CNAME record value the domain should point to.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSitesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	sites, err := client.ListAllSites(accountID)
+	if err != nil {
+		return diag.Errorf("Error listing sites for account (%d): %s", accountID, err)
+	}
+
+	// Plans are defined on the account, cache them since many sites share an account
+	accountPlans := make(map[int][]string)
+
+	result := make([]map[string]interface{}, 0)
+	for _, site := range sites {
+		if v, ok := d.GetOk("filter_by_status"); ok && v.(string) != site.Status {
+			continue
+		}
+		if v, ok := d.GetOk("filter_by_active"); ok && v.(string) != site.Active {
+			continue
+		}
+		if v, ok := d.GetOk("filter_by_domain_contains"); ok && !strings.Contains(strings.ToLower(site.Domain), strings.ToLower(v.(string))) {
+			continue
+		}
+		if v, ok := d.GetOk("filter_by_plan"); ok {
+			plans, found := accountPlans[site.AccountID]
+			if !found {
+				accountStatusResponse, err := client.AccountStatus(site.AccountID)
+				if err != nil {
+					return diag.Errorf("Error getting the plan of account (%d) for site (%d): %s", site.AccountID, site.SiteID, err)
+				}
+				plans = []string{accountStatusResponse.Account.PlanID, accountStatusResponse.Account.PlanName}
+				accountPlans[site.AccountID] = plans
+			}
+			if v.(string) != plans[0] && v.(string) != plans[1] {
+				continue
+			}
+		}
+
+		result = append(result, map[string]interface{}{
+			"site_id":                site.SiteID,
+			"domain":                 site.Domain,
+			"account_id":             site.AccountID,
+			"status":                 site.Status,
+			"active":                 site.Active,
+			"dns_cname_record_value": getSiteCNAMERecordValue(&site),
+		})
+	}
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.Set("sites", result)
+
+	return nil
+}
+
+func getSiteCNAMERecordValue(site *imperva.SiteStatusResponse) string {
+	for _, entry := range site.DNS {
+		if entry.SetTypeTo == "CNAME" && len(entry.SetDataTo) > 0 {
+			return entry.SetDataTo[0]
+		}
+	}
+	return ""
+}
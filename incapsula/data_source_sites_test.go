@@ -0,0 +1,40 @@
+package incapsula
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const dataSourceSitesName = "data.incapsula_sites.testacc-terraform-sites"
+
+func TestAccIncapsulaDataSourceSites_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIncapsulaSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaDataSourceSitesConfigBasic(GenerateTestDomain(nil)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceSitesName, "sites.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceSitesName, "sites.0.domain", GenerateTestDomain(nil)),
+					resource.TestCheckResourceAttrPair(dataSourceSitesName, "sites.0.site_id", siteResourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceSitesName, "sites.0.account_id", siteResourceName, "account_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIncapsulaDataSourceSitesConfigBasic(domain string) string {
+	return testAccCheckIncapsulaSiteConfigBasic(domain) + fmt.Sprintf(`
+	data "incapsula_sites" "testacc-terraform-sites" {
+		account_id                = %s.account_id
+		filter_by_domain_contains = "%s"
+		depends_on                = ["%s"]
+	}`,
+		siteResourceName, domain, siteResourceName,
+	)
+}
@@ -0,0 +1,130 @@
+package incapsula
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+func dataSourceWAFRules() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWAFRulesRead,
+		Description: "Provides the WAF rules which can be configured with incapsula_waf_security_rule, along with their allowed actions. The rules are a static list of the provider.",
+
+		Schema: map[string]*schema.Schema{
+			"site_id": {
+				Description: "Numeric identifier of a site. When specified, the current action of each rule on the site is exported as well.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+
+			// Computed Attributes
+			"ids": {
+				Description: "The identifiers of the WAF rules.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"rules": {
+				Description: "The WAF rules.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The identifier of the WAF rule, e.g api.threats.sql_injection.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The display name of the WAF rule.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"actions": {
+							Description: "The allowed actions of the WAF rule. For api.threats.ddos these are the activation modes.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"default_action": {
+							Description: "The action the WAF rule is reset to when incapsula_waf_security_rule is destroyed.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"current_action": {
+							Description: "The current action of the WAF rule on the site. Only set when site_id is specified.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceWAFRulesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	currentActions := make(map[string]string)
+	currentNames := make(map[string]string)
+	var unsupported []string
+
+	if v, ok := d.GetOk("site_id"); ok {
+		client := m.(*providerMeta).client
+		siteID := v.(int)
+
+		siteStatusResponse, err := client.SiteStatus("waf-rules", siteID)
+		if err != nil {
+			return diag.Errorf("Error getting the WAF rules of site (%d): %s", siteID, err)
+		}
+
+		for _, rule := range siteStatusResponse.Security.Waf.Rules {
+			if !isWAFRuleCatalogID(rule.ID) {
+				unsupported = append(unsupported, rule.ID)
+			}
+			currentNames[rule.ID] = rule.Name
+			if rule.ID == imperva.DDoSRuleID {
+				currentActions[rule.ID] = rule.ActivationMode
+			} else {
+				currentActions[rule.ID] = rule.Action
+			}
+		}
+	}
+
+	rules := make([]map[string]interface{}, 0, len(wafRuleCatalog))
+	for _, entry := range wafRuleCatalog {
+		name := entry.Name
+		if currentName, ok := currentNames[entry.ID]; ok && currentName != "" {
+			name = currentName
+		}
+
+		rules = append(rules, map[string]interface{}{
+			"id":             entry.ID,
+			"name":           name,
+			"actions":        entry.Actions,
+			"default_action": entry.DefaultAction,
+			"current_action": currentActions[entry.ID],
+		})
+	}
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.Set("ids", getWAFRuleCatalogIDs())
+	d.Set("rules", rules)
+
+	if len(unsupported) > 0 {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Site (%d) has WAF rules which are not supported by the provider", d.Get("site_id").(int)),
+				Detail:   fmt.Sprintf("The rules are left out: %s", strings.Join(unsupported, ", ")),
+			},
+		}
+	}
+	return nil
+}
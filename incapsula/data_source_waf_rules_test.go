@@ -0,0 +1,27 @@
+package incapsula
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+const dataSourceWAFRulesName = "data.incapsula_waf_rules.testacc-terraform-waf-rules"
+
+func TestAccIncapsulaDataSourceWAFRules_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "incapsula_waf_rules" "testacc-terraform-waf-rules" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceWAFRulesName, "ids.#", "7"),
+					resource.TestCheckResourceAttr(dataSourceWAFRulesName, "rules.4.id", imperva.SQLInjectionRuleID),
+					resource.TestCheckResourceAttr(dataSourceWAFRulesName, "rules.4.default_action", sqlInjectionRuleIDDefaultAction),
+				),
+			},
+		},
+	})
+}
@@ -2,7 +2,7 @@ package incapsula
 
 import (
 	"encoding/json"
-	"log"
+	"fmt"
 	"reflect"
 	"sort"
 	"strings"
@@ -10,43 +10,123 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// suppressEquivalentStringDiffs compares comma separated lists as sets, the API doesn't keep the order of the items
+// nor the spaces around them
 func suppressEquivalentStringDiffs(k, old, new string, d *schema.ResourceData) bool {
-	oldSlice := strings.Split(old, ",")
-	newSlice := strings.Split(new, ",")
-	sort.Strings(oldSlice)
-	sort.Strings(newSlice)
-
-	return reflect.DeepEqual(oldSlice, newSlice)
+	return reflect.DeepEqual(normalizeStringSet(strings.Split(old, ",")), normalizeStringSet(strings.Split(new, ",")))
 }
 
+// suppressEquivalentJSONStringDiffs compares JSON documents regardless of the key order and whitespace
 func suppressEquivalentJSONStringDiffs(k, old, new string, d *schema.ResourceData) bool {
-	var o1 interface{}
-	var o2 interface{}
+	return equivalentJSONStrings(old, new, false)
+}
 
-	old = strings.TrimSpace(old)
-	new = strings.TrimSpace(new)
+// suppressEquivalentUnorderedJSONStringDiffs is the same as suppressEquivalentJSONStringDiffs, the arrays being compared
+// as sets, for documents whose lists are returned in any order by the API
+func suppressEquivalentUnorderedJSONStringDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return equivalentJSONStrings(old, new, true)
+}
 
-	if old == "" && new == "" {
-		return true
+// suppressEquivalentUnorderedListDiffs compares the lists of primitives as sets, the API doesn't keep their order.
+// It's called for the count and each element of the list, so the whole lists are compared.
+func suppressEquivalentUnorderedListDiffs(k, old, new string, d *schema.ResourceData) bool {
+	index := strings.LastIndex(k, ".")
+	if index < 0 || d == nil {
+		return false
 	}
-	if old == "" && new != "" {
+
+	oldList, newList := d.GetChange(k[:index])
+	oldItems, ok := oldList.([]interface{})
+	if !ok {
 		return false
 	}
-	if old != "" && new == "" {
+	newItems, ok := newList.([]interface{})
+	if !ok {
 		return false
 	}
 
-	var err error
-	err = json.Unmarshal([]byte(old), &o1)
+	return reflect.DeepEqual(normalizeStringSet(stringifyItems(oldItems)), normalizeStringSet(stringifyItems(newItems)))
+}
+
+// normalizeStringSet trims the items and returns the distinct non empty ones sorted
+func normalizeStringSet(items []string) []string {
+	seen := make(map[string]bool, len(items))
+	normalized := make([]string, 0, len(items))
+	for _, item := range items {
+		item = strings.TrimSpace(item)
+		if item != "" && !seen[item] {
+			seen[item] = true
+			normalized = append(normalized, item)
+		}
+	}
+	sort.Strings(normalized)
+	return normalized
+}
+
+func stringifyItems(items []interface{}) []string {
+	stringItems := make([]string, len(items))
+	for i, item := range items {
+		stringItems[i] = fmt.Sprint(item)
+	}
+	return stringItems
+}
+
+func equivalentJSONStrings(old, new string, unordered bool) bool {
+	old = strings.TrimSpace(old)
+	new = strings.TrimSpace(new)
+	if old == "" || new == "" {
+		return old == new
+	}
+
+	// Invalid JSON is reported by the validation of the attribute
+	oldCanonical, err := canonicalJSON(old, unordered)
 	if err != nil {
-		log.Panicf("Invalid JSON (current value): %s", err.Error())
 		return false
 	}
-	err = json.Unmarshal([]byte(new), &o2)
+	newCanonical, err := canonicalJSON(new, unordered)
 	if err != nil {
-		log.Panicf("Invalid JSON (new value): %s", err.Error())
 		return false
 	}
 
-	return reflect.DeepEqual(o1, o2)
+	return oldCanonical == newCanonical
+}
+
+// canonicalJSON encodes the JSON document with sorted keys and without whitespace, the arrays are sorted when unordered
+func canonicalJSON(document string, unordered bool) (string, error) {
+	var value interface{}
+	err := json.Unmarshal([]byte(document), &value)
+	if err != nil {
+		return "", err
+	}
+
+	if unordered {
+		value = sortJSONArrays(value)
+	}
+
+	canonical, err := json.Marshal(value)
+	return string(canonical), err
+}
+
+// sortJSONArrays sorts the elements of the arrays by their canonical encoding
+func sortJSONArrays(value interface{}) interface{} {
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		for key, element := range typedValue {
+			typedValue[key] = sortJSONArrays(element)
+		}
+	case []interface{}:
+		encodedElements := make([]string, len(typedValue))
+		for i, element := range typedValue {
+			encoded, _ := json.Marshal(sortJSONArrays(element))
+			encodedElements[i] = string(encoded)
+		}
+		sort.Strings(encodedElements)
+
+		sorted := make([]interface{}, len(encodedElements))
+		for i, encoded := range encodedElements {
+			sorted[i] = json.RawMessage(encoded)
+		}
+		return sorted
+	}
+	return value
 }
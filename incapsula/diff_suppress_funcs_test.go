@@ -1,6 +1,8 @@
 package incapsula
 
 import (
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -30,3 +32,52 @@ func TestSuppressEquivalentStringDiffsSameStringsDifferent(t *testing.T) {
 		t.Errorf("Should not be equivalent")
 	}
 }
+
+func TestSuppressEquivalentStringDiffsSpacesAndDuplicates(t *testing.T) {
+	if !suppressEquivalentStringDiffs("", "1.2.3.4,5.6.7.8", " 5.6.7.8, 1.2.3.4,1.2.3.4,", nil) {
+		t.Errorf("Should be equivalent")
+	}
+}
+
+func TestSuppressEquivalentJSONStringDiffsKeyOrderAndWhitespace(t *testing.T) {
+	old := `{"a":1,"b":{"c":[1,2]}}`
+	new := `{
+  "b": { "c": [1, 2] },
+  "a": 1.0
+}`
+
+	if !suppressEquivalentJSONStringDiffs("", old, new, nil) {
+		t.Errorf("Should be equivalent")
+	}
+	if suppressEquivalentJSONStringDiffs("", old, `{"a":1,"b":{"c":[2,1]}}`, nil) {
+		t.Errorf("Should not be equivalent, the arrays are ordered")
+	}
+}
+
+func TestSuppressEquivalentJSONStringDiffsInvalid(t *testing.T) {
+	if suppressEquivalentJSONStringDiffs("", `{"a":1}`, `{"a":`, nil) {
+		t.Errorf("Should not be equivalent")
+	}
+	if !suppressEquivalentJSONStringDiffs("", " ", "", nil) {
+		t.Errorf("Should be equivalent")
+	}
+}
+
+func TestSuppressEquivalentUnorderedJSONStringDiffs(t *testing.T) {
+	old := `[{"settingsAction":"BLOCK","data":{"ips":["1.2.3.4","5.6.7.8"]}},{"settingsAction":"ALLOW","data":{"geo":{"countries":["US"]}}}]`
+	new := `[{"data":{"geo":{"countries":["US"]}},"settingsAction":"ALLOW"},{"settingsAction":"BLOCK","data":{"ips":["5.6.7.8","1.2.3.4"]}}]`
+
+	if !suppressEquivalentUnorderedJSONStringDiffs("", old, new, nil) {
+		t.Errorf("Should be equivalent")
+	}
+	if suppressEquivalentUnorderedJSONStringDiffs("", old, strings.Replace(new, "5.6.7.8", "9.9.9.9", 1), nil) {
+		t.Errorf("Should not be equivalent")
+	}
+}
+
+func TestNormalizeStringSet(t *testing.T) {
+	normalized := normalizeStringSet([]string{"b", " a", "", "b "})
+	if !reflect.DeepEqual(normalized, []string{"a", "b"}) {
+		t.Errorf("Should have normalized the items, got: %v", normalized)
+	}
+}
@@ -0,0 +1,132 @@
+package incapsula
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Enumerations accepted by the API, shared by the resource schemas so invalid values fail during plan
+// with the allowed values listed, instead of on apply
+
+var booleanStringValues = []string{"true", "false"}
+
+var dataStorageRegionValues = []string{"APAC", "AU", "EU", "US"}
+
+var logLevelValues = []string{"full", "security", "none"}
+
+var httpMethodValues = []string{"POST", "GET", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS"}
+
+// Site settings
+var siteActiveValues = []string{"active", "bypass"}
+
+var siteMaintenanceModeValues = []string{siteMaintenanceModeBypass, siteMaintenanceModeErrorPage}
+
+var siteDomainValidationValues = []string{"email", "html", "dns"}
+
+var siteAccelerationLevelValues = []string{"none", "standard", "aggressive"}
+
+var siteSealLocationValues = []string{
+	"api.seal_location.bottom_left",
+	"api.seal_location.none",
+	"api.seal_location.right_bottom",
+	"api.seal_location.right",
+	"api.seal_location.left",
+	"api.seal_location.bottom_right",
+	"api.seal_location.bottom",
+}
+
+var loginProtectAuthenticationMethodValues = []string{"ga", "sms", "email"}
+
+var loginProtectURLPatternValues = []string{"contains", "equals", "prefix", "suffix", "not_equals", "not_contain", "not_prefix", "not_suffix"}
+
+var perfModeHTTPSValues = []string{"disabled", "dont_include_html", "include_html", "include_all_resources"}
+
+var perfModeLevelValues = []string{"disable", "standard", "smart", "all_resources"}
+
+var perfCacheResponseHeaderModeValues = []string{"all", "custom"}
+
+var perfStaleContentModeValues = []string{"disabled", "adaptive", "custom"}
+
+// Waiting rooms
+var waitingRoomBotsActionValues = []string{"WAIT_IN_LINE", "BYPASS"}
+
+// Incap rules
+var incapRuleActionValues = []string{
+	"RULE_ACTION_REDIRECT",
+	"RULE_ACTION_SIMPLIFIED_REDIRECT",
+	"RULE_ACTION_REWRITE_URL",
+	"RULE_ACTION_REWRITE_HEADER",
+	"RULE_ACTION_REWRITE_COOKIE",
+	"RULE_ACTION_DELETE_HEADER",
+	"RULE_ACTION_DELETE_COOKIE",
+	"RULE_ACTION_RESPONSE_REWRITE_HEADER",
+	"RULE_ACTION_RESPONSE_DELETE_HEADER",
+	"RULE_ACTION_RESPONSE_REWRITE_RESPONSE_CODE",
+	"RULE_ACTION_FORWARD_TO_DC",
+	"RULE_ACTION_ALERT",
+	"RULE_ACTION_BLOCK",
+	"RULE_ACTION_BLOCK_USER",
+	"RULE_ACTION_BLOCK_IP",
+	"RULE_ACTION_RETRY",
+	"RULE_ACTION_INTRUSIVE_HTML",
+	"RULE_ACTION_CAPTCHA",
+	"RULE_ACTION_RATE",
+	"RULE_ACTION_CUSTOM_ERROR_RESPONSE",
+	"RULE_ACTION_FORWARD_TO_PORT",
+}
+
+var incapRuleRateContextValues = []string{"IP", "Session"}
+
+var incapRuleErrorTypeValues = []string{
+	"error.type.all",
+	"error.type.connection_timeout",
+	"error.type.access_denied",
+	"error.type.parse_req_error",
+	"error.type.parse_resp_error",
+	"error.type.connection_failed",
+	"error.type.deny_and_retry",
+	"error.type.ssl_failed",
+	"error.type.deny_and_captcha",
+	"error.type.2fa_required",
+	"error.type.no_ssl_config",
+	"error.type.no_ipv6_config",
+}
+
+var incapRuleErrorResponseFormatValues = []string{"json", "xml"}
+
+var incapRuleOverrideWafRuleValues = []string{"SQL Injection", "Remote File Inclusion", "Cross Site Scripting", "Illegal Resource Access"}
+
+var incapRuleOverrideWafActionValues = []string{"Alert Only", "Block Request", "Block User", "Block IP", "Ignore"}
+
+// Cache rules
+var cacheRuleActionValues = []string{
+	"HTTP_CACHE_MAKE_STATIC",
+	"HTTP_CACHE_CLIENT_CACHE_CTL",
+	"HTTP_CACHE_FORCE_UNCACHEABLE",
+	"HTTP_CACHE_ADD_TAG",
+	"HTTP_CACHE_DIFFERENTIATE_SSL",
+	"HTTP_CACHE_DIFFERENTIATE_BY_HEADER",
+	"HTTP_CACHE_DIFFERENTIATE_BY_COOKIE",
+	"HTTP_CACHE_DIFFERENTIATE_BY_GEO",
+	"HTTP_CACHE_IGNORE_PARAMS",
+	"HTTP_CACHE_ENRICH_CACHE_KEY",
+	"HTTP_CACHE_FORCE_VALIDATION",
+	"HTTP_CACHE_IGNORE_AUTH_HEADER",
+}
+
+// WAF security rules
+var wafActionValues = []string{wafActionDisabled, wafActionAlert, wafActionBlockRequest, wafActionBlockUser, wafActionBlockIP, wafActionQuarantineURL}
+
+var ddosActivationModeValues = []string{ddosActivationModeOff, ddosActivationModeAuto, ddosActivationModeOn}
+
+var ddosTrafficThresholdValues = []string{"10", "20", "50", "100", "200", "500", "750", "1000", "2000", "3000", "4000", "5000"}
+
+// Policies
+var policyTypeValues = []string{"ACL", "WHITELIST", "WAF_RULES"}
+
+var policyAssetTypeValues = []string{"WEBSITE"}
+
+// validateEnum rejects the values which aren't in the enumeration, the error lists the allowed values
+func validateEnum(values []string) schema.SchemaValidateFunc {
+	return validation.StringInSlice(values, false)
+}
@@ -0,0 +1,146 @@
+package incapsula
+
+import (
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestValidateEnum(t *testing.T) {
+	_, errs := validateEnum(logLevelValues)("security", "log_level")
+	if len(errs) != 0 {
+		t.Errorf("Should not have received an error, got: %v", errs)
+	}
+
+	_, errs = validateEnum(logLevelValues)("Security", "log_level")
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "[full security none]") {
+		t.Errorf("Should have received an error listing the allowed values, got: %v", errs)
+	}
+}
+
+func TestEnumValidatedAttributes(t *testing.T) {
+	cases := []struct {
+		resource  *schema.Resource
+		attribute string
+		valid     string
+		invalid   string
+	}{
+		{resourceSite(), "log_level", "full", "debug"},
+		{resourceSite(), "seal_location", "api.seal_location.bottom_right", "bottom_right"},
+		{resourceSite(), "perf_mode_level", "smart", "smarter"},
+		{resourceSiteTrustSeal(), "location", "api.seal_location.left", "left"},
+		{resourceSiteMaintenanceMode(), "mode", "error_page", "maintenance"},
+		{resourceIncapRule(), "action", "RULE_ACTION_REDIRECT", "RULE_ACTION_REDIRECTION"},
+		{resourceIncapRule(), "error_type", "error.type.all", "error.type.any"},
+		{resourceCacheRule(), "action", "HTTP_CACHE_MAKE_STATIC", "HTTP_CACHE_MAKE_DYNAMIC"},
+		{resourceWAFSecurityRule(), "security_rule_action", wafActionBlockIP, "api.threats.action.block"},
+		{resourceWAFSecurityRule(), "activation_mode", ddosActivationModeAuto, "auto"},
+		{resourceWAFSecurityRule(), "ddos_traffic_threshold", "750", "1234"},
+		{resourcePolicy(), "policy_type", "ACL", "acl"},
+	}
+
+	for _, c := range cases {
+		validate := c.resource.Schema[c.attribute].ValidateFunc
+		if validate == nil {
+			t.Errorf("Should have validated %s", c.attribute)
+			continue
+		}
+		if _, errs := validate(c.valid, c.attribute); len(errs) != 0 {
+			t.Errorf("Should have accepted %s for %s, got: %v", c.valid, c.attribute, errs)
+		}
+		if _, errs := validate(c.invalid, c.attribute); len(errs) == 0 {
+			t.Errorf("Should have rejected %s for %s", c.invalid, c.attribute)
+		}
+	}
+}
+
+func TestEnumCatalogUniqueValues(t *testing.T) {
+	enums := [][]string{
+		booleanStringValues, dataStorageRegionValues, logLevelValues, httpMethodValues, siteActiveValues, siteMaintenanceModeValues,
+		siteDomainValidationValues, siteAccelerationLevelValues, siteSealLocationValues, loginProtectAuthenticationMethodValues,
+		loginProtectURLPatternValues, perfModeHTTPSValues, perfModeLevelValues, perfCacheResponseHeaderModeValues,
+		perfStaleContentModeValues, waitingRoomBotsActionValues, incapRuleActionValues,
+		incapRuleRateContextValues, incapRuleErrorTypeValues, incapRuleErrorResponseFormatValues,
+		incapRuleOverrideWafRuleValues, incapRuleOverrideWafActionValues, cacheRuleActionValues, wafActionValues,
+		ddosActivationModeValues, ddosTrafficThresholdValues, policyTypeValues, policyAssetTypeValues,
+	}
+
+	for _, values := range enums {
+		seen := make(map[string]bool)
+		for _, value := range values {
+			if value == "" || seen[value] {
+				t.Errorf("Should have unique non empty values, got: %v", values)
+			}
+			seen[value] = true
+		}
+	}
+}
+
+// documentedValues returns the values listed after "Possible values" or "Options are" in the description of the
+// argument in the docs of the resource
+func documentedValues(t *testing.T, resource, attribute string) []string {
+	content, err := ioutil.ReadFile("../website/docs/r/" + resource + ".html.markdown")
+	if err != nil {
+		t.Fatalf("Could not read the docs of %s: %s", resource, err)
+	}
+
+	for _, line := range strings.Split(string(content), "\n") {
+		if !strings.HasPrefix(line, "* `"+attribute+"` - ") {
+			continue
+		}
+		for _, marker := range []string{"Possible values", "Options are"} {
+			i := strings.Index(line, marker)
+			if i < 0 {
+				continue
+			}
+			list := line[i+len(marker):]
+			if end := strings.Index(list, ". "); end >= 0 {
+				list = list[:end]
+			}
+			values := make([]string, 0)
+			for _, match := range regexp.MustCompile("`([^`]+)`").FindAllStringSubmatch(list, -1) {
+				values = append(values, match[1])
+			}
+			return values
+		}
+	}
+	t.Fatalf("Could not find the values of %s in the docs of %s", attribute, resource)
+	return nil
+}
+
+func TestEnumCatalogMatchesDocs(t *testing.T) {
+	cases := []struct {
+		resource  string
+		attribute string
+		values    []string
+	}{
+		{"site", "acceleration_level", siteAccelerationLevelValues},
+		{"site", "seal_location", siteSealLocationValues},
+		{"site", "data_storage_region", dataStorageRegionValues},
+		{"site", "log_level", logLevelValues},
+		{"site", "perf_mode_https", perfModeHTTPSValues},
+		{"site", "perf_mode_level", perfModeLevelValues},
+		{"site", "perf_response_cache_response_header_mode", perfCacheResponseHeaderModeValues},
+		{"site", "perf_response_stale_content_mode", perfStaleContentModeValues},
+		{"site_trust_seal", "location", siteSealLocationValues},
+		{"incap_rule", "action", incapRuleActionValues},
+		{"incap_rule", "rate_context", incapRuleRateContextValues},
+		{"incap_rule", "error_type", incapRuleErrorTypeValues},
+		{"incap_rule", "error_response_format", incapRuleErrorResponseFormatValues},
+		{"cache_rule", "action", cacheRuleActionValues},
+	}
+
+	for _, c := range cases {
+		documented := documentedValues(t, c.resource, c.attribute)
+		catalog := append([]string{}, c.values...)
+		sort.Strings(documented)
+		sort.Strings(catalog)
+		if strings.Join(documented, ",") != strings.Join(catalog, ",") {
+			t.Errorf("The values of %s documented for %s should match the catalog, got: %v, catalog: %v", c.attribute, c.resource, documented, catalog)
+		}
+	}
+}
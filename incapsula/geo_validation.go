@@ -0,0 +1,67 @@
+package incapsula
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+// validateGeoCodes returns a CustomizeDiff function that checks the comma separated country and continent codes
+// of the given attributes against the canonical list returned by the geo info API, so typos fail during plan. The list
+// is fetched once per client, and the check is skipped when it can't be fetched
+func validateGeoCodes(countriesKey, continentsKey string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+		countries := diff.Get(countriesKey).(string)
+		continents := diff.Get(continentsKey).(string)
+		if strings.TrimSpace(countries) == "" && strings.TrimSpace(continents) == "" {
+			return nil
+		}
+
+		// The provider may not be configured yet (e.g. during validate), the API will reject bad codes on apply
+		meta, ok := m.(*providerMeta)
+		if !ok || meta == nil {
+			return nil
+		}
+		client := meta.client
+
+		geoInfo, err := client.GetCachedGeoInfo()
+		if err != nil {
+			log.Printf("[WARN] Could not get Incapsula geo info, skipping the validation of %s and %s: %s\n", countriesKey, continentsKey, err)
+			return nil
+		}
+
+		err = validateGeoCodeList(countriesKey, countries, geoInfo.Countries)
+		if err != nil {
+			return err
+		}
+
+		return validateGeoCodeList(continentsKey, continents, geoInfo.Continents)
+	}
+}
+
+func validateGeoCodeList(key, value string, validCodes []imperva.GeoCode) error {
+	validCodesSet := make(map[string]bool, len(validCodes))
+	for _, code := range validCodes {
+		validCodesSet[code.ID] = true
+	}
+
+	invalidCodes := make([]string, 0)
+	for _, code := range strings.Split(value, ",") {
+		code = strings.TrimSpace(code)
+		if code != "" && !validCodesSet[code] {
+			invalidCodes = append(invalidCodes, code)
+		}
+	}
+
+	if len(invalidCodes) > 0 {
+		sort.Strings(invalidCodes)
+		return fmt.Errorf("%q contains unknown codes: %s. Use the incapsula_geo_locations data source to list the valid codes", key, strings.Join(invalidCodes, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package incapsula
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+func TestValidateGeoCodeList(t *testing.T) {
+	validCodes := []imperva.GeoCode{{ID: "US", Name: "United States"}, {ID: "IL", Name: "Israel"}}
+
+	if err := validateGeoCodeList("countries", "US,IL", validCodes); err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if err := validateGeoCodeList("countries", "", validCodes); err != nil {
+		t.Errorf("Should not have received an error for an empty list, got: %s", err)
+	}
+
+	err := validateGeoCodeList("countries", "US,XX,UK", validCodes)
+	if err == nil {
+		t.Fatalf("Should have received an error")
+	}
+	if !strings.Contains(err.Error(), "UK, XX") {
+		t.Errorf("Should have listed the unknown codes, got: %s", err)
+	}
+}
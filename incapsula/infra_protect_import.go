@@ -0,0 +1,29 @@
+package incapsula
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// infraProtectImportState accepts the ID of an Infrastructure Protection object,
+// or account_id/id for objects which belong to a sub account
+func infraProtectImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idSlice := strings.Split(d.Id(), "/")
+	if len(idSlice) > 2 || idSlice[len(idSlice)-1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%q), expected id or account_id/id", d.Id())
+	}
+
+	if len(idSlice) == 2 {
+		accountID, err := strconv.Atoi(idSlice[0])
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert account ID from import command, actual value: %s, expected numeric id", idSlice[0])
+		}
+		d.Set("account_id", accountID)
+		d.SetId(idSlice[1])
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,38 @@
+package incapsula
+
+import (
+	"testing"
+)
+
+func TestInfraProtectImportState(t *testing.T) {
+	r := resourceNetflowExporter()
+
+	d := r.TestResourceData()
+	d.SetId("123")
+	_, err := infraProtectImportState(d, nil)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if d.Id() != "123" || d.Get("account_id").(int) != 0 {
+		t.Errorf("Should have kept ID 123 without account_id, got: %s, %d", d.Id(), d.Get("account_id").(int))
+	}
+
+	d = r.TestResourceData()
+	d.SetId("42/123")
+	_, err = infraProtectImportState(d, nil)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if d.Id() != "123" || d.Get("account_id").(int) != 42 {
+		t.Errorf("Should have set ID 123 and account_id 42, got: %s, %d", d.Id(), d.Get("account_id").(int))
+	}
+
+	for _, id := range []string{"abc/123", "1/2/3", "42/"} {
+		d = r.TestResourceData()
+		d.SetId(id)
+		_, err = infraProtectImportState(d, nil)
+		if err == nil {
+			t.Errorf("Should have received an error for ID %s", id)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// mutexKV is a simple key/value store for arbitrary mutexes. It can be used to serialize changes across
+// arbitrary collaborators that share knowledge of the keys they must serialize on.
+type mutexKV struct {
+	lock  sync.Mutex
+	store map[string]*sync.Mutex
+}
+
+// Lock the mutex for the given key. Caller is responsible for calling Unlock for the same key
+func (m *mutexKV) Lock(key string) {
+	log.Printf("[DEBUG] Locking %q", key)
+	m.get(key).Lock()
+	log.Printf("[DEBUG] Locked %q", key)
+}
+
+// Unlock the mutex for the given key. Caller must have called Lock for the same key first
+func (m *mutexKV) Unlock(key string) {
+	log.Printf("[DEBUG] Unlocking %q", key)
+	m.get(key).Unlock()
+	log.Printf("[DEBUG] Unlocked %q", key)
+}
+
+// Returns a mutex for the given key, no guarantee of its lock status
+func (m *mutexKV) get(key string) *sync.Mutex {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	mutex, ok := m.store[key]
+	if !ok {
+		mutex = &sync.Mutex{}
+		m.store[key] = mutex
+	}
+	return mutex
+}
+
+func newMutexKV() *mutexKV {
+	return &mutexKV{
+		store: make(map[string]*sync.Mutex),
+	}
+}
+
+// siteMutexKV serializes the changes to the same site. The API intermittently returns conflicts,
+// or drops changes, when rules and settings of a site are changed in parallel.
+var siteMutexKV = newMutexKV()
+
+func siteLockKey(siteID interface{}) string {
+	return fmt.Sprintf("site/%v", siteID)
+}
+
+// withSiteLock wraps the create, update or delete function of a resource with a site_id argument,
+// so the changes to the same site are applied one at a time.
+// Only wrap the functions set on the resource, never the ones they call, the mutexes aren't reentrant.
+func withSiteLock(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, m interface{}) error {
+		key := siteLockKey(d.Get("site_id"))
+		siteMutexKV.Lock(key)
+		defer siteMutexKV.Unlock(key)
+		return f(d, m)
+	}
+}
+
+// withSiteIDLock is withSiteLock for the site resource itself, whose ID is the site ID
+func withSiteIDLock(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, m interface{}) error {
+		key := siteLockKey(d.Id())
+		siteMutexKV.Lock(key)
+		defer siteMutexKV.Unlock(key)
+		return f(d, m)
+	}
+}
@@ -0,0 +1,115 @@
+package incapsula
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestMutexKVLock(t *testing.T) {
+	mkv := newMutexKV()
+
+	mkv.Lock("foo")
+
+	doneCh := make(chan struct{})
+
+	go func() {
+		mkv.Lock("foo")
+		close(doneCh)
+	}()
+
+	select {
+	case <-doneCh:
+		t.Fatal("Second lock was able to be taken. This shouldn't happen.")
+	case <-time.After(50 * time.Millisecond):
+		// pass
+	}
+}
+
+func TestMutexKVUnlock(t *testing.T) {
+	mkv := newMutexKV()
+
+	mkv.Lock("foo")
+	mkv.Unlock("foo")
+
+	doneCh := make(chan struct{})
+
+	go func() {
+		mkv.Lock("foo")
+		close(doneCh)
+	}()
+
+	select {
+	case <-doneCh:
+		// pass
+	case <-time.After(50 * time.Millisecond):
+		t.Fatal("Second lock blocked after unlock. This shouldn't happen.")
+	}
+}
+
+func TestMutexKVDifferentKeys(t *testing.T) {
+	mkv := newMutexKV()
+
+	mkv.Lock("foo")
+
+	doneCh := make(chan struct{})
+
+	go func() {
+		mkv.Lock("bar")
+		close(doneCh)
+	}()
+
+	select {
+	case <-doneCh:
+		// pass
+	case <-time.After(50 * time.Millisecond):
+		t.Fatal("Second lock on a different key blocked. This shouldn't happen.")
+	}
+}
+
+func TestWithSiteLockSerializesSameSite(t *testing.T) {
+	// incapsula_txt_record has an int site_id, incapsula_cache_rule a string one, both lock the same site
+	resources := make([]*schema.ResourceData, 0)
+	for i := 0; i < 2; i++ {
+		txtRecord := resourceTXTRecord().TestResourceData()
+		txtRecord.Set("site_id", 123)
+		cacheRule := resourceCacheRule().TestResourceData()
+		cacheRule.Set("site_id", "123")
+		resources = append(resources, txtRecord, cacheRule)
+	}
+
+	var mutex sync.Mutex
+	running := 0
+	concurrent := false
+	f := withSiteLock(func(d *schema.ResourceData, m interface{}) error {
+		mutex.Lock()
+		running++
+		if running > 1 {
+			concurrent = true
+		}
+		mutex.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		mutex.Lock()
+		running--
+		mutex.Unlock()
+		return nil
+	})
+
+	var wg sync.WaitGroup
+	for _, d := range resources {
+		wg.Add(1)
+		go func(d *schema.ResourceData) {
+			defer wg.Done()
+			f(d, nil)
+		}(d)
+	}
+	wg.Wait()
+
+	if concurrent {
+		t.Errorf("Changes to the same site should have been applied one at a time")
+	}
+}
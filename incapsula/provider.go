@@ -2,6 +2,7 @@ package incapsula
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
 )
 
 var baseURL string
@@ -28,19 +29,46 @@ func init() {
 		"base_url_rev_2": "The base URL (revision 2) for API operations. Used for provider development.",
 
 		"base_url_api": "The base URL (same as v2 but with different subdomain) for API operations. Used for provider development.",
+
+		"validate_references": "Check the sites and accounts referenced by resources exist during plan, using read-only API calls. " +
+			"Can be set via INCAPSULA_VALIDATE_REFERENCES environment variable.",
 	}
 }
 
 func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
-	config := Config{
+	config := providerConfig(d)
+	return newProviderMeta(d, config)
+}
+
+// providerMeta is passed to the resources and data sources as meta, the client along with the provider-level options
+type providerMeta struct {
+	client *imperva.Client
+
+	// validateReferences makes the resources check the sites and accounts they reference during plan
+	validateReferences bool
+}
+
+// newProviderMeta creates the meta passed to the resources, with the client and the provider-level options
+func newProviderMeta(d *schema.ResourceData, config imperva.Config) (interface{}, error) {
+	client, err := config.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	return &providerMeta{
+		client:             client,
+		validateReferences: d.Get("validate_references").(bool),
+	}, nil
+}
+
+func providerConfig(d *schema.ResourceData) imperva.Config {
+	return imperva.Config{
 		APIID:       d.Get("api_id").(string),
 		APIKey:      d.Get("api_key").(string),
 		BaseURL:     d.Get("base_url").(string),
 		BaseURLRev2: d.Get("base_url_rev_2").(string),
 		BaseURLAPI:  d.Get("base_url_api").(string),
 	}
-
-	return config.Client()
 }
 
 // Provider returns a *schema.Provider.
@@ -77,35 +105,73 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("INCAPSULA_BASE_URL_API", baseURLAPI),
 				Description: descriptions["base_url_api"],
 			},
+			"validate_references": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("INCAPSULA_VALIDATE_REFERENCES", false),
+				Description: descriptions["validate_references"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"incapsula_role_abilities": dataSourceRoleAbilities(),
-			"incapsula_data_center":    dataSourceDataCenter(),
+			"incapsula_role_abilities":           dataSourceRoleAbilities(),
+			"incapsula_data_center":              dataSourceDataCenter(),
+			"incapsula_sites":                    dataSourceSites(),
+			"incapsula_site":                     dataSourceSite(),
+			"incapsula_client_apps":              dataSourceClientApps(),
+			"incapsula_geo_locations":            dataSourceGeoLocations(),
+			"incapsula_waf_rules":                dataSourceWAFRules(),
+			"incapsula_policy":                   dataSourcePolicy(),
+			"incapsula_data_centers":             dataSourceDataCenters(),
+			"incapsula_custom_certificate":       dataSourceCustomCertificate(),
+			"incapsula_account_export":           dataSourceAccountExport(),
+			"incapsula_infra_protect_statistics": dataSourceInfraProtectStatistics(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"incapsula_cache_rule":                   resourceCacheRule(),
-			"incapsula_custom_certificate":           resourceCertificate(),
-			"incapsula_data_center":                  resourceDataCenter(),
-			"incapsula_data_center_server":           resourceDataCenterServer(),
-			"incapsula_incap_rule":                   resourceIncapRule(),
-			"incapsula_origin_pop":                   resourceOriginPOP(),
-			"incapsula_policy":                       resourcePolicy(),
-			"incapsula_policy_asset_association":     resourcePolicyAssetAssociation(),
-			"incapsula_security_rule_exception":      resourceSecurityRuleException(),
-			"incapsula_site":                         resourceSite(),
-			"incapsula_waf_security_rule":            resourceWAFSecurityRule(),
-			"incapsula_account":                      resourceAccount(),
-			"incapsula_subaccount":                   resourceSubAccount(),
-			"incapsula_txt_record":                   resourceTXTRecord(),
-			"incapsula_data_centers_configuration":   resourceDataCentersConfiguration(),
-			"incapsula_api_security_site_config":     resourceApiSecuritySiteConfig(),
-			"incapsula_api_security_api_config":      resourceApiSecurityApiConfig(),
-			"incapsula_api_security_endpoint_config": resourceApiSecurityEndpointConfig(),
-			"incapsula_notification_center_policy":   resourceNotificationCenterPolicy(),
-			"incapsula_csp_site_configuration":       resourceCSPSiteConfiguration(),
-			"incapsula_csp_site_domain":              resourceCSPSiteDomain(),
+			"incapsula_cache_rule":                       resourceCacheRule(),
+			"incapsula_custom_certificate":               resourceCertificate(),
+			"incapsula_data_center":                      resourceDataCenter(),
+			"incapsula_data_center_server":               resourceDataCenterServer(),
+			"incapsula_incap_rule":                       resourceIncapRule(),
+			"incapsula_origin_pop":                       resourceOriginPOP(),
+			"incapsula_policy":                           resourcePolicy(),
+			"incapsula_policy_asset_association":         resourcePolicyAssetAssociation(),
+			"incapsula_security_rule_exception":          resourceSecurityRuleException(),
+			"incapsula_site":                             resourceSite(),
+			"incapsula_waf_security_rule":                resourceWAFSecurityRule(),
+			"incapsula_account":                          resourceAccount(),
+			"incapsula_subaccount":                       resourceSubAccount(),
+			"incapsula_txt_record":                       resourceTXTRecord(),
+			"incapsula_data_centers_configuration":       resourceDataCentersConfiguration(),
+			"incapsula_api_security_site_config":         resourceApiSecuritySiteConfig(),
+			"incapsula_api_security_api_config":          resourceApiSecurityApiConfig(),
+			"incapsula_api_security_endpoint_config":     resourceApiSecurityEndpointConfig(),
+			"incapsula_notification_center_policy":       resourceNotificationCenterPolicy(),
+			"incapsula_csp_site_configuration":           resourceCSPSiteConfiguration(),
+			"incapsula_csp_site_domain":                  resourceCSPSiteDomain(),
+			"incapsula_account_data_storage_region":      resourceAccountDataStorageRegion(),
+			"incapsula_netflow_exporter":                 resourceNetflowExporter(),
+			"incapsula_protected_ip_range":               resourceProtectedIPRange(),
+			"incapsula_bgp_connection":                   resourceBGPConnection(),
+			"incapsula_origin_connectivity_monitoring":   resourceOriginConnectivityMonitoring(),
+			"incapsula_network_ddos_settings":            resourceNetworkDDoSSettings(),
+			"incapsula_infra_protect_test_alert":         resourceInfraProtectTestAlert(),
+			"incapsula_dns_protection_zone":              resourceDNSProtectionZone(),
+			"incapsula_flow_monitoring_device":           resourceFlowMonitoringDevice(),
+			"incapsula_gre_tunnel":                       resourceGRETunnel(),
+			"incapsula_ipsec_tunnel":                     resourceIPsecTunnel(),
+			"incapsula_infra_protect_access_list":        resourceInfraProtectAccessList(),
+			"incapsula_infra_protect_syslog_destination": resourceInfraProtectSyslogDestination(),
+			"incapsula_login_protect":                    resourceLoginProtect(),
+			"incapsula_login_protect_url":                resourceLoginProtectURL(),
+			"incapsula_waiting_room":                     resourceWaitingRoom(),
+			"incapsula_site_cname_configuration":         resourceSiteCNAMEConfiguration(),
+			"incapsula_site_trust_seal":                  resourceSiteTrustSeal(),
+			"incapsula_account_trusted_ips":              resourceAccountTrustedIPs(),
+			"incapsula_site_maintenance_mode":            resourceSiteMaintenanceMode(),
+			"incapsula_site_full_config":                 resourceSiteFullConfig(),
+			"incapsula_managed_certificate_validation":   resourceManagedCertificateValidation(),
 		},
 	}
 
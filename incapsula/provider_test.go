@@ -3,11 +3,14 @@ package incapsula
 import (
 	"context"
 	"os"
+	"strings"
 	"sync"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
 )
 
 var testAccProviders map[string]*schema.Provider
@@ -26,11 +29,61 @@ func ThreeValidPoPs() []string {
 
 func init() {
 	testAccProvider = Provider()
+	if testAccVCR != nil {
+		testAccProvider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+			config := providerConfig(d)
+			config.Transport = testAccVCR
+			return newProviderMeta(d, config)
+		}
+	}
 	testAccProviders = map[string]*schema.Provider{
 		"incapsula": testAccProvider,
 	}
 }
 
+// TestMain runs the sweepers with `go test ./incapsula -v -sweep=all`, the region is ignored
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+// sharedClientForSweepers returns a client configured like the provider, from the INCAPSULA_* environment variables
+func sharedClientForSweepers() (*imperva.Client, error) {
+	config := imperva.Config{
+		APIID:       os.Getenv("INCAPSULA_API_ID"),
+		APIKey:      os.Getenv("INCAPSULA_API_KEY"),
+		BaseURL:     baseURL,
+		BaseURLRev2: baseURLRev2,
+		BaseURLAPI:  baseURLAPI,
+	}
+	if v := os.Getenv("INCAPSULA_BASE_URL"); v != "" {
+		config.BaseURL = v
+	}
+	if v := os.Getenv("INCAPSULA_BASE_URL_REV_2"); v != "" {
+		config.BaseURLRev2 = v
+	}
+	if v := os.Getenv("INCAPSULA_BASE_URL_API"); v != "" {
+		config.BaseURLAPI = v
+	}
+
+	return config.Client()
+}
+
+// listTestSitesForSweepers lists the sites created by the acceptance tests
+func listTestSitesForSweepers(client *imperva.Client) ([]imperva.SiteStatusResponse, error) {
+	sites, err := client.ListAllSites(0)
+	if err != nil {
+		return nil, err
+	}
+
+	testSites := make([]imperva.SiteStatusResponse, 0)
+	for _, site := range sites {
+		if strings.HasSuffix(site.Domain, testAccSiteDomainSuffix) {
+			testSites = append(testSites, site)
+		}
+	}
+	return testSites, nil
+}
+
 func TestProvider(t *testing.T) {
 	if err := Provider().InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
@@ -42,6 +95,11 @@ func TestProvider_impl(t *testing.T) {
 }
 
 func testAccPreCheck(t *testing.T) {
+	if testAccVCR != nil {
+		testAccVCRPreCheck(t)
+		return
+	}
+
 	testAccProviderConfigure.Do(func() {
 		if v := os.Getenv("INCAPSULA_API_ID"); v == "" {
 			t.Fatal("INCAPSULA_API_ID must be set for acceptance tests")
@@ -57,3 +115,30 @@ func testAccPreCheck(t *testing.T) {
 		}
 	})
 }
+
+// testAccVCRPreCheck starts the fixture of the test and configures the provider for every test,
+// so each fixture holds all the interactions its test needs
+func testAccVCRPreCheck(t *testing.T) {
+	if testAccVCR.mode == vcrModeReplay {
+		// Replayed requests don't need valid credentials
+		for _, envVar := range []string{"INCAPSULA_API_ID", "INCAPSULA_API_KEY"} {
+			if os.Getenv(envVar) == "" {
+				os.Setenv(envVar, "vcr-replay")
+			}
+		}
+	}
+
+	if v := os.Getenv("INCAPSULA_API_ID"); v == "" {
+		t.Fatal("INCAPSULA_API_ID must be set for acceptance tests")
+	}
+
+	if v := os.Getenv("INCAPSULA_API_KEY"); v == "" {
+		t.Fatal("INCAPSULA_API_KEY must be set for acceptance tests")
+	}
+
+	testAccVCR.start(t, t.Name())
+	err := testAccProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,49 @@
+package incapsula
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+// validateSiteReference returns a CustomizeDiff function that checks the site referenced by the given attribute
+// exists when the provider's validate_references is enabled, so a site deleted outside of Terraform fails during plan
+// with a pointed error instead of an API response on apply
+func validateSiteReference(siteIDKey string) schema.CustomizeDiffFunc {
+	return validateReference(siteIDKey, func(client *imperva.Client, id int) error { return client.CheckSiteReference(id) })
+}
+
+// validateAccountReference is the same as validateSiteReference for an attribute referencing an account
+func validateAccountReference(accountIDKey string) schema.CustomizeDiffFunc {
+	return validateReference(accountIDKey, func(client *imperva.Client, id int) error { return client.CheckAccountReference(id) })
+}
+
+func validateReference(key string, check func(client *imperva.Client, id int) error) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+		// The provider may not be configured yet (e.g. during validate)
+		meta, ok := m.(*providerMeta)
+		if !ok || meta == nil || !meta.validateReferences {
+			return nil
+		}
+		client := meta.client
+
+		// The ID isn't known yet when it references a resource created in the same apply
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+
+		id, err := strconv.Atoi(fmt.Sprint(diff.Get(key)))
+		if err != nil || id == 0 {
+			return nil
+		}
+
+		err = check(client, id)
+		if err != nil {
+			return fmt.Errorf("%s: %s", key, err)
+		}
+		return nil
+	}
+}
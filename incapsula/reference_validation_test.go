@@ -0,0 +1,52 @@
+package incapsula
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestReferenceValidatedResources(t *testing.T) {
+	for name, resource := range Provider().ResourcesMap {
+		if _, ok := resource.Schema["site_id"]; ok && resource.CustomizeDiff == nil {
+			t.Errorf("Should have validated the site_id of %s", name)
+		}
+	}
+}
+
+func TestValidateReferenceDisabled(t *testing.T) {
+	validate := validateSiteReference("site_id")
+
+	// The diff isn't read when the references aren't validated
+	for _, m := range []interface{}{nil, &providerMeta{validateReferences: false}} {
+		if err := validate(context.Background(), nil, m); err != nil {
+			t.Errorf("Should not have validated the reference, got: %s", err)
+		}
+	}
+}
+
+func TestNewProviderMeta(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"res":0,"account":{"account_id":123}}`))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"api_id":              "foo",
+		"api_key":             "bar",
+		"base_url":            server.URL,
+		"validate_references": true,
+	})
+
+	m, err := newProviderMeta(d, providerConfig(d))
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	meta := m.(*providerMeta)
+	if meta.client == nil || !meta.validateReferences {
+		t.Errorf("Should have kept the client and validate_references, got: %+v", meta)
+	}
+}
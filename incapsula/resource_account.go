@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
 )
 
 func resourceAccount() *schema.Resource {
@@ -67,7 +68,7 @@ func resourceAccount() *schema.Resource {
 				Description:  "The log level. Options are `full`, `security`, and `none`.",
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validation.StringInSlice([]string{"full", "security", "none"}, false),
+				ValidateFunc: validateEnum(logLevelValues),
 			},
 			"error_page_template": {
 				Description: "Base64 encoded template for an error page.",
@@ -98,7 +99,7 @@ func resourceAccount() *schema.Resource {
 				Type:         schema.TypeString,
 				Default:      "US",
 				Optional:     true,
-				ValidateFunc: validation.StringInSlice([]string{"APAC", "EU", "US", "AU"}, false),
+				ValidateFunc: validateEnum(dataStorageRegionValues),
 			},
 
 			// Computed Attributes
@@ -122,7 +123,7 @@ func resourceAccount() *schema.Resource {
 }
 
 func resourceAccountCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 	email := d.Get("email").(string)
 
 	log.Printf("[INFO] Creating Incapsula account for email: %s\n", email)
@@ -166,7 +167,7 @@ func resourceAccountCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceAccountRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	accountID, _ := strconv.Atoi(d.Id())
 
@@ -199,6 +200,7 @@ func resourceAccountRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("support_all_tls_versions", accountStatusResponse.Account.SupportAllTLSVersions)
 	d.Set("wildcard_san_for_new_sites", accountStatusResponse.Account.WildcardSANForNewSites)
 	d.Set("naked_domain_san_for_new_www_sites", accountStatusResponse.Account.NakedDomainSANForNewWWWSites)
+	// error_page_template, log_level and logs_account_id aren't returned by the API and are kept as configured
 
 	// Get the performance settings for the site
 	defaultAccountDataStorageRegion, err := client.GetAccountDataStorageRegion(d.Id())
@@ -214,7 +216,7 @@ func resourceAccountRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceAccountUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	updateParams := [7]string{"email", "plan_id", "ref_id", "error_page_template", "support_all_tls_versions", "naked_domain_san_for_new_www_sites", "wildcard_san_for_new_sites"}
 	for i := 0; i < len(updateParams); i++ {
@@ -249,7 +251,7 @@ func resourceAccountUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceAccountDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 	accountID, _ := strconv.Atoi(d.Id())
 
 	log.Printf("[INFO] Deleting Incapsula account id: %d\n", accountID)
@@ -270,7 +272,7 @@ func resourceAccountDelete(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func updateAdditionalAccountProperties(client *Client, d *schema.ResourceData) error {
+func updateAdditionalAccountProperties(client *imperva.Client, d *schema.ResourceData) error {
 	updateParams := [5]string{"name", "error_page_template", "support_all_tls_versions", "naked_domain_san_for_new_www_sites", "wildcard_san_for_new_sites"}
 	for i := 0; i < len(updateParams); i++ {
 		param := updateParams[i]
@@ -286,7 +288,7 @@ func updateAdditionalAccountProperties(client *Client, d *schema.ResourceData) e
 	return nil
 }
 
-func updateAccountLogLevel(client *Client, d *schema.ResourceData) error {
+func updateAccountLogLevel(client *imperva.Client, d *schema.ResourceData) error {
 	if d.HasChange("log_level") ||
 		d.HasChange("logs_account_id") {
 		logLevel := d.Get("log_level").(string)
@@ -300,7 +302,7 @@ func updateAccountLogLevel(client *Client, d *schema.ResourceData) error {
 	return nil
 }
 
-func updateDefaultDataStorageRegion(client *Client, d *schema.ResourceData) error {
+func updateDefaultDataStorageRegion(client *imperva.Client, d *schema.ResourceData) error {
 	if d.HasChange("data_storage_region") {
 		region := d.Get("data_storage_region").(string)
 		_, err := client.UpdateAccountDataStorageRegion(d.Id(), region)
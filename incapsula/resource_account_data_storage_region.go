@@ -0,0 +1,101 @@
+package incapsula
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAccountDataStorageRegion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAccountDataStorageRegionUpdate,
+		Read:   resourceAccountDataStorageRegionRead,
+		Update: resourceAccountDataStorageRegionUpdate,
+		Delete: resourceAccountDataStorageRegionDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				accountID, err := strconv.Atoi(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				d.Set("account_id", accountID)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		CustomizeDiff: validateAccountReference("account_id"),
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account to operate on.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"region": {
+				Description:  "Default data region of the account for newly created sites. Options are `APAC`, `EU`, `US` and `AU`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateEnum(dataStorageRegionValues),
+			},
+		},
+	}
+}
+
+func resourceAccountDataStorageRegionUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := strconv.Itoa(d.Get("account_id").(int))
+	region := d.Get("region").(string)
+
+	log.Printf("[INFO] Setting Incapsula default data storage region: %s for account: %s\n", region, accountID)
+
+	_, err := client.UpdateAccountDataStorageRegion(accountID, region)
+	if err != nil {
+		log.Printf("[ERROR] Could not set Incapsula default data storage region: %s for account: %s: %s\n", region, accountID, err)
+		return err
+	}
+
+	d.SetId(accountID)
+
+	log.Printf("[INFO] Set Incapsula default data storage region: %s for account: %s\n", region, accountID)
+
+	return resourceAccountDataStorageRegionRead(d, m)
+}
+
+func resourceAccountDataStorageRegionRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+
+	log.Printf("[INFO] Reading Incapsula default data storage region for account: %s\n", d.Id())
+
+	accountDataStorageRegionResponse, err := client.GetAccountDataStorageRegion(d.Id())
+
+	// Account may have been deleted
+	if accountDataStorageRegionResponse != nil && accountDataStorageRegionResponse.Res == 9403 {
+		log.Printf("[INFO] Incapsula account %s has already been deleted: %s\n", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula default data storage region for account: %s, %s\n", d.Id(), err)
+		return err
+	}
+
+	d.Set("region", accountDataStorageRegionResponse.Region)
+
+	log.Printf("[INFO] Finished reading Incapsula default data storage region for account: %s\n", d.Id())
+
+	return nil
+}
+
+func resourceAccountDataStorageRegionDelete(d *schema.ResourceData, m interface{}) error {
+	// The default data storage region can't be removed from an account, only changed.
+	// Deleting the resource leaves the current region in place and stops managing it.
+	log.Printf("[INFO] Removing Incapsula default data storage region for account %s from state, the region remains unchanged\n", d.Id())
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package incapsula
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const accountDataStorageRegionResourceType = "incapsula_account_data_storage_region"
+const accountDataStorageRegionResourceName = "testacc-terraform-account-data-storage-region"
+const accountDataStorageRegionResource = accountDataStorageRegionResourceType + "." + accountDataStorageRegionResourceName
+
+func TestAccIncapsulaAccountDataStorageRegion_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaAccountDataStorageRegionConfigBasic("EU"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaAccountDataStorageRegionExists(accountDataStorageRegionResource, "EU"),
+					resource.TestCheckResourceAttr(accountDataStorageRegionResource, "region", "EU"),
+				),
+			},
+			{
+				Config: testAccCheckIncapsulaAccountDataStorageRegionConfigBasic("US"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaAccountDataStorageRegionExists(accountDataStorageRegionResource, "US"),
+					resource.TestCheckResourceAttr(accountDataStorageRegionResource, "region", "US"),
+				),
+			},
+			{
+				ResourceName:      accountDataStorageRegionResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckIncapsulaAccountDataStorageRegionExists(name, region string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		res, ok := state.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Incapsula account data storage region resource not found: %s", name)
+		}
+
+		accountID := res.Primary.ID
+		if accountID == "" {
+			return fmt.Errorf("Incapsula account ID does not exist")
+		}
+
+		client := testAccProvider.Meta().(*providerMeta).client
+		accountDataStorageRegionResponse, err := client.GetAccountDataStorageRegion(accountID)
+		if err != nil {
+			return fmt.Errorf("Incapsula default data storage region for account id: %s does not exist: %s", accountID, err)
+		}
+		if accountDataStorageRegionResponse.Region != region {
+			return fmt.Errorf("Incapsula default data storage region for account id: %s is %s, expected %s", accountID, accountDataStorageRegionResponse.Region, region)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIncapsulaAccountDataStorageRegionConfigBasic(region string) string {
+	return testAccCheckIncapsulaSiteConfigBasic(GenerateTestDomain(nil)) + fmt.Sprintf(`
+	resource "%s" "%s" {
+		account_id = %s.account_id
+		region     = "%s"
+	}`,
+		accountDataStorageRegionResourceType, accountDataStorageRegionResourceName, siteResourceName, region,
+	)
+}
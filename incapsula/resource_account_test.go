@@ -72,7 +72,7 @@ func TestIncapsulaAccount_ImportBasic(t *testing.T) {
 }
 
 func testCheckIncapsulaAccountDestroy(state *terraform.State) error {
-	client := testAccProvider.Meta().(*Client)
+	client := testAccProvider.Meta().(*providerMeta).client
 
 	for _, res := range state.RootModule().Resources {
 		if res.Type != "incapsula_account" {
@@ -116,7 +116,7 @@ func testCheckIncapsulaAccountExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Account ID conversion error for %s: %s", accountIDStr, err)
 		}
 
-		client := testAccProvider.Meta().(*Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 		accountStatusResponse, err := client.AccountStatus(accountID)
 		if accountStatusResponse == nil {
 			return fmt.Errorf("Incapsula account id: %d does not exist", accountID)
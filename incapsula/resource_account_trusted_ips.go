@@ -0,0 +1,212 @@
+package incapsula
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+const policyAssetTypeWebsite = "WEBSITE"
+
+func resourceAccountTrustedIPs() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAccountTrustedIPsCreate,
+		Read:   resourceAccountTrustedIPsRead,
+		Update: resourceAccountTrustedIPsUpdate,
+		Delete: resourceAccountTrustedIPsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: validateAccountReference("account_id"),
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"ips": {
+				Description: "The trusted IPs, IP ranges (e.g. `1.2.3.4-1.2.3.10`) and CIDRs.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Required: true,
+				MinItems: 1,
+			},
+
+			// Optional Arguments
+			"account_id": {
+				Description: "The account of the trusted IPs. If not specified, the account of the API credentials is used.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name of the allowlist policy.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Trusted IPs",
+			},
+			"description": {
+				Description: "The description of the allowlist policy.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"enabled": {
+				Description: "Enables the trusted IPs.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"apply_to_existing_sites": {
+				Description: "Applies the trusted IPs to the sites of the account when the resource is created. The new sites always get them.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+// accountTrustedIPsPolicy returns the allowlist policy of the trusted IPs, the default policy of the account's websites
+func accountTrustedIPsPolicy(d *schema.ResourceData, accountID int) *imperva.PolicySubmitted {
+	policySetting := imperva.PolicySetting{
+		SettingsAction:    "ALLOW",
+		PolicySettingType: "IP",
+	}
+	policySetting.Data.Ips = expandStringSet(d.Get("ips").(*schema.Set))
+
+	policySubmitted := &imperva.PolicySubmitted{
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		Enabled:        d.Get("enabled").(bool),
+		AccountID:      accountID,
+		PolicyType:     "WHITELIST",
+		PolicySettings: []imperva.PolicySetting{policySetting},
+	}
+	if accountID != 0 {
+		policySubmitted.DefaultPolicyConfig = []imperva.PolicyDefaultConfig{{AccountID: accountID, AssetType: policyAssetTypeWebsite}}
+	}
+	return policySubmitted
+}
+
+func resourceAccountTrustedIPsCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	log.Printf("[INFO] Creating Incapsula trusted IPs for account id: %d\n", accountID)
+
+	policyAddResponse, err := client.AddPolicy(accountTrustedIPsPolicy(d, accountID))
+	if err != nil {
+		log.Printf("[ERROR] Could not create Incapsula trusted IPs for account id: %d, %s\n", accountID, err)
+		return err
+	}
+
+	policyID := policyAddResponse.Value.ID
+	d.SetId(strconv.Itoa(policyID))
+
+	// The account of the API credentials is only known once the policy is created
+	accountID = policyAddResponse.Value.AccountID
+	d.Set("account_id", accountID)
+	if len(policyAddResponse.Value.DefaultPolicyConfig) == 0 {
+		_, err = client.UpdatePolicy(policyID, accountTrustedIPsPolicy(d, accountID))
+		if err != nil {
+			log.Printf("[ERROR] Could not set Incapsula trusted IPs policy %d as default of account id: %d, %s\n", policyID, accountID, err)
+			return err
+		}
+	}
+
+	if d.Get("apply_to_existing_sites").(bool) {
+		sites, err := client.ListAllSites(accountID)
+		if err != nil {
+			log.Printf("[ERROR] Could not list the Incapsula sites of account id: %d, %s\n", accountID, err)
+			return err
+		}
+
+		for _, site := range sites {
+			err = client.AddPolicyAssetAssociation(d.Id(), strconv.Itoa(site.SiteID), policyAssetTypeWebsite)
+			if err != nil {
+				log.Printf("[ERROR] Could not apply Incapsula trusted IPs policy %d to site id: %d, %s\n", policyID, site.SiteID, err)
+				return err
+			}
+		}
+	}
+
+	log.Printf("[INFO] Created Incapsula trusted IPs policy %d for account id: %d\n", policyID, accountID)
+
+	return resourceAccountTrustedIPsRead(d, m)
+}
+
+func resourceAccountTrustedIPsRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	policyID := d.Id()
+
+	log.Printf("[INFO] Reading Incapsula trusted IPs policy: %s\n", policyID)
+
+	policyGetResponse, err := client.GetPolicy(policyID)
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula trusted IPs policy: %s, %s\n", policyID, err)
+		return err
+	}
+
+	ips := make([]string, 0)
+	for _, policySetting := range policyGetResponse.Value.PolicySettings {
+		if policySetting.PolicySettingType == "IP" {
+			ips = append(ips, policySetting.Data.Ips...)
+		}
+	}
+
+	d.Set("account_id", policyGetResponse.Value.AccountID)
+	d.Set("name", policyGetResponse.Value.Name)
+	d.Set("description", policyGetResponse.Value.Description)
+	d.Set("enabled", policyGetResponse.Value.Enabled)
+	d.Set("ips", ips)
+
+	log.Printf("[INFO] Finished reading Incapsula trusted IPs policy: %s\n", policyID)
+
+	return nil
+}
+
+func resourceAccountTrustedIPsUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	policyID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Updating Incapsula trusted IPs policy: %d\n", policyID)
+
+	_, err = client.UpdatePolicy(policyID, accountTrustedIPsPolicy(d, d.Get("account_id").(int)))
+	if err != nil {
+		log.Printf("[ERROR] Could not update Incapsula trusted IPs policy: %d, %s\n", policyID, err)
+		return err
+	}
+
+	log.Printf("[INFO] Updated Incapsula trusted IPs policy: %d\n", policyID)
+
+	return resourceAccountTrustedIPsRead(d, m)
+}
+
+func resourceAccountTrustedIPsDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	policyID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Incapsula trusted IPs policy: %d\n", policyID)
+
+	err = client.DeletePolicy(d.Id())
+	if err != nil {
+		log.Printf("[ERROR] Could not delete Incapsula trusted IPs policy: %d, %s\n", policyID, err)
+		return err
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] Deleted Incapsula trusted IPs policy: %d\n", policyID)
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package incapsula
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const accountTrustedIPsResourceType = "incapsula_account_trusted_ips"
+const accountTrustedIPsResourceName = "testacc-terraform-account-trusted-ips"
+const accountTrustedIPsResource = accountTrustedIPsResourceType + "." + accountTrustedIPsResourceName
+
+func TestAccIncapsulaAccountTrustedIPs_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaAccountTrustedIPsConfigBasic(`"1.2.3.4"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(accountTrustedIPsResource, "ips.#", "1"),
+					resource.TestCheckResourceAttrSet(accountTrustedIPsResource, "account_id"),
+				),
+			},
+			{
+				Config: testAccCheckIncapsulaAccountTrustedIPsConfigBasic(`"1.2.3.4", "10.0.0.0/24"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(accountTrustedIPsResource, "ips.#", "2"),
+				),
+			},
+			{
+				ResourceName:            accountTrustedIPsResource,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"apply_to_existing_sites"},
+			},
+		},
+	})
+}
+
+func testAccCheckIncapsulaAccountTrustedIPsConfigBasic(ips string) string {
+	return fmt.Sprintf(`
+	resource "%s" "%s" {
+		name                    = "Terraform trusted IPs"
+		ips                     = [%s]
+		apply_to_existing_sites = false
+	}`,
+		accountTrustedIPsResourceType, accountTrustedIPsResourceName, ips,
+	)
+}
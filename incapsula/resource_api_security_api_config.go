@@ -2,19 +2,21 @@ package incapsula
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	"strconv"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
 )
 
 func resourceApiSecurityApiConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceApiSecurityAPIConfigCreate,
+		Create: withSiteLock(resourceApiSecurityAPIConfigCreate),
 		Read:   resourceApiSecurityAPIConfigRead,
-		Update: resourceApiSecurityAPIConfigUpdate,
-		Delete: resourceApiSecurityAPIConfigDelete,
+		Update: withSiteLock(resourceApiSecurityAPIConfigUpdate),
+		Delete: withSiteLock(resourceApiSecurityAPIConfigDelete),
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				idSlice := strings.Split(d.Id(), "/")
@@ -41,6 +43,8 @@ func resourceApiSecurityApiConfig() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: validateSiteReference("site_id"),
+
 		Schema: map[string]*schema.Schema{
 			// Required Arguments
 			"site_id": {
@@ -121,14 +125,14 @@ func resourceApiSecurityApiConfig() *schema.Resource {
 }
 
 func resourceApiSecurityAPIConfigCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
-	payload := ApiSecurityApiConfigPostPayload{
+	payload := imperva.ApiSecurityApiConfigPostPayload{
 		ValidateHost:     false,
 		Description:      d.Get("description").(string),
 		ApiSpecification: d.Get("api_specification").(string),
 		BasePath:         d.Get("base_path").(string),
-		ViolationActions: ViolationActions{
+		ViolationActions: imperva.ViolationActions{
 			InvalidUrlViolationAction:        d.Get("invalid_url_violation_action").(string),
 			InvalidMethodViolationAction:     d.Get("invalid_method_violation_action").(string),
 			MissingParamViolationAction:      d.Get("missing_param_violation_action").(string),
@@ -154,13 +158,13 @@ func resourceApiSecurityAPIConfigCreate(d *schema.ResourceData, m interface{}) e
 }
 
 func resourceApiSecurityAPIConfigUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
-	payload := ApiSecurityApiConfigPostPayload{
+	payload := imperva.ApiSecurityApiConfigPostPayload{
 		ValidateHost:     false,
 		Description:      d.Get("description").(string),
 		ApiSpecification: d.Get("api_specification").(string),
-		ViolationActions: ViolationActions{
+		ViolationActions: imperva.ViolationActions{
 			InvalidUrlViolationAction:        d.Get("invalid_url_violation_action").(string),
 			InvalidMethodViolationAction:     d.Get("invalid_method_violation_action").(string),
 			MissingParamViolationAction:      d.Get("missing_param_violation_action").(string),
@@ -184,7 +188,7 @@ func resourceApiSecurityAPIConfigUpdate(d *schema.ResourceData, m interface{}) e
 }
 
 func resourceApiSecurityAPIConfigRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 	siteID := d.Get("site_id").(int)
 	apiID, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -222,7 +226,7 @@ func resourceApiSecurityAPIConfigRead(d *schema.ResourceData, m interface{}) err
 }
 
 func resourceApiSecurityAPIConfigDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 	siteID := d.Get("site_id").(int)
 	apiID, err := strconv.Atoi(d.Id())
 	if err != nil {
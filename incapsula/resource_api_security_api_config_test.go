@@ -2,12 +2,12 @@ package incapsula
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"log"
 	"strconv"
-
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 const apiSecApiConfigResourceName = "incapsula_api_security_api_config"
@@ -46,7 +46,7 @@ func TestAccIncapsulaApiSecurityApiConfig_Basic(t *testing.T) {
 }
 
 func testACCStateApiSecurityApiConfigDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*Client)
+	client := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != apiSecApiConfigResourceName {
@@ -115,7 +115,7 @@ func testCheckIncapsulaApiConfigExists() resource.TestCheckFunc {
 		}
 		apiIDInt, err := strconv.Atoi(apiID)
 
-		client := testAccProvider.Meta().(*Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 		_, err = client.GetApiSecurityApiConfig(siteIdInt, apiIDInt)
 		if err != nil {
 			return fmt.Errorf("Incapsula API Security API Config : %s (SiteId : %d, API Id %d) does not exist", apiSecApiConfigResource, siteIdInt, apiIDInt)
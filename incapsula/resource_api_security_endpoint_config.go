@@ -2,11 +2,13 @@ package incapsula
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	"strconv"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
 )
 
 func resourceApiSecurityEndpointConfig() *schema.Resource {
@@ -48,10 +50,11 @@ func resourceApiSecurityEndpointConfig() *schema.Resource {
 				ForceNew:    true,
 			},
 			"method": {
-				Description: "HTTP method that describes a specific endpoint. Possible values: POST, GET, PUT, PATCH, DELETE, HEAD, OPTIONS",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Description:  "HTTP method that describes a specific endpoint. Possible values: POST, GET, PUT, PATCH, DELETE, HEAD, OPTIONS",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateEnum(httpMethodValues),
 			},
 			"path": {
 				Description: "An URL path of specific endpoint ",
@@ -90,7 +93,7 @@ func resourceApiSecurityEndpointConfig() *schema.Resource {
 
 func resourceApiSecurityEndpointConfigRead(d *schema.ResourceData, m interface{}) error {
 	log.Printf("[INFO] Read Incapsula API-security endpoint configuration for ID: %s", d.Id())
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 	endpointGetResponse, err := client.GetApiSecurityEndpointConfig(d.Get("api_id").(int), d.Id())
 	if err != nil {
 		log.Printf("[ERROR] Could not get Incapsula API-security endpoint: %s - %s\n", d.Get("id"), err)
@@ -106,7 +109,7 @@ func resourceApiSecurityEndpointConfigRead(d *schema.ResourceData, m interface{}
 }
 
 func resourceApiSecurityEndpointConfigCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 	endpointGetAllResponse, _ := client.GetApiSecurityAllEndpointsConfig(d.Get("api_id").(int))
 	var found bool
 	var endpointId string
@@ -128,10 +131,10 @@ func resourceApiSecurityEndpointConfigCreate(d *schema.ResourceData, m interface
 }
 
 func resourceApiSecurityEndpointConfigUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
-	payload := ApiSecurityEndpointConfigPostPayload{
-		ViolationActions: UserViolationActions{
+	payload := imperva.ApiSecurityEndpointConfigPostPayload{
+		ViolationActions: imperva.UserViolationActions{
 			MissingParamViolationAction:      d.Get("missing_param_violation_action").(string),
 			InvalidParamNameViolationAction:  d.Get("invalid_param_name_violation_action").(string),
 			InvalidParamValueViolationAction: d.Get("invalid_param_value_violation_action").(string),
@@ -2,11 +2,12 @@ package incapsula
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"log"
 	"strconv"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 const apiSecEndpointConfigResourceName = "incapsula_api_security_endpoint_config"
@@ -83,7 +84,7 @@ func testCheckIncapsulaApiSecurityEndpointExists(name string) resource.TestCheck
 			return fmt.Errorf("failed to convert api security API ID is not numeric")
 		}
 
-		client := testAccProvider.Meta().(*Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 		endpointListResponse, err := client.GetApiSecurityEndpointConfig(apiIdInt, endpointId)
 		if err != nil {
 			return fmt.Errorf("Incapsula Api Security Endpoint doesn't exist")
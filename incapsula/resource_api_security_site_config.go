@@ -2,18 +2,20 @@ package incapsula
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
 )
 
 func resourceApiSecuritySiteConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceApiSecuritySiteConfigUpdate,
+		Create: withSiteLock(resourceApiSecuritySiteConfigUpdate),
 		Read:   resourceApiSecuritySiteConfigRead,
-		Update: resourceApiSecuritySiteConfigUpdate,
-		Delete: resourceApiSecuritySiteConfigDelete,
+		Update: withSiteLock(resourceApiSecuritySiteConfigUpdate),
+		Delete: withSiteLock(resourceApiSecuritySiteConfigDelete),
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				siteID, err := strconv.Atoi(d.Id())
@@ -27,6 +29,8 @@ func resourceApiSecuritySiteConfig() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: validateSiteReference("site_id"),
+
 		Schema: map[string]*schema.Schema{
 			"site_id": {
 				Description: "The Site ID of the the site the API security is configured on.",
@@ -98,12 +102,12 @@ func resourceApiSecuritySiteConfig() *schema.Resource {
 func resourceApiSecuritySiteConfigUpdate(d *schema.ResourceData, m interface{}) error {
 	log.Printf("[INFO] Update Incapsula API-security site configuration for site ID: %d", d.Get("site_id"))
 
-	client := m.(*Client)
-	payload := ApiSecuritySiteConfigPostPayload{
+	client := m.(*providerMeta).client
+	payload := imperva.ApiSecuritySiteConfigPostPayload{
 		ApiOnlySite:                               d.Get("is_api_only_site").(bool),
 		NonApiRequestViolationAction:              d.Get("non_api_request_violation_action").(string),
 		IsAutomaticDiscoveryApiIntegrationEnabled: d.Get("is_automatic_discovery_api_integration_enabled").(bool),
-		ViolationActions: ViolationActions{
+		ViolationActions: imperva.ViolationActions{
 			InvalidUrlViolationAction:        d.Get("invalid_url_violation_action").(string),
 			InvalidMethodViolationAction:     d.Get("invalid_method_violation_action").(string),
 			MissingParamViolationAction:      d.Get("missing_param_violation_action").(string),
@@ -129,7 +133,7 @@ func resourceApiSecuritySiteConfigUpdate(d *schema.ResourceData, m interface{})
 }
 
 func resourceApiSecuritySiteConfigRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 	siteId := d.Get("site_id")
 
 	apiSecuritySiteConfigGetResponse, err := client.ReadApiSecuritySiteConfig(siteId.(int))
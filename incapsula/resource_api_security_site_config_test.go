@@ -2,11 +2,12 @@ package incapsula
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"log"
 	"strconv"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 const apiSiteConfigResourceName = "incapsula_api_security_site_config"
@@ -54,7 +55,7 @@ func testCheckApiSecuritySiteConfigExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Error parsing ID %v to int", res.Primary.ID)
 		}
 
-		client := testAccProvider.Meta().(*Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 		_, err = client.ReadApiSecuritySiteConfig(siteId)
 		if err != nil {
 			fmt.Errorf("Incapsula Api Security Site Config doesn't exist")
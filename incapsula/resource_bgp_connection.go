@@ -0,0 +1,165 @@
+package incapsula
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+func resourceBGPConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBGPConnectionCreate,
+		Read:   resourceBGPConnectionRead,
+		Update: resourceBGPConnectionUpdate,
+		Delete: resourceBGPConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: infraProtectImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"name": {
+				Description: "The name of the BGP connection.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"peer_asn": {
+				Description:  "The autonomous system number of the customer's router.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 4294967295),
+			},
+			"peer_ip": {
+				Description:  "The IP address of the customer's router.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			// Optional Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account to operate on. If not specified, operation will be performed on the account identified by the authentication parameters.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"md5_password": {
+				Description:  "The MD5 password of the BGP session. The password is never returned by the API, so changes made outside of Terraform are not detected.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, 80),
+			},
+			"tunnel_id": {
+				Description: "The ID of the GRE or IPsec tunnel the BGP session runs over.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			// Computed Attributes
+			"session_state": {
+				Description: "The state of the BGP session, e.g. ESTABLISHED, ACTIVE or IDLE.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceBGPConnectionCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	connection, err := client.AddBGPConnection(accountID, bgpConnectionFromResourceData(d))
+	if err != nil {
+		log.Printf("[ERROR] Could not create Incapsula BGP connection %s for account %d: %s\n", d.Get("name"), accountID, err)
+		return err
+	}
+
+	d.SetId(connection.ID)
+	log.Printf("[INFO] Created Incapsula BGP connection %s for account %d\n", d.Id(), accountID)
+
+	return resourceBGPConnectionRead(d, m)
+}
+
+func resourceBGPConnectionRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	connection, statusCode, err := client.GetBGPConnection(accountID, d.Id())
+
+	// If the connection is deleted on the server, blow it out locally and run through the normal TF cycle
+	if statusCode == 404 {
+		log.Printf("[INFO] Incapsula BGP connection %s has already been deleted: %s\n", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula BGP connection %s: %s\n", d.Id(), err)
+		return err
+	}
+
+	// md5_password is write-only and is kept as configured
+	d.Set("name", connection.Name)
+	d.Set("peer_asn", connection.PeerASN)
+	d.Set("peer_ip", connection.PeerIP)
+	d.Set("tunnel_id", connection.TunnelID)
+	d.Set("session_state", connection.SessionState)
+
+	return nil
+}
+
+func resourceBGPConnectionUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	connection := bgpConnectionFromResourceData(d)
+	if d.HasChange("md5_password") {
+		// An empty password removes it
+		md5Password := d.Get("md5_password").(string)
+		connection.MD5Password = &md5Password
+	} else {
+		// Don't reset the password when other arguments change
+		connection.MD5Password = nil
+	}
+
+	_, err := client.UpdateBGPConnection(accountID, d.Id(), connection)
+	if err != nil {
+		log.Printf("[ERROR] Could not update Incapsula BGP connection %s: %s\n", d.Id(), err)
+		return err
+	}
+
+	return resourceBGPConnectionRead(d, m)
+}
+
+func resourceBGPConnectionDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+
+	err := client.DeleteBGPConnection(d.Get("account_id").(int), d.Id())
+	if err != nil {
+		log.Printf("[ERROR] Could not delete Incapsula BGP connection %s: %s\n", d.Id(), err)
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func bgpConnectionFromResourceData(d *schema.ResourceData) *imperva.BGPConnection {
+	connection := &imperva.BGPConnection{
+		Name:     d.Get("name").(string),
+		PeerASN:  d.Get("peer_asn").(int),
+		PeerIP:   d.Get("peer_ip").(string),
+		TunnelID: d.Get("tunnel_id").(string),
+	}
+	if md5Password := d.Get("md5_password").(string); md5Password != "" {
+		connection.MD5Password = &md5Password
+	}
+	return connection
+}
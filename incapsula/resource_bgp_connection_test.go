@@ -0,0 +1,95 @@
+package incapsula
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const bgpConnectionResourceType = "incapsula_bgp_connection"
+const bgpConnectionResourceName = "testacc-terraform-bgp-connection"
+const bgpConnectionResource = bgpConnectionResourceType + "." + bgpConnectionResourceName
+
+func TestAccIncapsulaBGPConnection_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIncapsulaBGPConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaBGPConnectionConfigBasic("testacc-terraform-bgp-connection"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaBGPConnectionExists(bgpConnectionResource),
+					resource.TestCheckResourceAttr(bgpConnectionResource, "peer_asn", "64512"),
+					resource.TestCheckResourceAttr(bgpConnectionResource, "peer_ip", "192.0.2.1"),
+					resource.TestCheckResourceAttrSet(bgpConnectionResource, "session_state"),
+				),
+			},
+			{
+				Config: testAccCheckIncapsulaBGPConnectionConfigBasic("testacc-terraform-bgp-connection-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaBGPConnectionExists(bgpConnectionResource),
+					resource.TestCheckResourceAttr(bgpConnectionResource, "name", "testacc-terraform-bgp-connection-updated"),
+				),
+			},
+			{
+				ResourceName:            bgpConnectionResource,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"md5_password", "session_state"},
+			},
+		},
+	})
+}
+
+func testCheckIncapsulaBGPConnectionExists(name string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		res, ok := state.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Incapsula BGP connection resource not found: %s", name)
+		}
+
+		if res.Primary.ID == "" {
+			return fmt.Errorf("Incapsula BGP connection ID does not exist")
+		}
+
+		client := testAccProvider.Meta().(*providerMeta).client
+		_, _, err := client.GetBGPConnection(0, res.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Incapsula BGP connection %s does not exist: %s", res.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIncapsulaBGPConnectionDestroy(state *terraform.State) error {
+	client := testAccProvider.Meta().(*providerMeta).client
+
+	for _, res := range state.RootModule().Resources {
+		if res.Type != bgpConnectionResourceType {
+			continue
+		}
+
+		_, statusCode, _ := client.GetBGPConnection(0, res.Primary.ID)
+		if statusCode != 404 {
+			return fmt.Errorf("Incapsula BGP connection %s still exists", res.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIncapsulaBGPConnectionConfigBasic(name string) string {
+	return fmt.Sprintf(`
+	resource "%s" "%s" {
+		name         = "%s"
+		peer_asn     = 64512
+		peer_ip      = "192.0.2.1"
+		md5_password = "testacc-password"
+	}`,
+		bgpConnectionResourceType, bgpConnectionResourceName, name,
+	)
+}
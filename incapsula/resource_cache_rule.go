@@ -4,16 +4,18 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
 )
 
 func resourceCacheRule() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceCacheRuleCreate,
+	return withStateUpgraders(&schema.Resource{
+		Create: withSiteLock(resourceCacheRuleCreate),
 		Read:   resourceCacheRuleRead,
-		Update: resourceCacheRuleUpdate,
-		Delete: resourceCacheRuleDelete,
+		Update: withSiteLock(resourceCacheRuleUpdate),
+		Delete: withSiteLock(resourceCacheRuleDelete),
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				idSlice := strings.Split(d.Id(), "/")
@@ -31,6 +33,14 @@ func resourceCacheRule() *schema.Resource {
 			},
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		CustomizeDiff: validateSiteReference("site_id"),
+
 		Schema: map[string]*schema.Schema{
 			// Required Arguments
 			"site_id": {
@@ -45,9 +55,10 @@ func resourceCacheRule() *schema.Resource {
 				Required:    true,
 			},
 			"action": {
-				Description: "Rule action. See the detailed descriptions in the API documentation. Possible values: `HTTP_CACHE_MAKE_STATIC`, `HTTP_CACHE_CLIENT_CACHE_CTL`, `HTTP_CACHE_FORCE_UNCACHEABLE`, `HTTP_CACHE_ADD_TAG`, `HTTP_CACHE_DIFFERENTIATE_SSL`, `HTTP_CACHE_DIFFERENTIATE_BY_HEADER`, `HTTP_CACHE_DIFFERENTIATE_BY_COOKIE`, `HTTP_CACHE_DIFFERENTIATE_BY_GEO`, `HTTP_CACHE_IGNORE_PARAMS`, `HTTP_CACHE_ENRICH_CACHE_KEY`, `HTTP_CACHE_FORCE_VALIDATION`, `HTTP_CACHE_IGNORE_AUTH_HEADER`.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:  "Rule action. See the detailed descriptions in the API documentation. Possible values: `HTTP_CACHE_MAKE_STATIC`, `HTTP_CACHE_CLIENT_CACHE_CTL`, `HTTP_CACHE_FORCE_UNCACHEABLE`, `HTTP_CACHE_ADD_TAG`, `HTTP_CACHE_DIFFERENTIATE_SSL`, `HTTP_CACHE_DIFFERENTIATE_BY_HEADER`, `HTTP_CACHE_DIFFERENTIATE_BY_COOKIE`, `HTTP_CACHE_DIFFERENTIATE_BY_GEO`, `HTTP_CACHE_IGNORE_PARAMS`, `HTTP_CACHE_ENRICH_CACHE_KEY`, `HTTP_CACHE_FORCE_VALIDATION`, `HTTP_CACHE_IGNORE_AUTH_HEADER`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateEnum(cacheRuleActionValues),
 			},
 			"filter": {
 				Description: "The filter defines the conditions that trigger the rule action, if left empty, the rule is always run.",
@@ -81,13 +92,13 @@ func resourceCacheRule() *schema.Resource {
 				Optional:    true,
 			},
 		},
-	}
+	})
 }
 
 func resourceCacheRuleCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
-	rule := CacheRule{
+	rule := imperva.CacheRule{
 		Name:                 d.Get("name").(string),
 		Action:               d.Get("action").(string),
 		Filter:               d.Get("filter").(string),
@@ -98,7 +109,13 @@ func resourceCacheRuleCreate(d *schema.ResourceData, m interface{}) error {
 		DifferentiateByValue: d.Get("differentiate_by_value").(string),
 	}
 
-	ruleWithID, err := client.AddCacheRule(d.Get("site_id").(string), &rule)
+	// The rule doesn't exist yet, there is nothing to re-read before retrying
+	var ruleWithID *imperva.CacheRuleWithID
+	err := retryOnConflict(d.Timeout(schema.TimeoutCreate), func() error {
+		var err error
+		ruleWithID, err = client.AddCacheRule(d.Get("site_id").(string), &rule)
+		return err
+	}, nil)
 
 	if err != nil {
 		return err
@@ -111,7 +128,7 @@ func resourceCacheRuleCreate(d *schema.ResourceData, m interface{}) error {
 
 func resourceCacheRuleRead(d *schema.ResourceData, m interface{}) error {
 	// Implement by reading the SiteResponse for the site
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	ruleID, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -144,9 +161,9 @@ func resourceCacheRuleRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceCacheRuleUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
-	rule := CacheRule{
+	rule := imperva.CacheRule{
 		Name:                 d.Get("name").(string),
 		Action:               d.Get("action").(string),
 		Filter:               d.Get("filter").(string),
@@ -162,7 +179,15 @@ func resourceCacheRuleUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	err = client.UpdateCacheRule(d.Get("site_id").(string), ruleID, &rule)
+	err = retryOnConflict(d.Timeout(schema.TimeoutUpdate), func() error {
+		return client.UpdateCacheRule(d.Get("site_id").(string), ruleID, &rule)
+	}, func() (bool, error) {
+		_, statusCode, err := client.ReadCacheRule(d.Get("site_id").(string), ruleID)
+		if statusCode == 404 {
+			return false, fmt.Errorf("Cache Rule %d for Site ID %s was deleted while updating it", ruleID, d.Get("site_id"))
+		}
+		return false, err
+	})
 
 	if err != nil {
 		return err
@@ -172,14 +197,23 @@ func resourceCacheRuleUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceCacheRuleDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	ruleID, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return err
 	}
 
-	err = client.DeleteCacheRule(d.Get("site_id").(string), ruleID)
+	err = retryOnConflict(d.Timeout(schema.TimeoutDelete), func() error {
+		return client.DeleteCacheRule(d.Get("site_id").(string), ruleID)
+	}, func() (bool, error) {
+		// The rule may have been deleted by the conflicting change
+		_, statusCode, err := client.ReadCacheRule(d.Get("site_id").(string), ruleID)
+		if statusCode == 404 {
+			return true, nil
+		}
+		return false, err
+	})
 	if err != nil {
 		return err
 	}
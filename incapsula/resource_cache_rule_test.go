@@ -55,7 +55,7 @@ func testAccStateCacheRuleID(s *terraform.State) (string, error) {
 }
 
 func testAccCheckIncapsulaCacheRuleDestroy(state *terraform.State) error {
-	client := testAccProvider.Meta().(*Client)
+	client := testAccProvider.Meta().(*providerMeta).client
 
 	for _, res := range state.RootModule().Resources {
 		if res.Type != "incapsula_cache_rule" {
@@ -101,7 +101,7 @@ func testCheckIncapsulaCacheRuleExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Incapsula Site ID does not exist for Cache Rule ID %d", ruleID)
 		}
 
-		client := testAccProvider.Meta().(*Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 		_, statusCode, err := client.ReadCacheRule(siteID, ruleID)
 		if statusCode != 200 {
 			return fmt.Errorf("Incapsula Cache Rule: %s (site id: %s) should have received 200 status code", name, siteID)
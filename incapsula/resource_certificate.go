@@ -3,23 +3,34 @@ package incapsula
 import (
 	"crypto/sha1"
 	"encoding/hex"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"fmt"
 	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
 )
 
 func resourceCertificate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCertificateCreate,
+		Create: withSiteLock(resourceCertificateCreate),
 		Read:   resourceCertificateRead,
-		Update: resourceCertificateUpdate,
-		Delete: resourceCertificateDelete,
+		Update: withSiteLock(resourceCertificateUpdate),
+		Delete: withSiteLock(resourceCertificateDelete),
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				if _, err := strconv.Atoi(d.Id()); err != nil {
+					return nil, fmt.Errorf("unexpected format of ID (%q), expected site_id", d.Id())
+				}
+
+				// There is only one custom certificate per site, the resource ID is a fixed value
+				d.Set("site_id", d.Id())
 				d.SetId("12345")
-				d.Set("site_id", d.Get("site_id").(string))
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		CustomizeDiff: validateSiteReference("site_id"),
+
 		Schema: map[string]*schema.Schema{
 			// Required Arguments
 			"site_id": {
@@ -46,6 +57,12 @@ func resourceCertificate() *schema.Resource {
 				Optional:    true,
 				Sensitive:   true,
 			},
+			// Computed Attributes
+			"account_id": {
+				Description: "Numeric identifier of the account of the site, the site is refreshed from the listing of its account.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
 			"input_hash": {
 				Description: "inputHash",
 				Type:        schema.TypeString,
@@ -63,7 +80,7 @@ func resourceCertificate() *schema.Resource {
 }
 
 func resourceCertificateCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 	inputHash := createHash(d)
 	_, err := client.AddCertificate(
 		d.Get("site_id").(string),
@@ -77,6 +94,8 @@ func resourceCertificateCreate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	invalidateCachedSite(client, d.Get("site_id").(string))
+
 	// TODO: Setting this to arbitrary value as there is only one cert for each site.
 	d.SetId("12345")
 
@@ -85,14 +104,15 @@ func resourceCertificateCreate(d *schema.ResourceData, m interface{}) error {
 
 func resourceCertificateRead(d *schema.ResourceData, m interface{}) error {
 	// Implement by reading the ListCertificatesResponse for the data center
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	siteID := d.Get("site_id").(string)
+	siteIDInt, _ := strconv.Atoi(siteID)
 
-	listCertificatesResponse, err := client.ListCertificates(siteID)
+	siteStatusResponse, err := client.CachedSiteStatus("custom-certificate", siteIDInt, d.Get("account_id").(int))
 
-	// List data centers response object may indicate that the Site ID has been deleted (9413)
-	if listCertificatesResponse != nil && listCertificatesResponse.Res == 9413 {
+	// Site status response object may indicate that the Site ID has been deleted (9413)
+	if siteStatusResponse != nil && siteStatusResponse.Res.(float64) == 9413 {
 		log.Printf("[INFO] Incapsula Site ID %s has already been deleted: %s\n", d.Get("site_id"), err)
 		d.SetId("")
 		return nil
@@ -103,14 +123,22 @@ func resourceCertificateRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	d.Set("input_hash", listCertificatesResponse.SSL.CustomCertificate.InputHash)
+	// The custom certificate may have been removed from the site
+	if !siteStatusResponse.Ssl.CustomCertificate.Active {
+		log.Printf("[INFO] Incapsula site ID %s has no active custom certificate\n", siteID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_id", siteStatusResponse.AccountID)
+	d.Set("input_hash", siteStatusResponse.Ssl.CustomCertificate.InputHash)
 	d.SetId("12345")
 
 	return nil
 }
 
 func resourceCertificateUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	inputHash := createHash(d)
 
@@ -126,12 +154,14 @@ func resourceCertificateUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	invalidateCachedSite(client, d.Get("site_id").(string))
+
 	d.SetId("12345")
 	return resourceCertificateRead(d, m)
 }
 
 func resourceCertificateDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	err := client.DeleteCertificate(d.Get("site_id").(string))
 
@@ -139,6 +169,8 @@ func resourceCertificateDelete(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	invalidateCachedSite(client, d.Get("site_id").(string))
+
 	// Set the ID to empty
 	// Implicitly clears the resource
 	d.SetId("")
@@ -162,3 +194,11 @@ func calculateHash(certificate, passphrase, privateKey string) string {
 	result := hex.EncodeToString(byteString)
 	return result
 }
+
+// invalidateCachedSite removes the site of a string site_id from the cache of the client after it was changed
+func invalidateCachedSite(client *imperva.Client, siteID string) {
+	id, err := strconv.Atoi(siteID)
+	if err == nil {
+		client.InvalidateCachedSite(id)
+	}
+}
@@ -1,22 +1,23 @@
 package incapsula
 
 import (
-	"testing"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-
+	"bytes"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	b64 "encoding/base64"
 	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"log"
 	"math/big"
+	"strconv"
+	"testing"
 	"time"
 
-	"bytes"
-	b64 "encoding/base64"
-	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 const certificateResourceName = "incapsula_custom_certificate"
@@ -25,6 +26,38 @@ const certificateResource = certificateResourceName + "." + certificateName
 
 var calculatedHash = ""
 
+func init() {
+	resource.AddTestSweepers("incapsula_custom_certificate", &resource.Sweeper{
+		Name: "incapsula_custom_certificate",
+		F:    testSweepCustomCertificates,
+	})
+}
+
+func testSweepCustomCertificates(region string) error {
+	client, err := sharedClientForSweepers()
+	if err != nil {
+		return fmt.Errorf("Error getting client: %s", err)
+	}
+
+	sites, err := listTestSitesForSweepers(client)
+	if err != nil {
+		return fmt.Errorf("Error listing sites: %s", err)
+	}
+
+	for _, site := range sites {
+		if !site.Ssl.CustomCertificate.Active {
+			continue
+		}
+		log.Printf("[INFO] Sweeping Incapsula custom certificate of site %s (site id: %d)", site.Domain, site.SiteID)
+		err = client.DeleteCertificate(strconv.Itoa(site.SiteID))
+		if err != nil {
+			return fmt.Errorf("Error deleting custom certificate of site %s (site id: %d): %s", site.Domain, site.SiteID, err)
+		}
+	}
+
+	return nil
+}
+
 func TestAccIncapsulaCustomCertificate_Basic(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -38,10 +71,29 @@ func TestAccIncapsulaCustomCertificate_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(certificateResource, "input_hash", calculatedHash),
 				),
 			},
+			{
+				ResourceName:            certificateResource,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccStateCustomCertificateID,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"certificate", "private_key", "passphrase"},
+			},
 		},
 	})
 }
 
+func testAccStateCustomCertificateID(s *terraform.State) (string, error) {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != certificateResourceName {
+			continue
+		}
+
+		return rs.Primary.Attributes["site_id"], nil
+	}
+
+	return "", fmt.Errorf("Error finding site_id of the custom certificate")
+}
+
 func testCheckIncapsulaCertificateExists(name string) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		res, ok := state.RootModule().Resources[certificateResource]
@@ -54,7 +106,7 @@ func testCheckIncapsulaCertificateExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Incapsula Custom Certificate Site ID %s does not exist", siteID)
 		}
 
-		client := testAccProvider.Meta().(*Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 		listCertificatesResponse, _ := client.ListCertificates(siteID)
 		if listCertificatesResponse == nil && listCertificatesResponse.Res == 9413 {
 			return fmt.Errorf("Incapsula Custom Certificate : %s (SiteId : %s) does not exist", certificateResource, siteID)
@@ -64,7 +116,7 @@ func testCheckIncapsulaCertificateExists(name string) resource.TestCheckFunc {
 }
 
 func testAccCheckIncapsulaCertificateDestroy(state *terraform.State) error {
-	client := testAccProvider.Meta().(*Client)
+	client := testAccProvider.Meta().(*providerMeta).client
 	for _, rs := range state.RootModule().Resources {
 		if rs.Type != certificateResourceName {
 			continue
@@ -97,7 +149,24 @@ depends_on = ["%s"]
 	return result
 }
 
+// vcrCertificateFile and vcrPrivateKeyFile are the key pair uploaded when recording or replaying fixtures, a generated
+// one would make the requests differ from the recorded ones
+const vcrCertificateFile = "testdata/vcr_certificate.pem"
+const vcrPrivateKeyFile = "testdata/vcr_private_key.pem"
+
 func generateKeyPair() (string, string) {
+	if testAccVCR != nil {
+		certificatePEM, err := ioutil.ReadFile(vcrCertificateFile)
+		if err != nil {
+			log.Fatalf("Could not read %s: %s", vcrCertificateFile, err)
+		}
+		privateKeyPEM, err := ioutil.ReadFile(vcrPrivateKeyFile)
+		if err != nil {
+			log.Fatalf("Could not read %s: %s", vcrPrivateKeyFile, err)
+		}
+		return encodeKeyPair(certificatePEM, privateKeyPEM)
+	}
+
 	template := getCertificateTemplate()
 
 	// generate private key
@@ -127,8 +196,13 @@ func generateKeyPair() (string, string) {
 	out := &bytes.Buffer{}
 	pem.Encode(out, &pem.Block{Type: "CERTIFICATE", Bytes: certificate})
 
+	return encodeKeyPair(out.Bytes(), privateKeyPEM)
+}
+
+// encodeKeyPair returns the PEM-encoded certificate and private key as base64 heredocs and saves their hash
+func encodeKeyPair(certificatePEM, privateKeyPEM []byte) (string, string) {
 	// encode PEM-encoded certificate with base64 algorith
-	certificateBase64 := b64.StdEncoding.EncodeToString([]byte(out.String()))
+	certificateBase64 := b64.StdEncoding.EncodeToString(certificatePEM)
 	// encode PEM-encoded certificate with base64 algorith
 	privateKeyBase64 := b64.StdEncoding.EncodeToString(privateKeyPEM)
 
@@ -2,11 +2,13 @@ package incapsula
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	"strconv"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
 )
 
 const (
@@ -17,10 +19,10 @@ const (
 
 func resourceCSPSiteConfiguration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCSPSiteConfigurationUpdate,
+		Create: withSiteLock(resourceCSPSiteConfigurationUpdate),
 		Read:   resourceCSPSiteConfigurationRead,
-		Update: resourceCSPSiteConfigurationUpdate,
-		Delete: resourceCSPSiteConfigurationDelete,
+		Update: withSiteLock(resourceCSPSiteConfigurationUpdate),
+		Delete: withSiteLock(resourceCSPSiteConfigurationDelete),
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				keyParts := strings.Split(d.Id(), "/")
@@ -44,6 +46,8 @@ func resourceCSPSiteConfiguration() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: validateSiteReference("site_id"),
+
 		Schema: map[string]*schema.Schema{
 			// Required Arguments
 			"account_id": {
@@ -79,7 +83,7 @@ func resourceCSPSiteConfiguration() *schema.Resource {
 }
 
 func resourceCSPSiteConfigurationRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 	siteID := d.Get("site_id").(int)
 	accountID := d.Get("account_id").(int)
 
@@ -99,11 +103,11 @@ func resourceCSPSiteConfigurationRead(d *schema.ResourceData, m interface{}) err
 	d.Set("email_addresses", emails)
 
 	switch {
-	case strings.Compare(cspSite.Discovery, CSPDiscoveryOff) == 0:
+	case strings.Compare(cspSite.Discovery, imperva.CSPDiscoveryOff) == 0:
 		d.Set("mode", cspSiteModeOff)
-	case strings.Compare(cspSite.Discovery, CSPDiscoveryOn) == 0 && strings.Compare(cspSite.Mode, cspSiteModeMonitor) == 0:
+	case strings.Compare(cspSite.Discovery, imperva.CSPDiscoveryOn) == 0 && strings.Compare(cspSite.Mode, cspSiteModeMonitor) == 0:
 		d.Set("mode", cspSiteModeMonitor)
-	case strings.Compare(cspSite.Discovery, CSPDiscoveryOn) == 0 && strings.Compare(cspSite.Mode, cspSiteModeEnforce) == 0:
+	case strings.Compare(cspSite.Discovery, imperva.CSPDiscoveryOn) == 0 && strings.Compare(cspSite.Mode, cspSiteModeEnforce) == 0:
 		d.Set("mode", cspSiteModeEnforce)
 	default:
 		d.Set("mode", cspSiteModeOff)
@@ -113,35 +117,35 @@ func resourceCSPSiteConfigurationRead(d *schema.ResourceData, m interface{}) err
 }
 
 func resourceCSPSiteConfigurationUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 	emails := d.Get("email_addresses").(*schema.Set)
 	siteID := d.Get("site_id").(int)
 	accountID := d.Get("account_id").(int)
 
-	cspSiteConfig := CSPSiteConfig{
+	cspSiteConfig := imperva.CSPSiteConfig{
 		Name:      "",
 		Mode:      "",
 		Discovery: "",
 		Settings: struct {
-			Emails []CSPSiteConfigEmail `json:"emails"`
+			Emails []imperva.CSPSiteConfigEmail `json:"emails"`
 		}{},
 		TrackingIDs: nil,
 	}
 
-	cspSiteConfig.Settings.Emails = []CSPSiteConfigEmail{}
+	cspSiteConfig.Settings.Emails = []imperva.CSPSiteConfigEmail{}
 	for _, email := range emails.List() {
-		cspSiteConfig.Settings.Emails = append(cspSiteConfig.Settings.Emails, CSPSiteConfigEmail{Email: email.(string)})
+		cspSiteConfig.Settings.Emails = append(cspSiteConfig.Settings.Emails, imperva.CSPSiteConfigEmail{Email: email.(string)})
 	}
 
 	switch d.Get("mode").(string) {
 	case cspSiteModeOff:
-		cspSiteConfig.Discovery = CSPDiscoveryOff
+		cspSiteConfig.Discovery = imperva.CSPDiscoveryOff
 		cspSiteConfig.Mode = cspSiteModeMonitor
 	case cspSiteModeMonitor:
-		cspSiteConfig.Discovery = CSPDiscoveryOn
+		cspSiteConfig.Discovery = imperva.CSPDiscoveryOn
 		cspSiteConfig.Mode = cspSiteModeMonitor
 	case cspSiteModeEnforce:
-		cspSiteConfig.Discovery = CSPDiscoveryOn
+		cspSiteConfig.Discovery = imperva.CSPDiscoveryOn
 		cspSiteConfig.Mode = cspSiteModeEnforce
 	}
 
@@ -2,12 +2,14 @@ package incapsula
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"log"
 	"strconv"
 	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
 )
 
 const cspSiteConfigResourceType = "incapsula_csp_site_configuration"
@@ -61,12 +63,12 @@ func testCheckCSPSiteConfigExists(name string) resource.TestCheckFunc {
 			fmt.Errorf("failed to convert account ID from import command, actual value: %s, expected numeric ID", res.Primary.ID)
 		}
 
-		client := testAccProvider.Meta().(*Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 		cspSite, err := client.GetCSPSite(accountID, siteID)
 		if err != nil {
 			return fmt.Errorf("Incapsula CSP Site Config doesn't exist for site ID %d", siteID)
 		}
-		if cspSite == nil || cspSite.Discovery != CSPDiscoveryOn {
+		if cspSite == nil || cspSite.Discovery != imperva.CSPDiscoveryOn {
 			return fmt.Errorf("Incapsula CSP Site Config isn't on for site ID %d", siteID)
 		}
 
@@ -110,7 +112,7 @@ func testACCStateCSPSiteConfigID(s *terraform.State) (string, error) {
 }
 
 func testACCStateCSPSiteConfigDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*Client)
+	client := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != cspSiteConfigResourceType {
@@ -133,7 +135,7 @@ func testACCStateCSPSiteConfigDestroy(s *terraform.State) error {
 
 		cspSite, err := client.GetCSPSite(accountIDInt, siteIDInt)
 		fmt.Sprintf("Got CSP site config for site ID %d: %v", siteIDInt, cspSite)
-		if err != nil && cspSite != nil && cspSite.Discovery != CSPDiscoveryOff {
+		if err != nil && cspSite != nil && cspSite.Discovery != imperva.CSPDiscoveryOff {
 			return fmt.Errorf("Resource %s for CSP site configuration: Api Id %s, site ID %d still exists", cspSiteConfigResourceType, rs.Primary.ID, siteIDInt)
 		}
 		return nil
@@ -3,11 +3,13 @@ package incapsula
 import (
 	"encoding/base64"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	"strconv"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
 )
 
 const (
@@ -17,10 +19,10 @@ const (
 
 func resourceCSPSiteDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceCSPSiteDomainUpdate,
+		Create: withSiteLock(resourceCSPSiteDomainUpdate),
 		Read:   resourceCSPSiteDomainRead,
-		Update: resourceCSPSiteDomainUpdate,
-		Delete: resourceCSPSiteDomainDelete,
+		Update: withSiteLock(resourceCSPSiteDomainUpdate),
+		Delete: withSiteLock(resourceCSPSiteDomainDelete),
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				keyParts := strings.Split(d.Id(), "/")
@@ -48,6 +50,8 @@ func resourceCSPSiteDomain() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: validateSiteReference("site_id"),
+
 		Schema: map[string]*schema.Schema{
 			// Required Arguments
 			"account_id": {
@@ -96,7 +100,7 @@ func resourceCSPSiteDomain() *schema.Resource {
 }
 
 func resourceCSPSiteDomainRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 	accountID := d.Get("account_id").(int)
 	siteID := d.Get("site_id").(int)
 	domain := d.Get("domain").(string)
@@ -104,7 +108,7 @@ func resourceCSPSiteDomainRead(d *schema.ResourceData, m interface{}) error {
 
 	log.Printf("[DEBUG] Reading CSP domain for site ID: %d , domain reference: %s , domain: %s", siteID, domainRef, domain)
 
-	cspNotes, err := client.getCSPDomainNotes(accountID, siteID, domain)
+	cspNotes, err := client.GetCSPDomainNotes(accountID, siteID, domain)
 	if err != nil {
 		log.Printf("[ERROR] Could not get CSP domain notes: %s - %s\n", d.Id(), err)
 	} else {
@@ -120,7 +124,7 @@ func resourceCSPSiteDomainRead(d *schema.ResourceData, m interface{}) error {
 	}
 
 	// First check if it's a pre-approved domain, and update resource according to that
-	preApprovedDomain, err := client.getCSPPreApprovedDomain(accountID, siteID, domain)
+	preApprovedDomain, err := client.GetCSPPreApprovedDomain(accountID, siteID, domain)
 	if err != nil {
 		log.Printf("[ERROR] Could not get CSP pre-approved domain : %s - %s\n", d.Id(), err)
 	} else {
@@ -133,7 +137,7 @@ func resourceCSPSiteDomainRead(d *schema.ResourceData, m interface{}) error {
 	}
 
 	// If domain wasn't found as pre-approved domain, check if status set directly and update accordingly
-	status, err := client.getCSPDomainStatus(accountID, siteID, domain)
+	status, err := client.GetCSPDomainStatus(accountID, siteID, domain)
 	if err != nil {
 		log.Printf("[ERROR] Could not get CSP domain status: %s - %s\n", d.Id(), err)
 	} else if status.Blocked != nil {
@@ -156,7 +160,7 @@ func resourceCSPSiteDomainRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceCSPSiteDomainUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 	accountID := d.Get("account_id").(int)
 	siteID := d.Get("site_id").(int)
 	domain := d.Get("domain").(string)
@@ -168,13 +172,13 @@ func resourceCSPSiteDomainUpdate(d *schema.ResourceData, m interface{}) error {
 
 	if strings.Compare(status, cspDomainStatusAllowed) == 0 {
 		// If the domain is allowed just put it in the pre-approved list
-		dom := CSPPreApprovedDomain{
+		dom := imperva.CSPPreApprovedDomain{
 			Domain:      domain,
 			Subdomains:  d.Get("include_subdomains").(bool),
 			ReferenceID: base64.RawURLEncoding.EncodeToString([]byte(domain)),
 		}
 		log.Printf("[DEBUG] Updating CSP domain for site ID: %d , domain: %v\n", siteID, dom)
-		updatedDom, err := client.updateCSPPreApprovedDomain(accountID, siteID, &dom)
+		updatedDom, err := client.UpdateCSPPreApprovedDomain(accountID, siteID, &dom)
 		if err != nil {
 			log.Printf("[ERROR] Could not update CSP pre-approved domain: %v - %s\n", dom, err)
 			return err
@@ -182,14 +186,14 @@ func resourceCSPSiteDomainUpdate(d *schema.ResourceData, m interface{}) error {
 		log.Printf("[DEBUG] Updating CSP domain %v for site ID: %d , got response: %v.", dom, siteID, updatedDom)
 	} else if strings.Compare(status, cspDomainStatusBlocked) == 0 {
 		// Otherwise update the status directly to blocked
-		st := CSPDomainStatus{
+		st := imperva.CSPDomainStatus{
 			Blocked:  new(bool),
 			Reviewed: new(bool),
 		}
 		*(st.Blocked) = true
 		*(st.Reviewed) = true
 
-		domainStatus, err := client.updateCSPDomainStatus(accountID, siteID, domain, &st)
+		domainStatus, err := client.UpdateCSPDomainStatus(accountID, siteID, domain, &st)
 		if err != nil || domainStatus.Blocked == nil || domainStatus.Reviewed == nil {
 			e := fmt.Errorf("[ERROR] Could not update CSP domain %s status: %v - %s\n", domain, status, err)
 			return e
@@ -197,9 +201,9 @@ func resourceCSPSiteDomainUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	// Remove all existing notes and add them freshly
-	client.deleteCSPDomainNotes(accountID, siteID, domain)
+	client.DeleteCSPDomainNotes(accountID, siteID, domain)
 	for _, note := range notes.List() {
-		client.addCSPDomainNote(accountID, siteID, domain, note.(string))
+		client.AddCSPDomainNote(accountID, siteID, domain, note.(string))
 	}
 
 	newID := fmt.Sprintf("%d/%d/%s", accountID, siteID, domRef)
@@ -210,7 +214,7 @@ func resourceCSPSiteDomainUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceCSPSiteDomainDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 	accountID := d.Get("account_id").(int)
 	siteID := d.Get("site_id").(int)
 	domain := d.Get("domain").(string)
@@ -218,19 +222,19 @@ func resourceCSPSiteDomainDelete(d *schema.ResourceData, m interface{}) error {
 	log.Printf("[DEBUG] Deleting CSP domain %s from site ID %d\n", domain, siteID)
 
 	if strings.Compare(status, cspDomainStatusAllowed) == 0 {
-		err := client.deleteCSPPreApprovedDomains(accountID, siteID, base64.RawURLEncoding.EncodeToString([]byte(domain)))
+		err := client.DeleteCSPPreApprovedDomains(accountID, siteID, base64.RawURLEncoding.EncodeToString([]byte(domain)))
 		if err != nil {
 			log.Printf("[ERROR] Could not delete CSP pre-approved domain %s for site ID %d: %s\n", domain, siteID, err)
 			return err
 		}
 	} else if strings.Compare(status, cspDomainStatusBlocked) == 0 {
-		newStatus := CSPDomainStatus{
+		newStatus := imperva.CSPDomainStatus{
 			Blocked:  new(bool),
 			Reviewed: new(bool),
 		}
 		*newStatus.Blocked = false
 		*newStatus.Reviewed = false
-		ret, err := client.updateCSPDomainStatus(accountID, siteID, domain, &newStatus)
+		ret, err := client.UpdateCSPDomainStatus(accountID, siteID, domain, &newStatus)
 		if err != nil {
 			log.Printf("[ERROR] Could not delete CSP domain status %s for site ID %d: %s\n", domain, siteID, err)
 			return err
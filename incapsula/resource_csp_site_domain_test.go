@@ -3,12 +3,13 @@ package incapsula
 import (
 	"encoding/base64"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"log"
 	"strconv"
 	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 const cspDomainResourceName = "incapsula_csp_site_domain"
@@ -59,8 +60,8 @@ func testCheckCSPDomainExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Error parsing ID %v to int", res.Primary.Attributes["account_id"])
 		}
 
-		client := testAccProvider.Meta().(*Client)
-		cspDomain, err := client.getCSPPreApprovedDomain(accountID, siteID, res.Primary.Attributes["domain"])
+		client := testAccProvider.Meta().(*providerMeta).client
+		cspDomain, err := client.GetCSPPreApprovedDomain(accountID, siteID, res.Primary.Attributes["domain"])
 		if err != nil || cspDomain == nil {
 			return fmt.Errorf("Incapsula CSP domain %s doesn't exist for site ID %d", res.Primary.Attributes["domain"], siteID)
 		}
@@ -106,7 +107,7 @@ func testACCStateCSPDomainID(s *terraform.State) (string, error) {
 }
 
 func testACCStateCSPDomainDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*Client)
+	client := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != cspDomainResourceName {
@@ -132,7 +133,7 @@ func testACCStateCSPDomainDestroy(s *terraform.State) error {
 			return fmt.Errorf("Parameter domain was not found in resource %s", cspDomainResourceName)
 		}
 
-		cspDomain, err := client.getCSPPreApprovedDomain(accountIDInt, siteIDInt, domain)
+		cspDomain, err := client.GetCSPPreApprovedDomain(accountIDInt, siteIDInt, domain)
 
 		fmt.Sprintf("Got CSP domain for site ID %d: %v", siteIDInt, cspDomain)
 		if err != nil && cspDomain != nil {
@@ -8,15 +8,16 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
 )
 
 func resourceDataCenter() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This resource is deprecated. It will be removed in a future version. Please use resource incapsula_data_centers_configuration instead.",
-		Create:             resourceDataCenterCreate,
+		Create:             withSiteLock(resourceDataCenterCreate),
 		Read:               resourceDataCenterRead,
-		Update:             resourceDataCenterUpdate,
-		Delete:             resourceDataCenterDelete,
+		Update:             withSiteLock(resourceDataCenterUpdate),
+		Delete:             withSiteLock(resourceDataCenterDelete),
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				idSlice := strings.Split(d.Id(), "/")
@@ -33,6 +34,8 @@ func resourceDataCenter() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: validateSiteReference("site_id"),
+
 		Schema: map[string]*schema.Schema{
 			// Required Arguments
 			"site_id": {
@@ -77,9 +80,9 @@ func resourceDataCenter() *schema.Resource {
 }
 
 func resourceDataCenterCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
-	var dataCenterAddResponse *DataCenterAddResponse
+	var dataCenterAddResponse *imperva.DataCenterAddResponse
 	var err error
 
 	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
@@ -110,7 +113,7 @@ func resourceDataCenterCreate(d *schema.ResourceData, m interface{}) error {
 
 func resourceDataCenterRead(d *schema.ResourceData, m interface{}) error {
 	// Implement by reading the ListDataCentersResponse for the data center
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	listDataCentersResponse, err := client.ListDataCenters(d.Get("site_id").(string))
 
@@ -159,7 +162,7 @@ func resourceDataCenterRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceDataCenterUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	return resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		_, err := client.EditDataCenter(
@@ -178,7 +181,7 @@ func resourceDataCenterUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceDataCenterDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		err := client.DeleteDataCenter(d.Id())
@@ -11,10 +11,10 @@ import (
 func resourceDataCenterServer() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This resource is deprecated. It will be removed in a future version. Please use resource incapsula_data_centers_configuration instead.",
-		Create:             resourceDataCenterServerCreate,
+		Create:             withSiteLock(resourceDataCenterServerCreate),
 		Read:               resourceDataCenterServerRead,
-		Update:             resourceDataCenterServerUpdate,
-		Delete:             resourceDataCenterServerDelete,
+		Update:             withSiteLock(resourceDataCenterServerUpdate),
+		Delete:             withSiteLock(resourceDataCenterServerDelete),
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				idSlice := strings.Split(d.Id(), "/")
@@ -33,6 +33,8 @@ func resourceDataCenterServer() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: validateSiteReference("site_id"),
+
 		Schema: map[string]*schema.Schema{
 			// Required Arguments
 			"dc_id": {
@@ -71,7 +73,7 @@ func resourceDataCenterServer() *schema.Resource {
 }
 
 func resourceDataCenterServerCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	dataCenterServerAddResponse, err := client.AddDataCenterServer(
 		d.Get("dc_id").(string),
@@ -92,7 +94,7 @@ func resourceDataCenterServerCreate(d *schema.ResourceData, m interface{}) error
 
 func resourceDataCenterServerRead(d *schema.ResourceData, m interface{}) error {
 	// Implement by reading the ListDataCentersResponse for the data centers
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	listDataCentersResponse, err := client.ListDataCenters(d.Get("site_id").(string))
 
@@ -148,7 +150,7 @@ func resourceDataCenterServerRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceDataCenterServerUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	_, err := client.EditDataCenterServer(
 		d.Id(),
@@ -165,7 +167,7 @@ func resourceDataCenterServerUpdate(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceDataCenterServerDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 	serverID := d.Id()
 	err := client.DeleteDataCenterServer(serverID)
 
@@ -51,7 +51,7 @@ func testAccStateDCID(s *terraform.State) (string, error) {
 }
 
 func testAccCheckIncapsulaDataCenterServerDestroy(state *terraform.State) error {
-	client := testAccProvider.Meta().(*Client)
+	client := testAccProvider.Meta().(*providerMeta).client
 
 	for _, res := range state.RootModule().Resources {
 		if res.Type != "incapsula_site" {
@@ -110,7 +110,7 @@ func testCheckIncapsulaDataCenterServerExists(name string) resource.TestCheckFun
 			return fmt.Errorf("Incapsula data center ID does not exist")
 		}
 
-		client := testAccProvider.Meta().(*Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 		dataCenterListResponse, err := client.ListDataCenters(siteID)
 		if dataCenterListResponse == nil {
 			return fmt.Errorf("Incapsula data center: %s (site id: %s) does not exist\n%s", name, siteID, err)
@@ -56,7 +56,7 @@ func testAccStateDcID(s *terraform.State) (string, error) {
 }
 
 func testAccCheckIncapsulaDataCenterDestroy(state *terraform.State) error {
-	client := testAccProvider.Meta().(*Client)
+	client := testAccProvider.Meta().(*providerMeta).client
 
 	for _, res := range state.RootModule().Resources {
 		if res.Type != "incapsula_site" {
@@ -122,7 +122,7 @@ func testCheckIncapsulaDataCenterExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Incapsula data center ID does not exist")
 		}
 
-		client := testAccProvider.Meta().(*Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 
 		// If the site has already been deleted then return nil
 		// Otherwise check the data center list
@@ -4,19 +4,23 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"hash/crc32"
 	"log"
 	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
 )
 
 func resourceDataCentersConfiguration() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceDataCentersConfigurationCreate,
+	return withStateUpgraders(&schema.Resource{
+		Create: withSiteLock(resourceDataCentersConfigurationCreate),
 		Read:   resourceDataCentersConfigurationRead,
-		Update: resourceDataCentersConfigurationCreate,
-		Delete: resourceDataCentersConfigurationDelete,
+		Update: withSiteLock(resourceDataCentersConfigurationCreate),
+		Delete: withSiteLock(resourceDataCentersConfigurationDelete),
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				d.Set("site_id", d.Id())
@@ -24,6 +28,8 @@ func resourceDataCentersConfiguration() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: validateSiteReference("site_id"),
+
 		Schema: map[string]*schema.Schema{
 			// Required Arguments
 			"site_id": {
@@ -223,7 +229,12 @@ func resourceDataCentersConfiguration() *schema.Resource {
 				},
 			},
 		},
-	}
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+	})
 }
 
 func isValidEnum(val string, key string, allowedValues []string) bool {
@@ -236,13 +247,13 @@ func isValidEnum(val string, key string, allowedValues []string) bool {
 	return false
 }
 
-func populateFromConfOriginServers(dc map[string]interface{}) []OriginServerStruct {
+func populateFromConfOriginServers(dc map[string]interface{}) []imperva.OriginServerStruct {
 	originServerConf := dc["origin_server"].(*schema.Set)
-	var originServerStructs = make([]OriginServerStruct, len(originServerConf.List()))
+	var originServerStructs = make([]imperva.OriginServerStruct, len(originServerConf.List()))
 	var osInd int = 0
 	for _, originServer := range originServerConf.List() {
 		os := originServer.(map[string]interface{})
-		originServerStructs[osInd] = OriginServerStruct{}
+		originServerStructs[osInd] = imperva.OriginServerStruct{}
 		if attr, ok := os["address"]; ok && attr != "" {
 			originServerStructs[osInd].Address = attr.(string)
 		}
@@ -267,13 +278,13 @@ func populateFromConfOriginServers(dc map[string]interface{}) []OriginServerStru
 	return originServerStructs
 }
 
-func populateFromConfDataCenters(d *schema.ResourceData) []DataCenterStruct {
+func populateFromConfDataCenters(d *schema.ResourceData) []imperva.DataCenterStruct {
 	dataCentersConf := d.Get("data_center").(*schema.Set)
-	var dataCentersStructs = make([]DataCenterStruct, len(dataCentersConf.List()))
+	var dataCentersStructs = make([]imperva.DataCenterStruct, len(dataCentersConf.List()))
 	var dcInd int = 0
 	for _, dataCenter := range dataCentersConf.List() {
 		dc := dataCenter.(map[string]interface{})
-		dataCentersStructs[dcInd] = DataCenterStruct{}
+		dataCentersStructs[dcInd] = imperva.DataCenterStruct{}
 		if attr, ok := dc["name"]; ok && attr != "" {
 			dataCentersStructs[dcInd].Name = attr.(string)
 		}
@@ -322,9 +333,9 @@ func populateFromConfDataCenters(d *schema.ResourceData) []DataCenterStruct {
 	return dataCentersStructs
 }
 
-func populateFromConfDataCentersConfigurationDTO(d *schema.ResourceData) DataCentersConfigurationDTO {
-	requestDTO := DataCentersConfigurationDTO{}
-	requestDTO.Data = make([]DataCentersStruct, 1)
+func populateFromConfDataCentersConfigurationDTO(d *schema.ResourceData) imperva.DataCentersConfigurationDTO {
+	requestDTO := imperva.DataCentersConfigurationDTO{}
+	requestDTO.Data = make([]imperva.DataCentersStruct, 1)
 	requestDTO.Data[0].DataCenterMode = d.Get("site_topology").(string)
 	requestDTO.Data[0].FailOverRequiredMonitors = d.Get("fail_over_required_monitors").(string)
 	requestDTO.Data[0].IsPersistent = d.Get("is_persistent").(bool)
@@ -339,18 +350,43 @@ func populateFromConfDataCentersConfigurationDTO(d *schema.ResourceData) DataCen
 }
 
 func resourceDataCentersConfigurationCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
-	requestDTO := populateFromConfDataCentersConfigurationDTO(d)
-	responseDTO, err := client.PutDataCentersConfiguration(d.Get("site_id").(string), requestDTO)
-	if err != nil {
-		return fmt.Errorf("Error updating Data Centers configuration for site (%s): %s",
-			d.Get("site_id"), err)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
 	}
 
-	if responseDTO.Errors != nil && len(responseDTO.Errors) > 0 {
-		return fmt.Errorf("Error updating Data Centers configuration for site (%s): %s",
-			d.Get("site_id"), responseDTO.Errors)
+	requestDTO := populateFromConfDataCentersConfigurationDTO(d)
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		responseDTO, err := client.PutDataCentersConfiguration(d.Get("site_id").(string), requestDTO)
+		if err != nil {
+			// Only the requests which didn't reach the API are retried, the others failed for good
+			transient := imperva.IsTransientError(err)
+			err = fmt.Errorf("Error updating Data Centers configuration for site (%s): %s", d.Get("site_id"), err)
+			if transient {
+				log.Printf("[INFO] Retrying to update Data Centers configuration for site (%s): %s\n", d.Get("site_id"), err)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		if responseDTO.Errors != nil && len(responseDTO.Errors) > 0 {
+			err = fmt.Errorf("Error updating Data Centers configuration for site (%s): %s",
+				d.Get("site_id"), responseDTO.Errors)
+
+			// The site may still be busy applying a previous configuration
+			if isRetryableDataCentersConfigurationError(responseDTO.Errors[0]) {
+				log.Printf("[INFO] Retrying to update Data Centers configuration for site (%s): %s\n", d.Get("site_id"), responseDTO.Errors)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	// Set the dc ID
@@ -359,9 +395,13 @@ func resourceDataCentersConfigurationCreate(d *schema.ResourceData, m interface{
 	return resourceDataCentersConfigurationRead(d, m)
 }
 
+func isRetryableDataCentersConfigurationError(apiError imperva.ApiError) bool {
+	return apiError.Status == "409" || apiError.Status == "429" || strings.HasPrefix(apiError.Status, "5")
+}
+
 func resourceDataCentersConfigurationRead(d *schema.ResourceData, m interface{}) error {
 	// Implement by reading the ListDataCentersResponse for the data center
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	responseDTO, err := client.GetDataCentersConfiguration(d.Get("site_id").(string))
 	if err != nil {
@@ -442,7 +482,7 @@ func resourceDataCentersConfigurationRead(d *schema.ResourceData, m interface{})
 }
 
 func resourceDataCentersConfigurationDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	responseDTO, err := client.GetDataCentersConfiguration(d.Get("site_id").(string))
 	if err != nil {
@@ -56,7 +56,7 @@ func testAccStateDataCentersConfigurationID(s *terraform.State) (string, error)
 }
 
 func testAccCheckIncapsulaDataCentersConfigurationDestroy(state *terraform.State) error {
-	client := testAccProvider.Meta().(*Client)
+	client := testAccProvider.Meta().(*providerMeta).client
 
 	for _, res := range state.RootModule().Resources {
 		if res.Type != "incapsula_site" {
@@ -120,7 +120,7 @@ func testCheckIncapsulaDataCentersConfigurationExists(name string) resource.Test
 			return fmt.Errorf("Incapsula data centers configuration ID does not exist")
 		}
 
-		client := testAccProvider.Meta().(*Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 
 		// If the site has already been deleted then return nil
 		// Otherwise check the data center list
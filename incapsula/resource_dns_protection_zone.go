@@ -0,0 +1,148 @@
+package incapsula
+
+import (
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+func resourceDNSProtectionZone() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDNSProtectionZoneCreate,
+		Read:   resourceDNSProtectionZoneRead,
+		Update: resourceDNSProtectionZoneUpdate,
+		Delete: resourceDNSProtectionZoneDelete,
+		Importer: &schema.ResourceImporter{
+			State: infraProtectImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"zone_name": {
+				Description:  "The name of the DNS zone to protect, e.g. example.com.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				StateFunc: func(v interface{}) string {
+					return strings.TrimSuffix(strings.ToLower(v.(string)), ".")
+				},
+			},
+			"origin_name_servers": {
+				Description: "The IP addresses of the origin name servers which hold the zone.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPAddress,
+				},
+			},
+
+			// Optional Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account to operate on. If not specified, operation will be performed on the account identified by the authentication parameters.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+
+			// Computed Attributes
+			"name_servers": {
+				Description: "The Imperva name servers assigned to the zone. Delegate the zone to them at the registrar.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Description: "The status of the zone, e.g. PENDING_DELEGATION or ACTIVE.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceDNSProtectionZoneCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	zone, err := client.AddDNSProtectionZone(accountID, dnsProtectionZoneFromResourceData(d))
+	if err != nil {
+		log.Printf("[ERROR] Could not create Incapsula DNS protection zone %s for account %d: %s\n", d.Get("zone_name"), accountID, err)
+		return err
+	}
+
+	d.SetId(zone.ID)
+	log.Printf("[INFO] Created Incapsula DNS protection zone %s for account %d\n", d.Id(), accountID)
+
+	return resourceDNSProtectionZoneRead(d, m)
+}
+
+func resourceDNSProtectionZoneRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	zone, statusCode, err := client.GetDNSProtectionZone(accountID, d.Id())
+
+	// If the zone is deleted on the server, blow it out locally and run through the normal TF cycle
+	if statusCode == 404 {
+		log.Printf("[INFO] Incapsula DNS protection zone %s has already been deleted: %s\n", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula DNS protection zone %s: %s\n", d.Id(), err)
+		return err
+	}
+
+	d.Set("zone_name", zone.ZoneName)
+	d.Set("origin_name_servers", zone.OriginNameServers)
+	d.Set("name_servers", zone.AssignedNameServers)
+	d.Set("status", zone.Status)
+
+	return nil
+}
+
+func resourceDNSProtectionZoneUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	_, err := client.UpdateDNSProtectionZone(accountID, d.Id(), dnsProtectionZoneFromResourceData(d))
+	if err != nil {
+		log.Printf("[ERROR] Could not update Incapsula DNS protection zone %s: %s\n", d.Id(), err)
+		return err
+	}
+
+	return resourceDNSProtectionZoneRead(d, m)
+}
+
+func resourceDNSProtectionZoneDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+
+	err := client.DeleteDNSProtectionZone(d.Get("account_id").(int), d.Id())
+	if err != nil {
+		log.Printf("[ERROR] Could not delete Incapsula DNS protection zone %s: %s\n", d.Id(), err)
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func dnsProtectionZoneFromResourceData(d *schema.ResourceData) *imperva.DNSProtectionZone {
+	originNameServers := make([]string, 0)
+	for _, nameServer := range d.Get("origin_name_servers").(*schema.Set).List() {
+		originNameServers = append(originNameServers, nameServer.(string))
+	}
+
+	return &imperva.DNSProtectionZone{
+		ZoneName:          d.Get("zone_name").(string),
+		OriginNameServers: originNameServers,
+	}
+}
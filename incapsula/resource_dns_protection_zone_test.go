@@ -0,0 +1,93 @@
+package incapsula
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const dnsProtectionZoneResourceType = "incapsula_dns_protection_zone"
+const dnsProtectionZoneResourceName = "testacc-terraform-dns-protection-zone"
+const dnsProtectionZoneResource = dnsProtectionZoneResourceType + "." + dnsProtectionZoneResourceName
+
+func TestAccIncapsulaDNSProtectionZone_Basic(t *testing.T) {
+	zoneName := GenerateTestDomain(nil)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIncapsulaDNSProtectionZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaDNSProtectionZoneConfigBasic(zoneName, "192.0.2.53"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaDNSProtectionZoneExists(dnsProtectionZoneResource),
+					resource.TestCheckResourceAttr(dnsProtectionZoneResource, "zone_name", zoneName),
+					resource.TestCheckResourceAttrSet(dnsProtectionZoneResource, "name_servers.0"),
+				),
+			},
+			{
+				Config: testAccCheckIncapsulaDNSProtectionZoneConfigBasic(zoneName, "192.0.2.54"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaDNSProtectionZoneExists(dnsProtectionZoneResource),
+					resource.TestCheckResourceAttr(dnsProtectionZoneResource, "origin_name_servers.#", "1"),
+				),
+			},
+			{
+				ResourceName:      dnsProtectionZoneResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckIncapsulaDNSProtectionZoneExists(name string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		res, ok := state.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Incapsula DNS protection zone resource not found: %s", name)
+		}
+
+		if res.Primary.ID == "" {
+			return fmt.Errorf("Incapsula DNS protection zone ID does not exist")
+		}
+
+		client := testAccProvider.Meta().(*providerMeta).client
+		_, _, err := client.GetDNSProtectionZone(0, res.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Incapsula DNS protection zone %s does not exist: %s", res.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIncapsulaDNSProtectionZoneDestroy(state *terraform.State) error {
+	client := testAccProvider.Meta().(*providerMeta).client
+
+	for _, res := range state.RootModule().Resources {
+		if res.Type != dnsProtectionZoneResourceType {
+			continue
+		}
+
+		_, statusCode, _ := client.GetDNSProtectionZone(0, res.Primary.ID)
+		if statusCode != 404 {
+			return fmt.Errorf("Incapsula DNS protection zone %s still exists", res.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIncapsulaDNSProtectionZoneConfigBasic(zoneName, originNameServer string) string {
+	return fmt.Sprintf(`
+	resource "%s" "%s" {
+		zone_name           = "%s"
+		origin_name_servers = ["%s"]
+	}`,
+		dnsProtectionZoneResourceType, dnsProtectionZoneResourceName, zoneName, originNameServer,
+	)
+}
@@ -0,0 +1,141 @@
+package incapsula
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+func resourceFlowMonitoringDevice() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFlowMonitoringDeviceCreate,
+		Read:   resourceFlowMonitoringDeviceRead,
+		Update: resourceFlowMonitoringDeviceUpdate,
+		Delete: resourceFlowMonitoringDeviceDelete,
+		Importer: &schema.ResourceImporter{
+			State: infraProtectImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"name": {
+				Description: "The name of the device.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"ip_address": {
+				Description:  "The IP address the device sends the flow data from.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"sampling_rate": {
+				Description:  "The sampling rate configured on the device. Imperva multiplies the sampled flows by it.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+
+			// Optional Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account to operate on. If not specified, operation will be performed on the account identified by the authentication parameters.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Description: "A description of the device.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			// Computed Attributes
+			"status": {
+				Description: "Whether Imperva receives flow data from the device, e.g. RECEIVING or NOT_RECEIVING.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceFlowMonitoringDeviceCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	device, err := client.AddFlowMonitoringDevice(accountID, flowMonitoringDeviceFromResourceData(d))
+	if err != nil {
+		log.Printf("[ERROR] Could not create Incapsula flow monitoring device %s for account %d: %s\n", d.Get("name"), accountID, err)
+		return err
+	}
+
+	d.SetId(device.ID)
+	log.Printf("[INFO] Created Incapsula flow monitoring device %s for account %d\n", d.Id(), accountID)
+
+	return resourceFlowMonitoringDeviceRead(d, m)
+}
+
+func resourceFlowMonitoringDeviceRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	device, statusCode, err := client.GetFlowMonitoringDevice(accountID, d.Id())
+
+	// If the device is deleted on the server, blow it out locally and run through the normal TF cycle
+	if statusCode == 404 {
+		log.Printf("[INFO] Incapsula flow monitoring device %s has already been deleted: %s\n", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula flow monitoring device %s: %s\n", d.Id(), err)
+		return err
+	}
+
+	d.Set("name", device.Name)
+	d.Set("ip_address", device.IPAddress)
+	d.Set("sampling_rate", device.SamplingRate)
+	d.Set("description", device.Description)
+	d.Set("status", device.Status)
+
+	return nil
+}
+
+func resourceFlowMonitoringDeviceUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	_, err := client.UpdateFlowMonitoringDevice(accountID, d.Id(), flowMonitoringDeviceFromResourceData(d))
+	if err != nil {
+		log.Printf("[ERROR] Could not update Incapsula flow monitoring device %s: %s\n", d.Id(), err)
+		return err
+	}
+
+	return resourceFlowMonitoringDeviceRead(d, m)
+}
+
+func resourceFlowMonitoringDeviceDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+
+	err := client.DeleteFlowMonitoringDevice(d.Get("account_id").(int), d.Id())
+	if err != nil {
+		log.Printf("[ERROR] Could not delete Incapsula flow monitoring device %s: %s\n", d.Id(), err)
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func flowMonitoringDeviceFromResourceData(d *schema.ResourceData) *imperva.FlowMonitoringDevice {
+	return &imperva.FlowMonitoringDevice{
+		Name:         d.Get("name").(string),
+		IPAddress:    d.Get("ip_address").(string),
+		SamplingRate: d.Get("sampling_rate").(int),
+		Description:  d.Get("description").(string),
+	}
+}
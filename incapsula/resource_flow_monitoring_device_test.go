@@ -0,0 +1,93 @@
+package incapsula
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const flowMonitoringDeviceResourceType = "incapsula_flow_monitoring_device"
+const flowMonitoringDeviceResourceName = "testacc-terraform-flow-monitoring-device"
+const flowMonitoringDeviceResource = flowMonitoringDeviceResourceType + "." + flowMonitoringDeviceResourceName
+
+func TestAccIncapsulaFlowMonitoringDevice_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIncapsulaFlowMonitoringDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaFlowMonitoringDeviceConfigBasic(1000),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaFlowMonitoringDeviceExists(flowMonitoringDeviceResource),
+					resource.TestCheckResourceAttr(flowMonitoringDeviceResource, "ip_address", "192.0.2.1"),
+					resource.TestCheckResourceAttr(flowMonitoringDeviceResource, "sampling_rate", "1000"),
+				),
+			},
+			{
+				Config: testAccCheckIncapsulaFlowMonitoringDeviceConfigBasic(512),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaFlowMonitoringDeviceExists(flowMonitoringDeviceResource),
+					resource.TestCheckResourceAttr(flowMonitoringDeviceResource, "sampling_rate", "512"),
+				),
+			},
+			{
+				ResourceName:      flowMonitoringDeviceResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckIncapsulaFlowMonitoringDeviceExists(name string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		res, ok := state.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Incapsula flow monitoring device resource not found: %s", name)
+		}
+
+		if res.Primary.ID == "" {
+			return fmt.Errorf("Incapsula flow monitoring device ID does not exist")
+		}
+
+		client := testAccProvider.Meta().(*providerMeta).client
+		_, _, err := client.GetFlowMonitoringDevice(0, res.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Incapsula flow monitoring device %s does not exist: %s", res.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIncapsulaFlowMonitoringDeviceDestroy(state *terraform.State) error {
+	client := testAccProvider.Meta().(*providerMeta).client
+
+	for _, res := range state.RootModule().Resources {
+		if res.Type != flowMonitoringDeviceResourceType {
+			continue
+		}
+
+		_, statusCode, _ := client.GetFlowMonitoringDevice(0, res.Primary.ID)
+		if statusCode != 404 {
+			return fmt.Errorf("Incapsula flow monitoring device %s still exists", res.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIncapsulaFlowMonitoringDeviceConfigBasic(samplingRate int) string {
+	return fmt.Sprintf(`
+	resource "%s" "%s" {
+		name          = "testacc-terraform-flow-monitoring-device"
+		ip_address    = "192.0.2.1"
+		sampling_rate = %d
+		description   = "testacc-terraform-flow-monitoring-device"
+	}`,
+		flowMonitoringDeviceResourceType, flowMonitoringDeviceResourceName, samplingRate,
+	)
+}
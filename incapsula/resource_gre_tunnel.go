@@ -0,0 +1,185 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+func resourceGRETunnel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGRETunnelCreate,
+		Read:   resourceGRETunnelRead,
+		Update: resourceGRETunnelUpdate,
+		Delete: resourceTunnelDelete,
+		Importer: &schema.ResourceImporter{
+			State: infraProtectImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"name": {
+				Description: "The name of the tunnel.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"protected_ip_range_id": {
+				Description: "The ID of the protected IP range whose clean traffic is returned through the tunnel.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"customer_endpoint_ip": {
+				Description:  "The public IP address of the customer's tunnel endpoint.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+
+			// Optional Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account to operate on. If not specified, operation will be performed on the account identified by the authentication parameters.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"gre_key": {
+				Description:  "The GRE key of the tunnel. The key is never returned by the API, so changes made outside of Terraform are not detected.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.IntBetween(1, 4294967295),
+			},
+
+			// Computed Attributes
+			"imperva_endpoint_ip": {
+				Description: "The public IP address of the Imperva tunnel endpoint.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"imperva_inner_ip": {
+				Description: "The IP address of the Imperva side inside the tunnel.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"customer_inner_ip": {
+				Description: "The IP address of the customer side inside the tunnel.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status": {
+				Description: "The status of the tunnel, e.g. UP or DOWN.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceGRETunnelCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	tunnel, err := client.AddTunnel(accountID, greTunnelFromResourceData(d))
+	if err != nil {
+		log.Printf("[ERROR] Could not create Incapsula GRE tunnel %s for account %d: %s\n", d.Get("name"), accountID, err)
+		return err
+	}
+
+	d.SetId(tunnel.ID)
+	log.Printf("[INFO] Created Incapsula GRE tunnel %s for account %d\n", d.Id(), accountID)
+
+	return resourceGRETunnelRead(d, m)
+}
+
+func resourceGRETunnelRead(d *schema.ResourceData, m interface{}) error {
+	tunnel, err := readTunnel(d, m, imperva.TunnelTypeGRE)
+	if err != nil || tunnel == nil {
+		return err
+	}
+
+	// gre_key is write-only and is kept as configured
+	return nil
+}
+
+func resourceGRETunnelUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	tunnel := greTunnelFromResourceData(d)
+	if !d.HasChange("gre_key") {
+		// Don't reset the key when other arguments change
+		tunnel.GREKey = 0
+	}
+
+	_, err := client.UpdateTunnel(accountID, d.Id(), tunnel)
+	if err != nil {
+		log.Printf("[ERROR] Could not update Incapsula GRE tunnel %s: %s\n", d.Id(), err)
+		return err
+	}
+
+	return resourceGRETunnelRead(d, m)
+}
+
+func greTunnelFromResourceData(d *schema.ResourceData) *imperva.Tunnel {
+	return &imperva.Tunnel{
+		Type:               imperva.TunnelTypeGRE,
+		Name:               d.Get("name").(string),
+		IPRangeID:          d.Get("protected_ip_range_id").(string),
+		CustomerEndpointIP: d.Get("customer_endpoint_ip").(string),
+		GREKey:             d.Get("gre_key").(int),
+	}
+}
+
+// readTunnel reads the arguments and attributes shared by the GRE and IPsec tunnels.
+// It returns a nil tunnel when the tunnel no longer exists.
+func readTunnel(d *schema.ResourceData, m interface{}, tunnelType string) (*imperva.Tunnel, error) {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	tunnel, statusCode, err := client.GetTunnel(accountID, d.Id())
+
+	// If the tunnel is deleted on the server, blow it out locally and run through the normal TF cycle
+	if statusCode == 404 {
+		log.Printf("[INFO] Incapsula tunnel %s has already been deleted: %s\n", d.Id(), err)
+		d.SetId("")
+		return nil, nil
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula tunnel %s: %s\n", d.Id(), err)
+		return nil, err
+	}
+
+	if tunnel.Type != tunnelType {
+		return nil, fmt.Errorf("Incapsula tunnel %s is a %s tunnel, expected a %s tunnel", d.Id(), tunnel.Type, tunnelType)
+	}
+
+	d.Set("name", tunnel.Name)
+	d.Set("protected_ip_range_id", tunnel.IPRangeID)
+	d.Set("customer_endpoint_ip", tunnel.CustomerEndpointIP)
+	d.Set("imperva_endpoint_ip", tunnel.ImpervaEndpointIP)
+	d.Set("imperva_inner_ip", tunnel.ImpervaInnerIP)
+	d.Set("customer_inner_ip", tunnel.CustomerInnerIP)
+	d.Set("status", tunnel.Status)
+
+	return tunnel, nil
+}
+
+func resourceTunnelDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+
+	err := client.DeleteTunnel(d.Get("account_id").(int), d.Id())
+	if err != nil {
+		log.Printf("[ERROR] Could not delete Incapsula tunnel %s: %s\n", d.Id(), err)
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
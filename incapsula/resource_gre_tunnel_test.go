@@ -0,0 +1,94 @@
+package incapsula
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const greTunnelResourceType = "incapsula_gre_tunnel"
+const greTunnelResourceName = "testacc-terraform-gre-tunnel"
+const greTunnelResource = greTunnelResourceType + "." + greTunnelResourceName
+
+func TestAccIncapsulaGRETunnel_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIncapsulaGRETunnelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaGRETunnelConfigBasic("testacc-terraform-gre-tunnel"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaGRETunnelExists(greTunnelResource),
+					resource.TestCheckResourceAttr(greTunnelResource, "customer_endpoint_ip", "198.51.100.1"),
+					resource.TestCheckResourceAttrSet(greTunnelResource, "imperva_endpoint_ip"),
+				),
+			},
+			{
+				Config: testAccCheckIncapsulaGRETunnelConfigBasic("testacc-terraform-gre-tunnel-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaGRETunnelExists(greTunnelResource),
+					resource.TestCheckResourceAttr(greTunnelResource, "name", "testacc-terraform-gre-tunnel-updated"),
+				),
+			},
+			{
+				ResourceName:            greTunnelResource,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"gre_key", "status"},
+			},
+		},
+	})
+}
+
+func testCheckIncapsulaGRETunnelExists(name string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		res, ok := state.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Incapsula GRE tunnel resource not found: %s", name)
+		}
+
+		if res.Primary.ID == "" {
+			return fmt.Errorf("Incapsula GRE tunnel ID does not exist")
+		}
+
+		client := testAccProvider.Meta().(*providerMeta).client
+		_, _, err := client.GetTunnel(0, res.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Incapsula GRE tunnel %s does not exist: %s", res.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIncapsulaGRETunnelDestroy(state *terraform.State) error {
+	client := testAccProvider.Meta().(*providerMeta).client
+
+	for _, res := range state.RootModule().Resources {
+		if res.Type != greTunnelResourceType {
+			continue
+		}
+
+		_, statusCode, _ := client.GetTunnel(0, res.Primary.ID)
+		if statusCode != 404 {
+			return fmt.Errorf("Incapsula GRE tunnel %s still exists", res.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIncapsulaGRETunnelConfigBasic(name string) string {
+	return testAccCheckIncapsulaProtectedIPRangeConfigBasic(true) + fmt.Sprintf(`
+	resource "%s" "%s" {
+		name                  = "%s"
+		protected_ip_range_id = %s.id
+		customer_endpoint_ip  = "198.51.100.1"
+		gre_key               = 1234
+	}`,
+		greTunnelResourceType, greTunnelResourceName, name, protectedIPRangeResource,
+	)
+}
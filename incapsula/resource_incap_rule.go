@@ -1,19 +1,24 @@
 package incapsula
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
 )
 
 func resourceIncapRule() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceIncapRuleCreate,
+	return withStateUpgraders(&schema.Resource{
+		Create: withSiteLock(resourceIncapRuleCreate),
 		Read:   resourceIncapRuleRead,
-		Update: resourceIncapRuleUpdate,
-		Delete: resourceIncapRuleDelete,
+		Update: withSiteLock(resourceIncapRuleUpdate),
+		Delete: withSiteLock(resourceIncapRuleDelete),
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				idSlice := strings.Split(d.Id(), "/")
@@ -31,6 +36,14 @@ func resourceIncapRule() *schema.Resource {
 			},
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.All(validateSiteReference("site_id"), validateIncapRuleHeaderSettings),
+
 		Schema: map[string]*schema.Schema{
 			// Required Arguments
 			"site_id": {
@@ -45,9 +58,10 @@ func resourceIncapRule() *schema.Resource {
 				Required:    true,
 			},
 			"action": {
-				Description: "Rule action. See the detailed descriptions in the API documentation. Possible values: `RULE_ACTION_REDIRECT`, `RULE_ACTION_SIMPLIFIED_REDIRECT`, `RULE_ACTION_REWRITE_URL`, `RULE_ACTION_REWRITE_HEADER`, `RULE_ACTION_REWRITE_COOKIE`, `RULE_ACTION_DELETE_HEADER`, `RULE_ACTION_DELETE_COOKIE`, `RULE_ACTION_RESPONSE_REWRITE_HEADER`, `RULE_ACTION_RESPONSE_DELETE_HEADER`, `RULE_ACTION_RESPONSE_REWRITE_RESPONSE_CODE`, `RULE_ACTION_FORWARD_TO_DC`, `RULE_ACTION_ALERT`, `RULE_ACTION_BLOCK`, `RULE_ACTION_BLOCK_USER`, `RULE_ACTION_BLOCK_IP`, `RULE_ACTION_RETRY`, `RULE_ACTION_INTRUSIVE_HTML`, `RULE_ACTION_CAPTCHA`, `RULE_ACTION_RATE`, `RULE_ACTION_CUSTOM_ERROR_RESPONSE`",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:  "Rule action. See the detailed descriptions in the API documentation. Possible values: `RULE_ACTION_REDIRECT`, `RULE_ACTION_SIMPLIFIED_REDIRECT`, `RULE_ACTION_REWRITE_URL`, `RULE_ACTION_REWRITE_HEADER`, `RULE_ACTION_REWRITE_COOKIE`, `RULE_ACTION_DELETE_HEADER`, `RULE_ACTION_DELETE_COOKIE`, `RULE_ACTION_RESPONSE_REWRITE_HEADER`, `RULE_ACTION_RESPONSE_DELETE_HEADER`, `RULE_ACTION_RESPONSE_REWRITE_RESPONSE_CODE`, `RULE_ACTION_FORWARD_TO_DC`, `RULE_ACTION_ALERT`, `RULE_ACTION_BLOCK`, `RULE_ACTION_BLOCK_USER`, `RULE_ACTION_BLOCK_IP`, `RULE_ACTION_RETRY`, `RULE_ACTION_INTRUSIVE_HTML`, `RULE_ACTION_CAPTCHA`, `RULE_ACTION_RATE`, `RULE_ACTION_CUSTOM_ERROR_RESPONSE`",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateEnum(incapRuleActionValues),
 			},
 			// Optional Arguments
 			"filter": {
@@ -61,7 +75,7 @@ func resourceIncapRule() *schema.Resource {
 				Optional:    true,
 			},
 			"add_missing": {
-				Description: "Add cookie or header if it doesn't exist (Rewrite cookie rule only).",
+				Description: "Add cookie or header if it doesn't exist. Applies only for `RULE_ACTION_REWRITE_COOKIE`, `RULE_ACTION_REWRITE_HEADER` and `RULE_ACTION_RESPONSE_REWRITE_HEADER`.",
 				Type:        schema.TypeBool,
 				Optional:    true,
 			},
@@ -80,6 +94,12 @@ func resourceIncapRule() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"rewrite_existing": {
+				Description: "Rewrite the cookie or header if it exists, when `false` the rule only adds it (with `add_missing`). All the occurrences of a header are rewritten. Applies only for `RULE_ACTION_REWRITE_COOKIE`, `RULE_ACTION_REWRITE_HEADER` and `RULE_ACTION_RESPONSE_REWRITE_HEADER`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
 			"dc_id": {
 				Description: "Data center to forward request to. Applies only for `RULE_ACTION_FORWARD_TO_DC`.",
 				Type:        schema.TypeInt,
@@ -97,9 +117,10 @@ func resourceIncapRule() *schema.Resource {
 				Optional:    true,
 			},
 			"rate_context": {
-				Description: "The context of the rate counter. Possible values `IP` or `Session`. Applies only to rules using `RULE_ACTION_RATE`.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:  "The context of the rate counter. Possible values `IP` or `Session`. Applies only to rules using `RULE_ACTION_RATE`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateEnum(incapRuleRateContextValues),
 			},
 			"rate_interval": {
 				Description: "The interval in seconds of the rate counter. Possible values is a multiple of `10`; minimum `10` and maximum `300`. Applies only to rules using `RULE_ACTION_RATE`.",
@@ -107,14 +128,16 @@ func resourceIncapRule() *schema.Resource {
 				Optional:    true,
 			},
 			"error_type": {
-				Description: "The error that triggers the rule. `error.type.all` triggers the rule regardless of the error type. Applies only for `RULE_ACTION_CUSTOM_ERROR_RESPONSE`. Possible values: `error.type.all`, `error.type.connection_timeout`, `error.type.access_denied`, `error.type.parse_req_error`, `error.type.parse_resp_error`, `error.type.connection_failed`, `error.type.deny_and_retry`, `error.type.ssl_failed`, `error.type.deny_and_captcha`, `error.type.2fa_required`, `error.type.no_ssl_config`, `error.type.no_ipv6_config`.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:  "The error that triggers the rule. `error.type.all` triggers the rule regardless of the error type. Applies only for `RULE_ACTION_CUSTOM_ERROR_RESPONSE`. Possible values: `error.type.all`, `error.type.connection_timeout`, `error.type.access_denied`, `error.type.parse_req_error`, `error.type.parse_resp_error`, `error.type.connection_failed`, `error.type.deny_and_retry`, `error.type.ssl_failed`, `error.type.deny_and_captcha`, `error.type.2fa_required`, `error.type.no_ssl_config`, `error.type.no_ipv6_config`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateEnum(incapRuleErrorTypeValues),
 			},
 			"error_response_format": {
-				Description: "The format of the given error response in the error_response_data field. Applies only for `RULE_ACTION_CUSTOM_ERROR_RESPONSE`. Possible values: `json`, `xml`.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:  "The format of the given error response in the error_response_data field. Applies only for `RULE_ACTION_CUSTOM_ERROR_RESPONSE`. Possible values: `json`, `xml`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateEnum(incapRuleErrorResponseFormatValues),
 			},
 			"error_response_data": {
 				Description: "The response returned when the request matches the filter and is blocked. Applies only for `RULE_ACTION_CUSTOM_ERROR_RESPONSE`.",
@@ -127,23 +150,56 @@ func resourceIncapRule() *schema.Resource {
 				Optional:    true,
 			},
 			"override_waf_rule": {
-				Description: "The setting to override. Possible values: SQL Injection, Remote File Inclusion, Cross Site Scripting, Illegal Resource Access.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:  "The setting to override. Possible values: SQL Injection, Remote File Inclusion, Cross Site Scripting, Illegal Resource Access.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateEnum(incapRuleOverrideWafRuleValues),
 			},
 			"override_waf_action": {
-				Description: "The action for the override rule. Possible values: Alert Only, Block Request, Block User, Block IP, Ignore.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:  "The action for the override rule. Possible values: Alert Only, Block Request, Block User, Block IP, Ignore.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateEnum(incapRuleOverrideWafActionValues),
 			},
 		},
+	})
+}
+
+// incapRuleRewriteActions are the actions rewriting a cookie or header, which may add it when missing
+var incapRuleRewriteActions = []string{"RULE_ACTION_REWRITE_COOKIE", "RULE_ACTION_REWRITE_HEADER", "RULE_ACTION_RESPONSE_REWRITE_HEADER"}
+
+// incapRuleDeleteHeaderActions are the actions deleting a header, which may delete all its occurrences
+var incapRuleDeleteHeaderActions = []string{"RULE_ACTION_DELETE_HEADER", "RULE_ACTION_RESPONSE_DELETE_HEADER"}
+
+// validateIncapRuleHeaderSettings checks the cookie and header rules name what they change, and the rewrite rules
+// either rewrite or add it
+func validateIncapRuleHeaderSettings(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	action := diff.Get("action").(string)
+	rewrite := containsString(incapRuleRewriteActions, action)
+	deleteHeader := containsString(incapRuleDeleteHeaderActions, action)
+
+	if (rewrite || deleteHeader || action == "RULE_ACTION_DELETE_COOKIE") && diff.NewValueKnown("rewrite_name") && diff.Get("rewrite_name").(string) == "" {
+		return fmt.Errorf("rewrite_name must be set when action is %s", action)
+	}
+	if rewrite && !diff.Get("rewrite_existing").(bool) && !diff.Get("add_missing").(bool) {
+		return errors.New("add_missing must be true when rewrite_existing is false, otherwise the rule does nothing")
 	}
+	return nil
+}
+
+// incapRuleRewriteExisting returns rewrite_existing for the rewrite rules only, it doesn't apply to the others
+func incapRuleRewriteExisting(d *schema.ResourceData) *bool {
+	if !containsString(incapRuleRewriteActions, d.Get("action").(string)) {
+		return nil
+	}
+	rewriteExisting := d.Get("rewrite_existing").(bool)
+	return &rewriteExisting
 }
 
 func resourceIncapRuleCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
-	rule := IncapRule{
+	rule := imperva.IncapRule{
 		Name:                  d.Get("name").(string),
 		Action:                d.Get("action").(string),
 		Filter:                d.Get("filter").(string),
@@ -152,6 +208,7 @@ func resourceIncapRuleCreate(d *schema.ResourceData, m interface{}) error {
 		From:                  d.Get("from").(string),
 		To:                    d.Get("to").(string),
 		RewriteName:           d.Get("rewrite_name").(string),
+		RewriteExisting:       incapRuleRewriteExisting(d),
 		DCID:                  d.Get("dc_id").(int),
 		PortForwardingContext: d.Get("port_forwarding_context").(string),
 		PortForwardingValue:   d.Get("port_forwarding_value").(string),
@@ -165,7 +222,13 @@ func resourceIncapRuleCreate(d *schema.ResourceData, m interface{}) error {
 		OverrideWafAction:     d.Get("override_waf_action").(string),
 	}
 
-	ruleWithID, err := client.AddIncapRule(d.Get("site_id").(string), &rule)
+	// The rule doesn't exist yet, there is nothing to re-read before retrying
+	var ruleWithID *imperva.IncapRuleWithID
+	err := retryOnConflict(d.Timeout(schema.TimeoutCreate), func() error {
+		var err error
+		ruleWithID, err = client.AddIncapRule(d.Get("site_id").(string), &rule)
+		return err
+	}, nil)
 
 	if err != nil {
 		return err
@@ -178,7 +241,7 @@ func resourceIncapRuleCreate(d *schema.ResourceData, m interface{}) error {
 
 func resourceIncapRuleRead(d *schema.ResourceData, m interface{}) error {
 	// Implement by reading the SiteResponse for the site
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	ruleID, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -206,6 +269,12 @@ func resourceIncapRuleRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("from", rule.From)
 	d.Set("to", rule.To)
 	d.Set("rewrite_name", rule.RewriteName)
+	// The API only returns rewrite_existing for the rewrite rules, the others keep the default
+	rewriteExisting := true
+	if rule.RewriteExisting != nil {
+		rewriteExisting = *rule.RewriteExisting
+	}
+	d.Set("rewrite_existing", rewriteExisting)
 	d.Set("dc_id", rule.DCID)
 	d.Set("port_forwarding_context", rule.PortForwardingContext)
 	d.Set("port_forwarding_value", rule.PortForwardingValue)
@@ -222,9 +291,9 @@ func resourceIncapRuleRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceIncapRuleUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
-	rule := IncapRule{
+	rule := imperva.IncapRule{
 		Name:                  d.Get("name").(string),
 		Action:                d.Get("action").(string),
 		Filter:                d.Get("filter").(string),
@@ -233,6 +302,7 @@ func resourceIncapRuleUpdate(d *schema.ResourceData, m interface{}) error {
 		From:                  d.Get("from").(string),
 		To:                    d.Get("to").(string),
 		RewriteName:           d.Get("rewrite_name").(string),
+		RewriteExisting:       incapRuleRewriteExisting(d),
 		DCID:                  d.Get("dc_id").(int),
 		PortForwardingContext: d.Get("port_forwarding_context").(string),
 		PortForwardingValue:   d.Get("port_forwarding_value").(string),
@@ -251,7 +321,16 @@ func resourceIncapRuleUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	_, err = client.UpdateIncapRule(d.Get("site_id").(string), ruleID, &rule)
+	err = retryOnConflict(d.Timeout(schema.TimeoutUpdate), func() error {
+		_, err := client.UpdateIncapRule(d.Get("site_id").(string), ruleID, &rule)
+		return err
+	}, func() (bool, error) {
+		_, statusCode, err := client.ReadIncapRule(d.Get("site_id").(string), ruleID)
+		if statusCode == 404 {
+			return false, fmt.Errorf("Incap Rule %d for Site ID %s was deleted while updating it", ruleID, d.Get("site_id"))
+		}
+		return false, err
+	})
 
 	if err != nil {
 		return err
@@ -261,14 +340,23 @@ func resourceIncapRuleUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceIncapRuleDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	ruleID, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return err
 	}
 
-	err = client.DeleteIncapRule(d.Get("site_id").(string), ruleID)
+	err = retryOnConflict(d.Timeout(schema.TimeoutDelete), func() error {
+		return client.DeleteIncapRule(d.Get("site_id").(string), ruleID)
+	}, func() (bool, error) {
+		// The rule may have been deleted by the conflicting change
+		_, statusCode, err := client.ReadIncapRule(d.Get("site_id").(string), ruleID)
+		if statusCode == 404 {
+			return true, nil
+		}
+		return false, err
+	})
 	if err != nil {
 		return err
 	}
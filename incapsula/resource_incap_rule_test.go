@@ -35,6 +35,37 @@ func TestAccIncapsulaIncapRule_Basic(t *testing.T) {
 	})
 }
 
+func TestAccIncapsulaIncapRule_RequestHeader(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIncapsulaIncapRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaIncapRuleConfigRequestHeader(t, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaIncapRuleExists(incapRuleResourceName),
+					resource.TestCheckResourceAttr(incapRuleResourceName, "rewrite_name", "X-Forwarded-Client-Cert"),
+					resource.TestCheckResourceAttr(incapRuleResourceName, "rewrite_existing", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIncapsulaIncapRuleConfigRequestHeader(t, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaIncapRuleExists(incapRuleResourceName),
+					resource.TestCheckResourceAttr(incapRuleResourceName, "rewrite_existing", "false"),
+				),
+			},
+			{
+				ResourceName:      incapRuleResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccStateRuleID,
+			},
+		},
+	})
+}
+
 func testAccStateRuleID(s *terraform.State) (string, error) {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "incapsula_incap_rule" {
@@ -55,7 +86,7 @@ func testAccStateRuleID(s *terraform.State) (string, error) {
 }
 
 func testAccCheckIncapsulaIncapRuleDestroy(state *terraform.State) error {
-	client := testAccProvider.Meta().(*Client)
+	client := testAccProvider.Meta().(*providerMeta).client
 
 	for _, res := range state.RootModule().Resources {
 		if res.Type != "incapsula_incap_rule" {
@@ -101,7 +132,7 @@ func testCheckIncapsulaIncapRuleExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Incapsula Site ID does not exist for Rule ID %d", ruleID)
 		}
 
-		client := testAccProvider.Meta().(*Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 		_, statusCode, err := client.ReadIncapRule(siteID, ruleID)
 		if statusCode != 200 {
 			return fmt.Errorf("Incapsula Incap Rule: %s (site id: %s) should have received 200 status code", name, siteID)
@@ -125,3 +156,18 @@ resource "incapsula_incap_rule" "testacc-terraform-incap-rule" {
 }`, incapRuleName, siteResourceName,
 	)
 }
+
+func testAccCheckIncapsulaIncapRuleConfigRequestHeader(t *testing.T, rewriteExisting bool) string {
+	return testAccCheckIncapsulaSiteConfigBasic(GenerateTestDomain(t)) + fmt.Sprintf(`
+resource "incapsula_incap_rule" "testacc-terraform-incap-rule" {
+  name = "%s"
+  site_id = "${incapsula_site.testacc-terraform-site.id}"
+  action = "RULE_ACTION_REWRITE_HEADER"
+  rewrite_name = "X-Forwarded-Client-Cert"
+  to = "example-client-cert"
+  add_missing = true
+  rewrite_existing = %t
+  depends_on = ["%s"]
+}`, incapRuleName, rewriteExisting, siteResourceName,
+	)
+}
@@ -0,0 +1,203 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+var accessListPortsPattern = regexp.MustCompile(`^([0-9]+)(-([0-9]+))?$`)
+
+func resourceInfraProtectAccessList() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInfraProtectAccessListUpdate,
+		Read:   resourceInfraProtectAccessListRead,
+		Update: resourceInfraProtectAccessListUpdate,
+		Delete: resourceInfraProtectAccessListDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				_, err := infraProtectImportState(d, m)
+				if err != nil {
+					return nil, err
+				}
+				d.Set("protected_ip_range_id", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"protected_ip_range_id": {
+				Description: "The ID of the protected IP range.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			// Optional Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account to operate on. If not specified, operation will be performed on the account identified by the authentication parameters.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"allow": {
+				Description: "Traffic which is always allowed to the protected IP range.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        infraProtectAccessListEntrySchema(),
+			},
+			"block": {
+				Description: "Traffic which is always blocked.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        infraProtectAccessListEntrySchema(),
+			},
+		},
+	}
+}
+
+func infraProtectAccessListEntrySchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"source": {
+				Description:  "The source IP address or range in CIDR notation.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.Any(validation.IsIPAddress, validation.IsCIDR),
+			},
+			"protocol": {
+				Description:  "The protocol. Options are `ANY`, `TCP`, `UDP`, `ICMP` and `GRE`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ANY",
+				ValidateFunc: validation.StringInSlice([]string{"ANY", "TCP", "UDP", "ICMP", "GRE"}, false),
+			},
+			"ports": {
+				Description:  "The destination port or port range, e.g. 443 or 1024-2048. Only for TCP and UDP. If not specified, all ports match.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAccessListPorts,
+			},
+		},
+	}
+}
+
+func resourceInfraProtectAccessListRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	accessList, statusCode, err := client.GetInfraProtectAccessList(accountID, d.Id())
+
+	// If the range is deleted on the server, blow it out locally and run through the normal TF cycle
+	if statusCode == 404 {
+		log.Printf("[INFO] Incapsula protected IP range %s has already been deleted: %s\n", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula Infrastructure Protection access list for protected IP range %s: %s\n", d.Id(), err)
+		return err
+	}
+
+	allow := make([]interface{}, 0)
+	block := make([]interface{}, 0)
+	for _, entry := range accessList.Entries {
+		value := map[string]interface{}{
+			"source":   entry.Source,
+			"protocol": entry.Protocol,
+			"ports":    entry.Ports,
+		}
+		if entry.Action == imperva.AccessListActionBlock {
+			block = append(block, value)
+		} else {
+			allow = append(allow, value)
+		}
+	}
+
+	d.Set("protected_ip_range_id", d.Id())
+	d.Set("allow", allow)
+	d.Set("block", block)
+
+	return nil
+}
+
+func resourceInfraProtectAccessListUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+	rangeID := d.Get("protected_ip_range_id").(string)
+
+	accessList := imperva.InfraProtectAccessList{
+		Entries: make([]imperva.InfraProtectAccessListEntry, 0),
+	}
+	for _, action := range []string{imperva.AccessListActionAllow, imperva.AccessListActionBlock} {
+		key := strings.ToLower(action)
+		for _, v := range d.Get(key).(*schema.Set).List() {
+			entry := v.(map[string]interface{})
+			if entry["ports"].(string) != "" && entry["protocol"].(string) != "TCP" && entry["protocol"].(string) != "UDP" {
+				return fmt.Errorf("Error in %s entry for source %s: ports can only be set for the TCP and UDP protocols", key, entry["source"])
+			}
+			accessList.Entries = append(accessList.Entries, imperva.InfraProtectAccessListEntry{
+				Action:   action,
+				Source:   entry["source"].(string),
+				Protocol: entry["protocol"].(string),
+				Ports:    entry["ports"].(string),
+			})
+		}
+	}
+
+	_, err := client.UpdateInfraProtectAccessList(accountID, rangeID, &accessList)
+	if err != nil {
+		log.Printf("[ERROR] Could not update Incapsula Infrastructure Protection access list for protected IP range %s: %s\n", rangeID, err)
+		return err
+	}
+
+	d.SetId(rangeID)
+
+	return resourceInfraProtectAccessListRead(d, m)
+}
+
+func resourceInfraProtectAccessListDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+
+	// Deleting the access list is just removing all of its entries
+	accessList := imperva.InfraProtectAccessList{
+		Entries: make([]imperva.InfraProtectAccessListEntry, 0),
+	}
+
+	_, err := client.UpdateInfraProtectAccessList(d.Get("account_id").(int), d.Id(), &accessList)
+	if err != nil {
+		log.Printf("[ERROR] Could not clear Incapsula Infrastructure Protection access list for protected IP range %s: %s\n", d.Id(), err)
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func validateAccessListPorts(i interface{}, k string) ([]string, []error) {
+	ports := i.(string)
+	match := accessListPortsPattern.FindStringSubmatch(ports)
+	if match == nil {
+		return nil, []error{fmt.Errorf("%s must be a port or a port range, e.g. 443 or 1024-2048, got: %s", k, ports)}
+	}
+
+	from, _ := strconv.Atoi(match[1])
+	to := from
+	if match[3] != "" {
+		to, _ = strconv.Atoi(match[3])
+	}
+	if from < 1 || to > 65535 || from > to {
+		return nil, []error{fmt.Errorf("%s must be between 1 and 65535, with the first port of a range not above the last one, got: %s", k, ports)}
+	}
+
+	return nil, nil
+}
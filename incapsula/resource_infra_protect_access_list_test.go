@@ -0,0 +1,103 @@
+package incapsula
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const infraProtectAccessListResourceType = "incapsula_infra_protect_access_list"
+const infraProtectAccessListResourceName = "testacc-terraform-infra-protect-access-list"
+const infraProtectAccessListResource = infraProtectAccessListResourceType + "." + infraProtectAccessListResourceName
+
+func TestValidateAccessListPorts(t *testing.T) {
+	for _, ports := range []string{"1", "443", "1024-2048", "65535", "80-80"} {
+		_, errs := validateAccessListPorts(ports, "ports")
+		if len(errs) > 0 {
+			t.Errorf("Should not have received an error for %s, got: %v", ports, errs)
+		}
+	}
+
+	for _, ports := range []string{"", "0", "65536", "2048-1024", "80,443", "http", "1-"} {
+		_, errs := validateAccessListPorts(ports, "ports")
+		if len(errs) == 0 {
+			t.Errorf("Should have received an error for %q", ports)
+		}
+	}
+}
+
+func TestAccIncapsulaInfraProtectAccessList_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIncapsulaProtectedIPRangeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaInfraProtectAccessListConfigBasic("203.0.113.7"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaInfraProtectAccessListEntries(infraProtectAccessListResource, 3),
+					resource.TestCheckResourceAttr(infraProtectAccessListResource, "allow.#", "2"),
+					resource.TestCheckResourceAttr(infraProtectAccessListResource, "block.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIncapsulaInfraProtectAccessListConfigBasic("203.0.113.8"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaInfraProtectAccessListEntries(infraProtectAccessListResource, 3),
+					resource.TestCheckTypeSetElemNestedAttrs(infraProtectAccessListResource, "block.*", map[string]string{"source": "203.0.113.8"}),
+				),
+			},
+			{
+				ResourceName:      infraProtectAccessListResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckIncapsulaInfraProtectAccessListEntries(name string, entries int) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		res, ok := state.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Incapsula Infrastructure Protection access list resource not found: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*providerMeta).client
+		accessList, _, err := client.GetInfraProtectAccessList(0, res.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Incapsula Infrastructure Protection access list for protected IP range %s does not exist: %s", res.Primary.ID, err)
+		}
+		if len(accessList.Entries) != entries {
+			return fmt.Errorf("Incapsula Infrastructure Protection access list for protected IP range %s has %d entries, expected %d", res.Primary.ID, len(accessList.Entries), entries)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIncapsulaInfraProtectAccessListConfigBasic(blockedSource string) string {
+	return testAccCheckIncapsulaProtectedIPRangeConfigBasic(true) + fmt.Sprintf(`
+	resource "%s" "%s" {
+		protected_ip_range_id = %s.id
+
+		allow {
+			source   = "198.51.100.0/24"
+			protocol = "TCP"
+			ports    = "443"
+		}
+
+		allow {
+			source   = "198.51.100.10"
+			protocol = "ICMP"
+		}
+
+		block {
+			source = "%s"
+		}
+	}`,
+		infraProtectAccessListResourceType, infraProtectAccessListResourceName, protectedIPRangeResource, blockedSource,
+	)
+}
@@ -0,0 +1,252 @@
+package incapsula
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+// Syslog transport enumerations
+const syslogTransportUDP = "UDP"
+const syslogTransportTCP = "TCP"
+const syslogTransportTLS = "TLS"
+
+var syslogHostPattern = regexp.MustCompile(`^([A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?\.)*[A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?$`)
+
+// tlsOnlySyslogArguments can only be set when the events are sent over TLS
+var tlsOnlySyslogArguments = []string{"tls_ca_certificate", "tls_client_certificate", "tls_client_key"}
+
+func resourceInfraProtectSyslogDestination() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInfraProtectSyslogDestinationCreate,
+		Read:   resourceInfraProtectSyslogDestinationRead,
+		Update: resourceInfraProtectSyslogDestinationUpdate,
+		Delete: resourceInfraProtectSyslogDestinationDelete,
+		Importer: &schema.ResourceImporter{
+			State: infraProtectImportState,
+		},
+		CustomizeDiff: validateSyslogTLSOptions,
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"name": {
+				Description: "The name of the syslog destination.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"host": {
+				Description:  "The IP address or host name of the syslog server.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.Any(validation.IsIPAddress, validation.StringMatch(syslogHostPattern, "must be a valid host name")),
+			},
+
+			// Optional Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account to operate on. If not specified, operation will be performed on the account identified by the authentication parameters.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"port": {
+				Description:  "The port of the syslog server.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      6514,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"transport": {
+				Description:  "The transport protocol of the events: UDP, TCP or TLS.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      syslogTransportTLS,
+				ValidateFunc: validation.StringInSlice([]string{syslogTransportUDP, syslogTransportTCP, syslogTransportTLS}, false),
+			},
+			"format": {
+				Description:  "The format of the events: CEF, LEEF or JSON.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "CEF",
+				ValidateFunc: validation.StringInSlice([]string{"CEF", "LEEF", "JSON"}, false),
+			},
+			"enabled": {
+				Description: "Whether events are forwarded to the syslog server.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"tls_verify_certificate": {
+				Description: "Whether the certificate of the syslog server is verified. Only used when transport is TLS.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"tls_ca_certificate": {
+				Description:  "The PEM encoded certificate of the CA which signed the certificate of the syslog server, when it isn't signed by a public CA.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validatePEM,
+			},
+			"tls_client_certificate": {
+				Description:  "The PEM encoded client certificate presented to the syslog server.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validatePEM,
+			},
+			"tls_client_key": {
+				Description:  "The PEM encoded private key of the client certificate. The key is never returned by the API, so changes made outside of Terraform are not detected.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validatePEM,
+			},
+
+			// Computed Attributes
+			"status": {
+				Description: "The status of the connection to the syslog server, e.g. CONNECTED or ERROR.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// validateSyslogTLSOptions rejects TLS options when the events are not sent over TLS,
+// and a client certificate without its key
+func validateSyslogTLSOptions(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if diff.Get("transport").(string) != syslogTransportTLS {
+		for _, key := range tlsOnlySyslogArguments {
+			if diff.Get(key).(string) != "" {
+				return fmt.Errorf("%s can only be set when transport is %s", key, syslogTransportTLS)
+			}
+		}
+	}
+
+	// Unknown values are empty during plan, the API validates them on apply
+	if !diff.NewValueKnown("tls_client_certificate") || !diff.NewValueKnown("tls_client_key") {
+		return nil
+	}
+	if (diff.Get("tls_client_certificate").(string) == "") != (diff.Get("tls_client_key").(string) == "") {
+		return fmt.Errorf("tls_client_certificate and tls_client_key must be set together")
+	}
+
+	return nil
+}
+
+// validatePEM checks that the value looks like a PEM encoded block
+func validatePEM(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	v = strings.TrimSpace(v)
+	if !strings.HasPrefix(v, "-----BEGIN ") || !strings.HasSuffix(v, "-----") {
+		return nil, []error{fmt.Errorf("expected %s to be PEM encoded", k)}
+	}
+
+	return nil, nil
+}
+
+func resourceInfraProtectSyslogDestinationCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	destination, err := client.AddInfraProtectSyslogDestination(accountID, infraProtectSyslogDestinationFromResourceData(d))
+	if err != nil {
+		log.Printf("[ERROR] Could not create Incapsula Infrastructure Protection syslog destination %s for account %d: %s\n", d.Get("name"), accountID, err)
+		return err
+	}
+
+	d.SetId(destination.ID)
+	log.Printf("[INFO] Created Incapsula Infrastructure Protection syslog destination %s for account %d\n", d.Id(), accountID)
+
+	return resourceInfraProtectSyslogDestinationRead(d, m)
+}
+
+func resourceInfraProtectSyslogDestinationRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	destination, statusCode, err := client.GetInfraProtectSyslogDestination(accountID, d.Id())
+
+	// If the destination is deleted on the server, blow it out locally and run through the normal TF cycle
+	if statusCode == 404 {
+		log.Printf("[INFO] Incapsula Infrastructure Protection syslog destination %s has already been deleted: %s\n", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula Infrastructure Protection syslog destination %s: %s\n", d.Id(), err)
+		return err
+	}
+
+	// tls_client_key is write-only and is kept as configured
+	d.Set("name", destination.Name)
+	d.Set("host", destination.Host)
+	d.Set("port", destination.Port)
+	d.Set("transport", destination.Transport)
+	d.Set("format", destination.Format)
+	d.Set("enabled", destination.Enabled)
+	d.Set("tls_verify_certificate", destination.TLSVerifyCertificate)
+	d.Set("tls_ca_certificate", destination.TLSCACertificate)
+	d.Set("tls_client_certificate", destination.TLSClientCertificate)
+	d.Set("status", destination.Status)
+
+	return nil
+}
+
+func resourceInfraProtectSyslogDestinationUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	destination := infraProtectSyslogDestinationFromResourceData(d)
+	if !d.HasChange("tls_client_key") {
+		// Don't reset the key when other arguments change
+		destination.TLSClientKey = ""
+	}
+
+	_, err := client.UpdateInfraProtectSyslogDestination(accountID, d.Id(), destination)
+	if err != nil {
+		log.Printf("[ERROR] Could not update Incapsula Infrastructure Protection syslog destination %s: %s\n", d.Id(), err)
+		return err
+	}
+
+	return resourceInfraProtectSyslogDestinationRead(d, m)
+}
+
+func resourceInfraProtectSyslogDestinationDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+
+	err := client.DeleteInfraProtectSyslogDestination(d.Get("account_id").(int), d.Id())
+	if err != nil {
+		log.Printf("[ERROR] Could not delete Incapsula Infrastructure Protection syslog destination %s: %s\n", d.Id(), err)
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func infraProtectSyslogDestinationFromResourceData(d *schema.ResourceData) *imperva.InfraProtectSyslogDestination {
+	return &imperva.InfraProtectSyslogDestination{
+		Name:                 d.Get("name").(string),
+		Host:                 d.Get("host").(string),
+		Port:                 d.Get("port").(int),
+		Transport:            d.Get("transport").(string),
+		Format:               d.Get("format").(string),
+		Enabled:              d.Get("enabled").(bool),
+		TLSVerifyCertificate: d.Get("tls_verify_certificate").(bool),
+		TLSCACertificate:     d.Get("tls_ca_certificate").(string),
+		TLSClientCertificate: d.Get("tls_client_certificate").(string),
+		TLSClientKey:         d.Get("tls_client_key").(string),
+	}
+}
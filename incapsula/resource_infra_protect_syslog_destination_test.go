@@ -0,0 +1,97 @@
+package incapsula
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const infraProtectSyslogDestinationResourceType = "incapsula_infra_protect_syslog_destination"
+const infraProtectSyslogDestinationResourceName = "testacc-terraform-syslog-destination"
+const infraProtectSyslogDestinationResource = infraProtectSyslogDestinationResourceType + "." + infraProtectSyslogDestinationResourceName
+
+func TestAccIncapsulaInfraProtectSyslogDestination_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIncapsulaInfraProtectSyslogDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaInfraProtectSyslogDestinationConfigBasic("TLS", 6514),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaInfraProtectSyslogDestinationExists(infraProtectSyslogDestinationResource),
+					resource.TestCheckResourceAttr(infraProtectSyslogDestinationResource, "transport", "TLS"),
+					resource.TestCheckResourceAttr(infraProtectSyslogDestinationResource, "port", "6514"),
+					resource.TestCheckResourceAttrSet(infraProtectSyslogDestinationResource, "status"),
+				),
+			},
+			{
+				Config: testAccCheckIncapsulaInfraProtectSyslogDestinationConfigBasic("TCP", 1514),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaInfraProtectSyslogDestinationExists(infraProtectSyslogDestinationResource),
+					resource.TestCheckResourceAttr(infraProtectSyslogDestinationResource, "transport", "TCP"),
+					resource.TestCheckResourceAttr(infraProtectSyslogDestinationResource, "port", "1514"),
+				),
+			},
+			{
+				ResourceName:            infraProtectSyslogDestinationResource,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"tls_client_key", "status"},
+			},
+		},
+	})
+}
+
+func testCheckIncapsulaInfraProtectSyslogDestinationExists(name string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		res, ok := state.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Incapsula Infrastructure Protection syslog destination resource not found: %s", name)
+		}
+
+		if res.Primary.ID == "" {
+			return fmt.Errorf("Incapsula Infrastructure Protection syslog destination ID does not exist")
+		}
+
+		client := testAccProvider.Meta().(*providerMeta).client
+		_, _, err := client.GetInfraProtectSyslogDestination(0, res.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Incapsula Infrastructure Protection syslog destination %s does not exist: %s", res.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIncapsulaInfraProtectSyslogDestinationDestroy(state *terraform.State) error {
+	client := testAccProvider.Meta().(*providerMeta).client
+
+	for _, res := range state.RootModule().Resources {
+		if res.Type != infraProtectSyslogDestinationResourceType {
+			continue
+		}
+
+		_, statusCode, _ := client.GetInfraProtectSyslogDestination(0, res.Primary.ID)
+		if statusCode != 404 {
+			return fmt.Errorf("Incapsula Infrastructure Protection syslog destination %s still exists", res.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIncapsulaInfraProtectSyslogDestinationConfigBasic(transport string, port int) string {
+	return fmt.Sprintf(`
+	resource "%s" "%s" {
+		name      = "testacc-terraform-syslog-destination"
+		host      = "syslog.example.com"
+		port      = %d
+		transport = "%s"
+		format    = "CEF"
+	}`,
+		infraProtectSyslogDestinationResourceType, infraProtectSyslogDestinationResourceName, port, transport,
+	)
+}
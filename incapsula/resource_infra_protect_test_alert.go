@@ -0,0 +1,113 @@
+package incapsula
+
+import (
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+// Infrastructure Protection test alert types
+var infraProtectTestAlertTypes = []string{
+	"DDOS_START",
+	"DDOS_STOP",
+	"CONNECTIVITY_DOWN",
+	"CONNECTIVITY_UP",
+}
+
+// resourceInfraProtectTestAlert triggers a simulated alert when it is created.
+// Every argument forces a new resource, so changing the revision triggers another alert.
+func resourceInfraProtectTestAlert() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInfraProtectTestAlertCreate,
+		Read:   resourceInfraProtectTestAlertRead,
+		Delete: resourceInfraProtectTestAlertDelete,
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"revision": {
+				Description: "Any value. A new test alert is triggered whenever it changes.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			// Optional Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account to operate on. If not specified, operation will be performed on the account identified by the authentication parameters.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"alert_type": {
+				Description:  "The type of the simulated alert. Options are `DDOS_START`, `DDOS_STOP`, `CONNECTIVITY_DOWN` and `CONNECTIVITY_UP`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "DDOS_START",
+				ValidateFunc: validation.StringInSlice(infraProtectTestAlertTypes, false),
+			},
+			"protected_ip_range_id": {
+				Description: "The ID of the protected IP range the alert refers to. If not specified, the alert refers to the whole account.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+
+			// Computed Attributes
+			"status": {
+				Description: "The result of the test alert, as returned by the API.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"message": {
+				Description: "The message returned with the result.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"triggered_at": {
+				Description: "The time the alert was triggered, in RFC 3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceInfraProtectTestAlertCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	alert := imperva.InfraProtectTestAlert{
+		AlertType: d.Get("alert_type").(string),
+		IPRangeID: d.Get("protected_ip_range_id").(string),
+	}
+
+	result, err := client.TriggerInfraProtectTestAlert(accountID, &alert)
+	if err != nil {
+		log.Printf("[ERROR] Could not trigger Incapsula Infrastructure Protection test alert for account %d: %s\n", accountID, err)
+		return err
+	}
+
+	d.SetId(result.ID)
+	d.Set("status", result.Status)
+	d.Set("message", result.Message)
+	d.Set("triggered_at", time.Unix(0, result.TriggeredAt*int64(time.Millisecond)).UTC().Format(time.RFC3339))
+
+	log.Printf("[INFO] Triggered Incapsula Infrastructure Protection test alert %s for account %d: %s\n", d.Id(), accountID, result.Status)
+
+	return resourceInfraProtectTestAlertRead(d, m)
+}
+
+func resourceInfraProtectTestAlertRead(d *schema.ResourceData, m interface{}) error {
+	// The result of a test alert is recorded when it is triggered and can't be read back
+	return nil
+}
+
+func resourceInfraProtectTestAlertDelete(d *schema.ResourceData, m interface{}) error {
+	// A test alert can't be undone, it is just removed from the state
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,45 @@
+package incapsula
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const infraProtectTestAlertResourceType = "incapsula_infra_protect_test_alert"
+const infraProtectTestAlertResourceName = "testacc-terraform-infra-protect-test-alert"
+const infraProtectTestAlertResource = infraProtectTestAlertResourceType + "." + infraProtectTestAlertResourceName
+
+func TestAccIncapsulaInfraProtectTestAlert_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaInfraProtectTestAlertConfigBasic("1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(infraProtectTestAlertResource, "status"),
+					resource.TestCheckResourceAttrSet(infraProtectTestAlertResource, "triggered_at"),
+				),
+			},
+			{
+				Config: testAccCheckIncapsulaInfraProtectTestAlertConfigBasic("2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(infraProtectTestAlertResource, "revision", "2"),
+					resource.TestCheckResourceAttrSet(infraProtectTestAlertResource, "status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIncapsulaInfraProtectTestAlertConfigBasic(revision string) string {
+	return fmt.Sprintf(`
+	resource "%s" "%s" {
+		revision   = "%s"
+		alert_type = "DDOS_START"
+	}`,
+		infraProtectTestAlertResourceType, infraProtectTestAlertResourceName, revision,
+	)
+}
@@ -0,0 +1,146 @@
+package incapsula
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+func resourceIPsecTunnel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIPsecTunnelCreate,
+		Read:   resourceIPsecTunnelRead,
+		Update: resourceIPsecTunnelUpdate,
+		Delete: resourceTunnelDelete,
+		Importer: &schema.ResourceImporter{
+			State: infraProtectImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"name": {
+				Description: "The name of the tunnel.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"protected_ip_range_id": {
+				Description: "The ID of the protected IP range whose clean traffic is returned through the tunnel.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"customer_endpoint_ip": {
+				Description:  "The public IP address of the customer's tunnel endpoint.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"pre_shared_key": {
+				Description:  "The IKE pre-shared key of the tunnel. The key is never returned by the API, so changes made outside of Terraform are not detected.",
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(8, 64),
+			},
+
+			// Optional Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account to operate on. If not specified, operation will be performed on the account identified by the authentication parameters.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"ike_version": {
+				Description:  "The IKE version. Options are `IKEV1` and `IKEV2`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "IKEV2",
+				ValidateFunc: validation.StringInSlice([]string{"IKEV1", "IKEV2"}, false),
+			},
+
+			// Computed Attributes
+			"imperva_endpoint_ip": {
+				Description: "The public IP address of the Imperva tunnel endpoint.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"imperva_inner_ip": {
+				Description: "The IP address of the Imperva side inside the tunnel.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"customer_inner_ip": {
+				Description: "The IP address of the customer side inside the tunnel.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status": {
+				Description: "The status of the tunnel, e.g. UP or DOWN.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceIPsecTunnelCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	tunnel, err := client.AddTunnel(accountID, ipsecTunnelFromResourceData(d))
+	if err != nil {
+		log.Printf("[ERROR] Could not create Incapsula IPsec tunnel %s for account %d: %s\n", d.Get("name"), accountID, err)
+		return err
+	}
+
+	d.SetId(tunnel.ID)
+	log.Printf("[INFO] Created Incapsula IPsec tunnel %s for account %d\n", d.Id(), accountID)
+
+	return resourceIPsecTunnelRead(d, m)
+}
+
+func resourceIPsecTunnelRead(d *schema.ResourceData, m interface{}) error {
+	tunnel, err := readTunnel(d, m, imperva.TunnelTypeIPsec)
+	if err != nil || tunnel == nil {
+		return err
+	}
+
+	// pre_shared_key is write-only and is kept as configured
+	d.Set("ike_version", tunnel.IKEVersion)
+
+	return nil
+}
+
+func resourceIPsecTunnelUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	tunnel := ipsecTunnelFromResourceData(d)
+	if !d.HasChange("pre_shared_key") {
+		// Don't reset the key when other arguments change
+		tunnel.PreSharedKey = ""
+	}
+
+	_, err := client.UpdateTunnel(accountID, d.Id(), tunnel)
+	if err != nil {
+		log.Printf("[ERROR] Could not update Incapsula IPsec tunnel %s: %s\n", d.Id(), err)
+		return err
+	}
+
+	return resourceIPsecTunnelRead(d, m)
+}
+
+func ipsecTunnelFromResourceData(d *schema.ResourceData) *imperva.Tunnel {
+	return &imperva.Tunnel{
+		Type:               imperva.TunnelTypeIPsec,
+		Name:               d.Get("name").(string),
+		IPRangeID:          d.Get("protected_ip_range_id").(string),
+		CustomerEndpointIP: d.Get("customer_endpoint_ip").(string),
+		PreSharedKey:       d.Get("pre_shared_key").(string),
+		IKEVersion:         d.Get("ike_version").(string),
+	}
+}
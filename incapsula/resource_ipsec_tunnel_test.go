@@ -0,0 +1,94 @@
+package incapsula
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const ipsecTunnelResourceType = "incapsula_ipsec_tunnel"
+const ipsecTunnelResourceName = "testacc-terraform-ipsec-tunnel"
+const ipsecTunnelResource = ipsecTunnelResourceType + "." + ipsecTunnelResourceName
+
+func TestAccIncapsulaIPsecTunnel_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIncapsulaIPsecTunnelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaIPsecTunnelConfigBasic("testacc-terraform-ipsec-tunnel"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaIPsecTunnelExists(ipsecTunnelResource),
+					resource.TestCheckResourceAttr(ipsecTunnelResource, "customer_endpoint_ip", "198.51.100.1"),
+					resource.TestCheckResourceAttrSet(ipsecTunnelResource, "imperva_endpoint_ip"),
+				),
+			},
+			{
+				Config: testAccCheckIncapsulaIPsecTunnelConfigBasic("testacc-terraform-ipsec-tunnel-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaIPsecTunnelExists(ipsecTunnelResource),
+					resource.TestCheckResourceAttr(ipsecTunnelResource, "name", "testacc-terraform-ipsec-tunnel-updated"),
+				),
+			},
+			{
+				ResourceName:            ipsecTunnelResource,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pre_shared_key", "status"},
+			},
+		},
+	})
+}
+
+func testCheckIncapsulaIPsecTunnelExists(name string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		res, ok := state.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Incapsula IPsec tunnel resource not found: %s", name)
+		}
+
+		if res.Primary.ID == "" {
+			return fmt.Errorf("Incapsula IPsec tunnel ID does not exist")
+		}
+
+		client := testAccProvider.Meta().(*providerMeta).client
+		_, _, err := client.GetTunnel(0, res.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Incapsula IPsec tunnel %s does not exist: %s", res.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIncapsulaIPsecTunnelDestroy(state *terraform.State) error {
+	client := testAccProvider.Meta().(*providerMeta).client
+
+	for _, res := range state.RootModule().Resources {
+		if res.Type != ipsecTunnelResourceType {
+			continue
+		}
+
+		_, statusCode, _ := client.GetTunnel(0, res.Primary.ID)
+		if statusCode != 404 {
+			return fmt.Errorf("Incapsula IPsec tunnel %s still exists", res.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIncapsulaIPsecTunnelConfigBasic(name string) string {
+	return testAccCheckIncapsulaProtectedIPRangeConfigBasic(true) + fmt.Sprintf(`
+	resource "%s" "%s" {
+		name                  = "%s"
+		protected_ip_range_id = %s.id
+		customer_endpoint_ip  = "198.51.100.1"
+		pre_shared_key        = "testacc-pre-shared-key"
+	}`,
+		ipsecTunnelResourceType, ipsecTunnelResourceName, name, protectedIPRangeResource,
+	)
+}
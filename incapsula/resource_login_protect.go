@@ -0,0 +1,176 @@
+package incapsula
+
+import (
+	"log"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+func resourceLoginProtect() *schema.Resource {
+	return &schema.Resource{
+		Create: withSiteLock(resourceLoginProtectUpdate),
+		Read:   resourceLoginProtectRead,
+		Update: withSiteLock(resourceLoginProtectUpdate),
+		Delete: withSiteLock(resourceLoginProtectDelete),
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				siteID, err := strconv.Atoi(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				d.Set("site_id", siteID)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		CustomizeDiff: validateSiteReference("site_id"),
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"site_id": {
+				Description: "Numeric identifier of the site to operate on.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			// Optional Arguments
+			"enabled": {
+				Description: "Enables Login Protect for the site.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"allow_all_users": {
+				Description: "Allows all the Login Protect users of the account to access the site. Set to `false` to only allow the `specific_users`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"specific_users": {
+				Description: "The emails of the Login Protect users allowed to access the site when `allow_all_users` is `false`.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+			},
+			"send_lp_notifications": {
+				Description: "Sends a notification to the users when they log in.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"authentication_methods": {
+				Description: "The authentication methods the users can use. Options are `ga` (Google Authenticator), `sms` and `email`.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateEnum(loginProtectAuthenticationMethodValues),
+				},
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLoginProtectUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	siteID := d.Get("site_id").(int)
+
+	log.Printf("[INFO] Configuring Incapsula Login Protect for site id: %d\n", siteID)
+
+	loginProtect := &imperva.LoginProtect{
+		Enabled:               d.Get("enabled").(bool),
+		AllowAllUsers:         d.Get("allow_all_users").(bool),
+		SpecificUsers:         expandStringSet(d.Get("specific_users").(*schema.Set)),
+		SendLPNotifications:   d.Get("send_lp_notifications").(bool),
+		AuthenticationMethods: expandStringSet(d.Get("authentication_methods").(*schema.Set)),
+	}
+	err := client.ConfigureLoginProtect(siteID, loginProtect)
+	if err != nil {
+		log.Printf("[ERROR] Could not configure Incapsula Login Protect for site id: %d, %s\n", siteID, err)
+		return err
+	}
+
+	d.SetId(strconv.Itoa(siteID))
+
+	log.Printf("[INFO] Configured Incapsula Login Protect for site id: %d\n", siteID)
+
+	// The listed details of the site are outdated
+	client.InvalidateCachedSite(siteID)
+	return resourceLoginProtectRead(d, m)
+}
+
+func resourceLoginProtectRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	siteID, _ := strconv.Atoi(d.Id())
+
+	log.Printf("[INFO] Reading Incapsula Login Protect for site id: %d\n", siteID)
+
+	siteStatusResponse, err := client.CachedSiteStatus("login-protect", siteID, 0)
+
+	// Site object may have been deleted
+	if siteStatusResponse != nil && siteStatusResponse.Res.(float64) == 9413 {
+		log.Printf("[INFO] Incapsula Site ID %d has already been deleted: %s\n", siteID, err)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula Login Protect for site id: %d, %s\n", siteID, err)
+		return err
+	}
+
+	loginProtect := siteStatusResponse.LoginProtect
+	d.Set("site_id", siteID)
+	d.Set("enabled", loginProtect.Enabled)
+	d.Set("allow_all_users", loginProtect.AllowAllUsers)
+	d.Set("specific_users", imperva.LoginProtectUserEmails(loginProtect.SpecificUsersList))
+	d.Set("send_lp_notifications", loginProtect.SendLpNotifications)
+	d.Set("authentication_methods", loginProtect.AuthenticationMethods)
+
+	log.Printf("[INFO] Finished reading Incapsula Login Protect for site id: %d\n", siteID)
+
+	return nil
+}
+
+func resourceLoginProtectDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	siteID := d.Get("site_id").(int)
+
+	log.Printf("[INFO] Disabling Incapsula Login Protect for site id: %d\n", siteID)
+
+	// Login Protect can't be removed from a site, it's disabled with the users of the account allowed
+	loginProtect := &imperva.LoginProtect{
+		Enabled:               false,
+		AllowAllUsers:         true,
+		AuthenticationMethods: expandStringSet(d.Get("authentication_methods").(*schema.Set)),
+	}
+	err := client.ConfigureLoginProtect(siteID, loginProtect)
+	if err != nil {
+		log.Printf("[ERROR] Could not disable Incapsula Login Protect for site id: %d, %s\n", siteID, err)
+		return err
+	}
+
+	client.InvalidateCachedSite(siteID)
+	d.SetId("")
+
+	log.Printf("[INFO] Disabled Incapsula Login Protect for site id: %d\n", siteID)
+
+	return nil
+}
+
+// expandStringSet returns the strings of the set sorted, so the requests don't depend on the hash order
+func expandStringSet(set *schema.Set) []string {
+	values := make([]string, 0, set.Len())
+	for _, value := range set.List() {
+		values = append(values, value.(string))
+	}
+	sort.Strings(values)
+	return values
+}
@@ -0,0 +1,79 @@
+package incapsula
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const loginProtectResourceType = "incapsula_login_protect"
+const loginProtectResourceName = "testacc-terraform-login-protect"
+const loginProtectResource = loginProtectResourceType + "." + loginProtectResourceName
+
+func TestAccIncapsulaLoginProtect_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaLoginProtectConfigBasic(true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaLoginProtectEnabled(loginProtectResource, true),
+					resource.TestCheckResourceAttr(loginProtectResource, "enabled", "true"),
+					resource.TestCheckResourceAttr(loginProtectResource, "authentication_methods.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIncapsulaLoginProtectConfigBasic(false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaLoginProtectEnabled(loginProtectResource, false),
+					resource.TestCheckResourceAttr(loginProtectResource, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      loginProtectResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckIncapsulaLoginProtectEnabled(name string, enabled bool) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		res, ok := state.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Incapsula Login Protect resource not found: %s", name)
+		}
+
+		siteID, err := strconv.Atoi(res.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Incapsula Login Protect ID is not a site ID: %s", res.Primary.ID)
+		}
+
+		client := testAccProvider.Meta().(*providerMeta).client
+		siteStatusResponse, err := client.SiteStatus("login-protect", siteID)
+		if err != nil {
+			return fmt.Errorf("Incapsula site id %d does not exist: %s", siteID, err)
+		}
+		if siteStatusResponse.LoginProtect.Enabled != enabled {
+			return fmt.Errorf("Incapsula Login Protect of site id %d is enabled: %t, expected %t", siteID, siteStatusResponse.LoginProtect.Enabled, enabled)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIncapsulaLoginProtectConfigBasic(enabled bool) string {
+	return testAccCheckIncapsulaSiteConfigBasic(GenerateTestDomain(nil)) + fmt.Sprintf(`
+	resource "%s" "%s" {
+		site_id                = %s.id
+		enabled                = %t
+		authentication_methods = ["ga", "email"]
+	}`,
+		loginProtectResourceType, loginProtectResourceName, siteResourceName, enabled,
+	)
+}
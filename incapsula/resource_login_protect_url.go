@@ -0,0 +1,226 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+func resourceLoginProtectURL() *schema.Resource {
+	return &schema.Resource{
+		Create: withSiteLock(resourceLoginProtectURLCreate),
+		Read:   resourceLoginProtectURLRead,
+		Update: withSiteLock(resourceLoginProtectURLUpdate),
+		Delete: withSiteLock(resourceLoginProtectURLDelete),
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				siteID, loginProtectURL, err := parseLoginProtectURLID(d.Id())
+				if err != nil {
+					return nil, err
+				}
+
+				d.Set("site_id", siteID)
+				d.Set("url", loginProtectURL)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		CustomizeDiff: validateSiteReference("site_id"),
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"site_id": {
+				Description: "Numeric identifier of the site to operate on.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"url": {
+				Description: "The URL to protect, e.g. /admin.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					// The URLs are sent as a comma separated list
+					if v := val.(string); strings.TrimSpace(v) == "" || strings.Contains(v, ",") {
+						errs = append(errs, fmt.Errorf("%q must be a non empty URL without commas, got: %s", key, v))
+					}
+					return
+				},
+			},
+
+			// Optional Arguments
+			"pattern": {
+				Description:  "How the requested URLs are matched against the url. Options are `contains`, `equals`, `prefix`, `suffix`, `not_equals`, `not_contain`, `not_prefix` and `not_suffix`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "prefix",
+				ValidateFunc: validateEnum(loginProtectURLPatternValues),
+			},
+		},
+	}
+}
+
+func resourceLoginProtectURLCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	siteID := d.Get("site_id").(int)
+	loginProtectURL := d.Get("url").(string)
+
+	log.Printf("[INFO] Adding Incapsula Login Protect URL %s for site id: %d\n", loginProtectURL, siteID)
+
+	loginProtectURLs, err := getLoginProtectURLs(client, siteID)
+	if err != nil {
+		return err
+	}
+	for _, existingURL := range loginProtectURLs {
+		if existingURL.URL == loginProtectURL {
+			return fmt.Errorf("Incapsula Login Protect URL %s is already protected for site id %d, import it with: terraform import incapsula_login_protect_url.<name> %s", loginProtectURL, siteID, loginProtectURLID(siteID, loginProtectURL))
+		}
+	}
+
+	loginProtectURLs = append(loginProtectURLs, imperva.LoginProtectURL{URL: loginProtectURL, Pattern: d.Get("pattern").(string)})
+	err = client.ConfigureLoginProtectURLs(siteID, loginProtectURLs)
+	if err != nil {
+		log.Printf("[ERROR] Could not add Incapsula Login Protect URL %s for site id: %d, %s\n", loginProtectURL, siteID, err)
+		return err
+	}
+
+	d.SetId(loginProtectURLID(siteID, loginProtectURL))
+
+	log.Printf("[INFO] Added Incapsula Login Protect URL %s for site id: %d\n", loginProtectURL, siteID)
+
+	client.InvalidateCachedSite(siteID)
+	return resourceLoginProtectURLRead(d, m)
+}
+
+func resourceLoginProtectURLRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	siteID, loginProtectURL, err := parseLoginProtectURLID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading Incapsula Login Protect URL %s for site id: %d\n", loginProtectURL, siteID)
+
+	siteStatusResponse, err := client.CachedSiteStatus("login-protect-url", siteID, 0)
+
+	// Site object may have been deleted
+	if siteStatusResponse != nil && siteStatusResponse.Res.(float64) == 9413 {
+		log.Printf("[INFO] Incapsula Site ID %d has already been deleted: %s\n", siteID, err)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula Login Protect URL %s for site id: %d, %s\n", loginProtectURL, siteID, err)
+		return err
+	}
+
+	for _, existingURL := range imperva.LoginProtectURLs(siteStatusResponse.LoginProtect.Urls, siteStatusResponse.LoginProtect.URLPatterns) {
+		if existingURL.URL == loginProtectURL {
+			d.Set("site_id", siteID)
+			d.Set("url", existingURL.URL)
+			d.Set("pattern", existingURL.Pattern)
+			return nil
+		}
+	}
+
+	// The URL may have been removed outside of Terraform
+	log.Printf("[INFO] Incapsula Login Protect URL %s for site id %d has already been removed\n", loginProtectURL, siteID)
+	d.SetId("")
+
+	return nil
+}
+
+func resourceLoginProtectURLUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	siteID := d.Get("site_id").(int)
+	loginProtectURL := d.Get("url").(string)
+
+	log.Printf("[INFO] Updating Incapsula Login Protect URL %s for site id: %d\n", loginProtectURL, siteID)
+
+	loginProtectURLs, err := getLoginProtectURLs(client, siteID)
+	if err != nil {
+		return err
+	}
+	for i := range loginProtectURLs {
+		if loginProtectURLs[i].URL == loginProtectURL {
+			loginProtectURLs[i].Pattern = d.Get("pattern").(string)
+		}
+	}
+
+	err = client.ConfigureLoginProtectURLs(siteID, loginProtectURLs)
+	if err != nil {
+		log.Printf("[ERROR] Could not update Incapsula Login Protect URL %s for site id: %d, %s\n", loginProtectURL, siteID, err)
+		return err
+	}
+
+	client.InvalidateCachedSite(siteID)
+	return resourceLoginProtectURLRead(d, m)
+}
+
+func resourceLoginProtectURLDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	siteID := d.Get("site_id").(int)
+	loginProtectURL := d.Get("url").(string)
+
+	log.Printf("[INFO] Removing Incapsula Login Protect URL %s for site id: %d\n", loginProtectURL, siteID)
+
+	loginProtectURLs, err := getLoginProtectURLs(client, siteID)
+	if err != nil {
+		return err
+	}
+	remainingURLs := make([]imperva.LoginProtectURL, 0, len(loginProtectURLs))
+	for _, existingURL := range loginProtectURLs {
+		if existingURL.URL != loginProtectURL {
+			remainingURLs = append(remainingURLs, existingURL)
+		}
+	}
+
+	err = client.ConfigureLoginProtectURLs(siteID, remainingURLs)
+	if err != nil {
+		log.Printf("[ERROR] Could not remove Incapsula Login Protect URL %s for site id: %d, %s\n", loginProtectURL, siteID, err)
+		return err
+	}
+
+	client.InvalidateCachedSite(siteID)
+	d.SetId("")
+
+	log.Printf("[INFO] Removed Incapsula Login Protect URL %s for site id: %d\n", loginProtectURL, siteID)
+
+	return nil
+}
+
+// getLoginProtectURLs gets the current protected URLs of the site, the URLs of the site are changed together
+// so the status is read instead of using the listed details
+func getLoginProtectURLs(client *imperva.Client, siteID int) ([]imperva.LoginProtectURL, error) {
+	siteStatusResponse, err := client.SiteStatus("login-protect-url", siteID)
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula Login Protect URLs for site id: %d, %s\n", siteID, err)
+		return nil, err
+	}
+
+	return imperva.LoginProtectURLs(siteStatusResponse.LoginProtect.Urls, siteStatusResponse.LoginProtect.URLPatterns), nil
+}
+
+func loginProtectURLID(siteID int, loginProtectURL string) string {
+	return fmt.Sprintf("%d/%s", siteID, loginProtectURL)
+}
+
+func parseLoginProtectURLID(id string) (int, string, error) {
+	idSlice := strings.SplitN(id, "/", 2)
+	if len(idSlice) != 2 || idSlice[1] == "" {
+		return 0, "", fmt.Errorf("unexpected format of ID (%q), expected site_id/url", id)
+	}
+
+	siteID, err := strconv.Atoi(idSlice[0])
+	if err != nil {
+		return 0, "", fmt.Errorf("unexpected format of ID (%q), expected site_id/url", id)
+	}
+
+	return siteID, idSlice[1], nil
+}
@@ -0,0 +1,63 @@
+package incapsula
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const loginProtectURLResourceType = "incapsula_login_protect_url"
+const loginProtectURLResourceName = "testacc-terraform-login-protect-url"
+const loginProtectURLResource = loginProtectURLResourceType + "." + loginProtectURLResourceName
+
+func TestAccIncapsulaLoginProtectURL_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaLoginProtectURLConfigBasic("prefix"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(loginProtectURLResource, "url", "/admin"),
+					resource.TestCheckResourceAttr(loginProtectURLResource, "pattern", "prefix"),
+				),
+			},
+			{
+				Config: testAccCheckIncapsulaLoginProtectURLConfigBasic("equals"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(loginProtectURLResource, "pattern", "equals"),
+				),
+			},
+			{
+				ResourceName:      loginProtectURLResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestParseLoginProtectURLID(t *testing.T) {
+	siteID, loginProtectURL, err := parseLoginProtectURLID(loginProtectURLID(123, "/admin/login"))
+	if err != nil || siteID != 123 || loginProtectURL != "/admin/login" {
+		t.Errorf("Should have parsed the ID, got: %d, %s, %v", siteID, loginProtectURL, err)
+	}
+
+	for _, id := range []string{"123", "123/", "abc//admin"} {
+		if _, _, err := parseLoginProtectURLID(id); err == nil {
+			t.Errorf("Should have received an error for %s", id)
+		}
+	}
+}
+
+func testAccCheckIncapsulaLoginProtectURLConfigBasic(pattern string) string {
+	return testAccCheckIncapsulaSiteConfigBasic(GenerateTestDomain(nil)) + fmt.Sprintf(`
+	resource "%s" "%s" {
+		site_id = %s.id
+		url     = "/admin"
+		pattern = "%s"
+	}`,
+		loginProtectURLResourceType, loginProtectURLResourceName, siteResourceName, pattern,
+	)
+}
@@ -0,0 +1,283 @@
+package incapsula
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+// managedCertificateDomainValidated is the status of the domains whose validation is done
+const managedCertificateDomainValidated = "VALIDATED"
+
+func resourceManagedCertificateValidation() *schema.Resource {
+	return &schema.Resource{
+		Create: withSiteLock(resourceManagedCertificateValidationUpdate),
+		Read:   resourceManagedCertificateValidationRead,
+		Update: withSiteLock(resourceManagedCertificateValidationUpdate),
+		Delete: resourceManagedCertificateValidationDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				siteID, err := strconv.Atoi(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				d.Set("site_id", siteID)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		CustomizeDiff: customdiff.All(validateSiteReference("site_id"), validateManagedCertificateValidation),
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"site_id": {
+				Description: "Numeric identifier of the site to operate on.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"domain_validation": {
+				Description: "The validation method of a domain of the managed certificate.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain": {
+							Description: "The domain, as listed in the certificate, e.g. `*.example.com`.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"validation_method": {
+							Description:  "The validation method of the domain. Possible values: `email`, `html`, `dns`.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateEnum(siteDomainValidationValues),
+						},
+					},
+				},
+			},
+
+			// Computed Attributes
+			"pending_validation": {
+				Description: "The domains of the managed certificate which aren't validated yet, with what to do for their validation.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain": {
+							Description: "The domain.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"validation_method": {
+							Description: "The validation method of the domain.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "The validation status of the domain, e.g. `PENDING_USER_ACTION`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"record_type": {
+							Description: "The type of the DNS record to add, with the `dns` validation method.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"record_name": {
+							Description: "The name of the DNS record to add, with the `dns` validation method.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"verification_code": {
+							Description: "The value of the DNS record with the `dns` validation method, the content of the file with the `html` validation method.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"file_path": {
+							Description: "The path the file is served at, with the `html` validation method.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"approver_emails": {
+							Description: "The addresses the approval email can be sent to, with the `email` validation method.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// validateManagedCertificateValidation checks the domains are listed once and the wildcard domains aren't validated
+// with a file, which can't be served for all their subdomains
+func validateManagedCertificateValidation(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	domains := make(map[string]bool)
+	for _, domainValidation := range diff.Get("domain_validation").(*schema.Set).List() {
+		domainValidation := domainValidation.(map[string]interface{})
+		domain := strings.ToLower(domainValidation["domain"].(string))
+		if domain == "" {
+			continue
+		}
+		if domains[domain] {
+			return fmt.Errorf("domain %s is listed more than once in domain_validation", domain)
+		}
+		domains[domain] = true
+
+		if strings.HasPrefix(domain, "*.") && domainValidation["validation_method"].(string) == "html" {
+			return fmt.Errorf("the wildcard domain %s can't be validated with the html validation method", domain)
+		}
+	}
+	return nil
+}
+
+// findManagedCertificateDomain returns the certificate and the domain matching the name, or nil when the managed
+// certificates of the site don't list it
+func findManagedCertificateDomain(certificates []imperva.ManagedCertificate, domain string) (*imperva.ManagedCertificate, *imperva.ManagedCertificateDomain) {
+	for i := range certificates {
+		for j := range certificates[i].Sans {
+			if strings.EqualFold(certificates[i].Sans[j].SanValue, domain) {
+				return &certificates[i], &certificates[i].Sans[j]
+			}
+		}
+	}
+	return nil, nil
+}
+
+func resourceManagedCertificateValidationUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	siteID := d.Get("site_id").(int)
+
+	log.Printf("[INFO] Updating Incapsula managed certificate validation methods for site id: %d\n", siteID)
+
+	certificates, _, err := client.ListManagedCertificates(siteID)
+	if err != nil {
+		log.Printf("[ERROR] Could not list Incapsula managed certificates for site id: %d, %s\n", siteID, err)
+		return err
+	}
+
+	for _, domainValidation := range d.Get("domain_validation").(*schema.Set).List() {
+		domainValidation := domainValidation.(map[string]interface{})
+		domain := domainValidation["domain"].(string)
+		validationMethod := strings.ToUpper(domainValidation["validation_method"].(string))
+
+		certificate, san := findManagedCertificateDomain(certificates, domain)
+		if san == nil {
+			return fmt.Errorf("Domain %s is not a domain of the managed certificate of site id: %d", domain, siteID)
+		}
+		if strings.EqualFold(san.ValidationMethod, validationMethod) {
+			continue
+		}
+
+		err = client.UpdateManagedCertificateDomainValidationMethod(siteID, certificate.ID, san.SanID, validationMethod)
+		if err != nil {
+			log.Printf("[ERROR] Could not set Incapsula managed certificate validation method (%s) of domain %s for site id: %d, %s\n", validationMethod, domain, siteID, err)
+			return err
+		}
+	}
+
+	d.SetId(strconv.Itoa(siteID))
+
+	log.Printf("[INFO] Updated Incapsula managed certificate validation methods for site id: %d\n", siteID)
+
+	return resourceManagedCertificateValidationRead(d, m)
+}
+
+func resourceManagedCertificateValidationRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	siteID, _ := strconv.Atoi(d.Id())
+
+	log.Printf("[INFO] Reading Incapsula managed certificate validation methods for site id: %d\n", siteID)
+
+	certificates, statusCode, err := client.ListManagedCertificates(siteID)
+
+	// Site object may have been deleted
+	if statusCode == 404 {
+		log.Printf("[INFO] Incapsula Site ID %d has already been deleted: %s\n", siteID, err)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula managed certificate validation methods for site id: %d, %s\n", siteID, err)
+		return err
+	}
+
+	// Only the configured domains are managed, all the domains are imported
+	configuredDomains := make(map[string]bool)
+	if v, ok := d.GetOk("domain_validation"); ok {
+		for _, domainValidation := range v.(*schema.Set).List() {
+			configuredDomains[strings.ToLower(domainValidation.(map[string]interface{})["domain"].(string))] = true
+		}
+	}
+
+	domainValidations := make([]map[string]interface{}, 0)
+	pendingValidations := make([]map[string]interface{}, 0)
+	instructions := make(map[string]map[string]imperva.ManagedCertificateValidationInstruction)
+	for _, certificate := range certificates {
+		for _, san := range certificate.Sans {
+			if len(configuredDomains) == 0 || configuredDomains[strings.ToLower(san.SanValue)] {
+				domainValidations = append(domainValidations, map[string]interface{}{
+					"domain":            san.SanValue,
+					"validation_method": strings.ToLower(san.ValidationMethod),
+				})
+			}
+
+			if san.Status == managedCertificateDomainValidated {
+				continue
+			}
+
+			// The instructions are listed once per validation method
+			if _, ok := instructions[san.ValidationMethod]; !ok {
+				instructions[san.ValidationMethod] = make(map[string]imperva.ManagedCertificateValidationInstruction)
+				methodInstructions, err := client.GetManagedCertificateValidationInstructions(siteID, san.ValidationMethod)
+				if err != nil {
+					log.Printf("[ERROR] Could not read Incapsula managed certificate validation instructions (%s) for site id: %d, %s\n", san.ValidationMethod, siteID, err)
+					return err
+				}
+				for _, instruction := range methodInstructions {
+					instructions[san.ValidationMethod][strings.ToLower(instruction.Domain)] = instruction
+				}
+			}
+
+			instruction := instructions[san.ValidationMethod][strings.ToLower(san.SanValue)]
+			pendingValidations = append(pendingValidations, map[string]interface{}{
+				"domain":            san.SanValue,
+				"validation_method": strings.ToLower(san.ValidationMethod),
+				"status":            san.Status,
+				"record_type":       instruction.RecordType,
+				"record_name":       instruction.RecordName,
+				"verification_code": instruction.VerificationCode,
+				"file_path":         instruction.FilePath,
+				"approver_emails":   instruction.ApproverEmails,
+			})
+		}
+	}
+
+	d.Set("site_id", siteID)
+	d.Set("domain_validation", domainValidations)
+	d.Set("pending_validation", pendingValidations)
+
+	log.Printf("[INFO] Finished reading Incapsula managed certificate validation methods for site id: %d\n", siteID)
+
+	return nil
+}
+
+func resourceManagedCertificateValidationDelete(d *schema.ResourceData, m interface{}) error {
+	// The domains keep their validation method, they're only no longer managed
+	log.Printf("[INFO] Removing Incapsula managed certificate validation methods for site id: %s from the state\n", d.Id())
+	d.SetId("")
+	return nil
+}
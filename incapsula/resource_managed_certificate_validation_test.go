@@ -0,0 +1,48 @@
+package incapsula
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const managedCertificateValidationResourceType = "incapsula_managed_certificate_validation"
+const managedCertificateValidationResourceName = "testacc-terraform-managed-certificate-validation"
+const managedCertificateValidationResource = managedCertificateValidationResourceType + "." + managedCertificateValidationResourceName
+
+func TestAccIncapsulaManagedCertificateValidation_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaManagedCertificateValidationConfigBasic("dns"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(managedCertificateValidationResource, "domain_validation.0.validation_method", "dns"),
+					resource.TestCheckResourceAttr(managedCertificateValidationResource, "pending_validation.0.validation_method", "dns"),
+					resource.TestCheckResourceAttrSet(managedCertificateValidationResource, "pending_validation.0.verification_code"),
+				),
+			},
+			{
+				Config: testAccCheckIncapsulaManagedCertificateValidationConfigBasic("email"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(managedCertificateValidationResource, "domain_validation.0.validation_method", "email"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIncapsulaManagedCertificateValidationConfigBasic(validationMethod string) string {
+	return testAccCheckIncapsulaSiteConfigBasic(GenerateTestDomain(nil)) + fmt.Sprintf(`
+	resource "%s" "%s" {
+		site_id = %s.id
+		domain_validation {
+			domain            = %s.domain
+			validation_method = "%s"
+		}
+	}`,
+		managedCertificateValidationResourceType, managedCertificateValidationResourceName, siteResourceName, siteResourceName, validationMethod,
+	)
+}
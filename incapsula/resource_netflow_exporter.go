@@ -0,0 +1,152 @@
+package incapsula
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+func resourceNetflowExporter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetflowExporterCreate,
+		Read:   resourceNetflowExporterRead,
+		Update: resourceNetflowExporterUpdate,
+		Delete: resourceNetflowExporterDelete,
+		Importer: &schema.ResourceImporter{
+			State: infraProtectImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"name": {
+				Description: "The name of the exporter.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"collector_ip": {
+				Description:  "The IP address of the collector receiving the flow data.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"port": {
+				Description:  "The UDP port of the collector.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+
+			// Optional Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account to operate on. If not specified, operation will be performed on the account identified by the authentication parameters.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"sampling_rate": {
+				Description:  "Export one out of every sampling_rate flows. Use 1 to export all flows.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"protocol_version": {
+				Description:  "The flow protocol version. Options are `NETFLOW_V5`, `NETFLOW_V9` and `IPFIX`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "IPFIX",
+				ValidateFunc: validation.StringInSlice([]string{"NETFLOW_V5", "NETFLOW_V9", "IPFIX"}, false),
+			},
+			"enabled": {
+				Description: "Whether the exporter is enabled.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+		},
+	}
+}
+
+func resourceNetflowExporterCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	exporter, err := client.AddNetflowExporter(accountID, netflowExporterFromResourceData(d))
+	if err != nil {
+		log.Printf("[ERROR] Could not create Incapsula netflow exporter %s for account %d: %s\n", d.Get("name"), accountID, err)
+		return err
+	}
+
+	d.SetId(exporter.ID)
+	log.Printf("[INFO] Created Incapsula netflow exporter %s for account %d\n", d.Id(), accountID)
+
+	return resourceNetflowExporterRead(d, m)
+}
+
+func resourceNetflowExporterRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	exporter, statusCode, err := client.GetNetflowExporter(accountID, d.Id())
+
+	// If the exporter is deleted on the server, blow it out locally and run through the normal TF cycle
+	if statusCode == 404 {
+		log.Printf("[INFO] Incapsula netflow exporter %s has already been deleted: %s\n", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula netflow exporter %s: %s\n", d.Id(), err)
+		return err
+	}
+
+	d.Set("name", exporter.Name)
+	d.Set("collector_ip", exporter.CollectorIP)
+	d.Set("port", exporter.Port)
+	d.Set("sampling_rate", exporter.SamplingRate)
+	d.Set("protocol_version", exporter.ProtocolVersion)
+	d.Set("enabled", exporter.Enabled)
+
+	return nil
+}
+
+func resourceNetflowExporterUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	_, err := client.UpdateNetflowExporter(accountID, d.Id(), netflowExporterFromResourceData(d))
+	if err != nil {
+		log.Printf("[ERROR] Could not update Incapsula netflow exporter %s: %s\n", d.Id(), err)
+		return err
+	}
+
+	return resourceNetflowExporterRead(d, m)
+}
+
+func resourceNetflowExporterDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+
+	err := client.DeleteNetflowExporter(d.Get("account_id").(int), d.Id())
+	if err != nil {
+		log.Printf("[ERROR] Could not delete Incapsula netflow exporter %s: %s\n", d.Id(), err)
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func netflowExporterFromResourceData(d *schema.ResourceData) *imperva.NetflowExporter {
+	return &imperva.NetflowExporter{
+		Name:            d.Get("name").(string),
+		CollectorIP:     d.Get("collector_ip").(string),
+		Port:            d.Get("port").(int),
+		SamplingRate:    d.Get("sampling_rate").(int),
+		ProtocolVersion: d.Get("protocol_version").(string),
+		Enabled:         d.Get("enabled").(bool),
+	}
+}
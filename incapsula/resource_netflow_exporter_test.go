@@ -0,0 +1,97 @@
+package incapsula
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const netflowExporterResourceType = "incapsula_netflow_exporter"
+const netflowExporterResourceName = "testacc-terraform-netflow-exporter"
+const netflowExporterResource = netflowExporterResourceType + "." + netflowExporterResourceName
+
+func TestAccIncapsulaNetflowExporter_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIncapsulaNetflowExporterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaNetflowExporterConfigBasic(1, "IPFIX"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaNetflowExporterExists(netflowExporterResource),
+					resource.TestCheckResourceAttr(netflowExporterResource, "collector_ip", "192.0.2.10"),
+					resource.TestCheckResourceAttr(netflowExporterResource, "port", "2055"),
+					resource.TestCheckResourceAttr(netflowExporterResource, "sampling_rate", "1"),
+					resource.TestCheckResourceAttr(netflowExporterResource, "protocol_version", "IPFIX"),
+				),
+			},
+			{
+				Config: testAccCheckIncapsulaNetflowExporterConfigBasic(100, "NETFLOW_V9"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaNetflowExporterExists(netflowExporterResource),
+					resource.TestCheckResourceAttr(netflowExporterResource, "sampling_rate", "100"),
+					resource.TestCheckResourceAttr(netflowExporterResource, "protocol_version", "NETFLOW_V9"),
+				),
+			},
+			{
+				ResourceName:      netflowExporterResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckIncapsulaNetflowExporterExists(name string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		res, ok := state.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Incapsula netflow exporter resource not found: %s", name)
+		}
+
+		if res.Primary.ID == "" {
+			return fmt.Errorf("Incapsula netflow exporter ID does not exist")
+		}
+
+		client := testAccProvider.Meta().(*providerMeta).client
+		_, _, err := client.GetNetflowExporter(0, res.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Incapsula netflow exporter %s does not exist: %s", res.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIncapsulaNetflowExporterDestroy(state *terraform.State) error {
+	client := testAccProvider.Meta().(*providerMeta).client
+
+	for _, res := range state.RootModule().Resources {
+		if res.Type != netflowExporterResourceType {
+			continue
+		}
+
+		_, statusCode, _ := client.GetNetflowExporter(0, res.Primary.ID)
+		if statusCode != 404 {
+			return fmt.Errorf("Incapsula netflow exporter %s still exists", res.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIncapsulaNetflowExporterConfigBasic(samplingRate int, protocolVersion string) string {
+	return fmt.Sprintf(`
+	resource "%s" "%s" {
+		name             = "testacc-terraform-netflow-exporter"
+		collector_ip     = "192.0.2.10"
+		port             = 2055
+		sampling_rate    = %d
+		protocol_version = "%s"
+	}`,
+		netflowExporterResourceType, netflowExporterResourceName, samplingRate, protocolVersion,
+	)
+}
@@ -0,0 +1,228 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+// Network DDoS mitigation modes
+const networkDDoSModeAlwaysOn = "ALWAYS_ON"
+const networkDDoSModeOnDemand = "ON_DEMAND"
+
+// networkDDoSThresholdUnit is a unit of a threshold, from the largest to the smallest
+type networkDDoSThresholdUnit struct {
+	Name       string
+	Multiplier int64
+}
+
+var networkDDoSBandwidthUnits = []networkDDoSThresholdUnit{
+	{"Gbps", 1000 * 1000 * 1000},
+	{"Mbps", 1000 * 1000},
+	{"Kbps", 1000},
+	{"bps", 1},
+}
+
+var networkDDoSPacketRateUnits = []networkDDoSThresholdUnit{
+	{"Mpps", 1000 * 1000},
+	{"Kpps", 1000},
+	{"pps", 1},
+}
+
+var networkDDoSThresholdPattern = regexp.MustCompile(`^([1-9][0-9]*)\s*([A-Za-z]+)$`)
+
+func resourceNetworkDDoSSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkDDoSSettingsUpdate,
+		Read:   resourceNetworkDDoSSettingsRead,
+		Update: resourceNetworkDDoSSettingsUpdate,
+		Delete: resourceNetworkDDoSSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				_, err := infraProtectImportState(d, m)
+				if err != nil {
+					return nil, err
+				}
+				d.Set("protected_ip_range_id", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"protected_ip_range_id": {
+				Description: "The ID of the protected IP range.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			// Optional Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account to operate on. If not specified, operation will be performed on the account identified by the authentication parameters.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"mode": {
+				Description:  "The mitigation mode. Options are `ALWAYS_ON` and `ON_DEMAND`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      networkDDoSModeAlwaysOn,
+				ValidateFunc: validation.StringInSlice([]string{networkDDoSModeAlwaysOn, networkDDoSModeOnDemand}, false),
+			},
+			"bandwidth_threshold": {
+				Description:      "Traffic above this bandwidth is mitigated, e.g. `500Mbps`. Units are `bps`, `Kbps`, `Mbps` and `Gbps`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateNetworkDDoSThreshold(networkDDoSBandwidthUnits),
+				DiffSuppressFunc: suppressEquivalentNetworkDDoSThresholds(networkDDoSBandwidthUnits),
+			},
+			"packet_rate_threshold": {
+				Description:      "Traffic above this packet rate is mitigated, e.g. `100Kpps`. Units are `pps`, `Kpps` and `Mpps`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateNetworkDDoSThreshold(networkDDoSPacketRateUnits),
+				DiffSuppressFunc: suppressEquivalentNetworkDDoSThresholds(networkDDoSPacketRateUnits),
+			},
+		},
+	}
+}
+
+func resourceNetworkDDoSSettingsRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	settings, statusCode, err := client.GetNetworkDDoSSettings(accountID, d.Id())
+
+	// If the range is deleted on the server, blow it out locally and run through the normal TF cycle
+	if statusCode == 404 {
+		log.Printf("[INFO] Incapsula protected IP range %s has already been deleted: %s\n", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula network DDoS settings for protected IP range %s: %s\n", d.Id(), err)
+		return err
+	}
+
+	d.Set("protected_ip_range_id", d.Id())
+	d.Set("mode", settings.Mode)
+	d.Set("bandwidth_threshold", formatNetworkDDoSThreshold(settings.BandwidthThresholdBps, networkDDoSBandwidthUnits))
+	d.Set("packet_rate_threshold", formatNetworkDDoSThreshold(settings.PacketRateThresholdPps, networkDDoSPacketRateUnits))
+
+	return nil
+}
+
+func resourceNetworkDDoSSettingsUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+	rangeID := d.Get("protected_ip_range_id").(string)
+
+	// The values were validated at plan time
+	bandwidthThreshold, _ := parseNetworkDDoSThreshold(d.Get("bandwidth_threshold").(string), networkDDoSBandwidthUnits)
+	packetRateThreshold, _ := parseNetworkDDoSThreshold(d.Get("packet_rate_threshold").(string), networkDDoSPacketRateUnits)
+
+	settings := imperva.NetworkDDoSSettings{
+		Mode:                   d.Get("mode").(string),
+		BandwidthThresholdBps:  bandwidthThreshold,
+		PacketRateThresholdPps: packetRateThreshold,
+	}
+
+	_, err := client.UpdateNetworkDDoSSettings(accountID, rangeID, &settings)
+	if err != nil {
+		log.Printf("[ERROR] Could not update Incapsula network DDoS settings for protected IP range %s: %s\n", rangeID, err)
+		return err
+	}
+
+	d.SetId(rangeID)
+
+	return resourceNetworkDDoSSettingsRead(d, m)
+}
+
+func resourceNetworkDDoSSettingsDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+
+	// Deleting the settings is just restoring the defaults
+	settings := imperva.NetworkDDoSSettings{
+		Mode: networkDDoSModeAlwaysOn,
+	}
+
+	_, err := client.UpdateNetworkDDoSSettings(d.Get("account_id").(int), d.Id(), &settings)
+	if err != nil {
+		log.Printf("[ERROR] Could not reset Incapsula network DDoS settings for protected IP range %s: %s\n", d.Id(), err)
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// parseNetworkDDoSThreshold converts a threshold such as 500Mbps to the base unit. An empty threshold is 0.
+func parseNetworkDDoSThreshold(threshold string, units []networkDDoSThresholdUnit) (int64, error) {
+	if threshold == "" {
+		return 0, nil
+	}
+
+	names := make([]string, 0, len(units))
+	for _, unit := range units {
+		names = append(names, unit.Name)
+	}
+
+	match := networkDDoSThresholdPattern.FindStringSubmatch(threshold)
+	if match == nil {
+		return 0, fmt.Errorf("invalid threshold %q, expected a positive number followed by one of the units %v", threshold, names)
+	}
+
+	value, err := strconv.ParseInt(match[1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid threshold %q: %s", threshold, err)
+	}
+
+	for _, unit := range units {
+		if unit.Name == match[2] {
+			return value * unit.Multiplier, nil
+		}
+	}
+
+	return 0, fmt.Errorf("invalid unit %q of threshold %q, expected one of the units %v", match[2], threshold, names)
+}
+
+// formatNetworkDDoSThreshold converts a threshold in the base unit to the largest unit which represents it exactly
+func formatNetworkDDoSThreshold(value int64, units []networkDDoSThresholdUnit) string {
+	if value == 0 {
+		return ""
+	}
+
+	for _, unit := range units {
+		if value%unit.Multiplier == 0 {
+			return fmt.Sprintf("%d%s", value/unit.Multiplier, unit.Name)
+		}
+	}
+
+	return strconv.FormatInt(value, 10)
+}
+
+func validateNetworkDDoSThreshold(units []networkDDoSThresholdUnit) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) ([]string, []error) {
+		if _, err := parseNetworkDDoSThreshold(i.(string), units); err != nil {
+			return nil, []error{fmt.Errorf("%s: %s", k, err)}
+		}
+		return nil, nil
+	}
+}
+
+func suppressEquivalentNetworkDDoSThresholds(units []networkDDoSThresholdUnit) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		oldValue, oldErr := parseNetworkDDoSThreshold(old, units)
+		newValue, newErr := parseNetworkDDoSThreshold(new, units)
+		return oldErr == nil && newErr == nil && oldValue == newValue
+	}
+}
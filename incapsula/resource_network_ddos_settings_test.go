@@ -0,0 +1,131 @@
+package incapsula
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const networkDDoSSettingsResourceType = "incapsula_network_ddos_settings"
+const networkDDoSSettingsResourceName = "testacc-terraform-network-ddos-settings"
+const networkDDoSSettingsResource = networkDDoSSettingsResourceType + "." + networkDDoSSettingsResourceName
+
+func TestParseNetworkDDoSThreshold(t *testing.T) {
+	validThresholds := map[string]int64{
+		"":        0,
+		"1bps":    1,
+		"500Mbps": 500000000,
+		"2 Gbps":  2000000000,
+		"10Kbps":  10000,
+	}
+	for threshold, expected := range validThresholds {
+		value, err := parseNetworkDDoSThreshold(threshold, networkDDoSBandwidthUnits)
+		if err != nil {
+			t.Errorf("Should not have received an error for %q, got: %s", threshold, err)
+		}
+		if value != expected {
+			t.Errorf("Should have parsed %q as %d, got: %d", threshold, expected, value)
+		}
+	}
+
+	for _, threshold := range []string{"500", "500mbps", "500Kpps", "0Mbps", "-1Mbps", "1.5Gbps", "Mbps"} {
+		_, err := parseNetworkDDoSThreshold(threshold, networkDDoSBandwidthUnits)
+		if err == nil {
+			t.Errorf("Should have received an error for %q", threshold)
+		}
+	}
+
+	value, err := parseNetworkDDoSThreshold("100Kpps", networkDDoSPacketRateUnits)
+	if err != nil || value != 100000 {
+		t.Errorf("Should have parsed 100Kpps as 100000, got: %d, %v", value, err)
+	}
+}
+
+func TestFormatNetworkDDoSThreshold(t *testing.T) {
+	thresholds := map[int64]string{
+		0:          "",
+		1500:       "1500bps",
+		500000000:  "500Mbps",
+		2000000000: "2Gbps",
+	}
+	for value, expected := range thresholds {
+		threshold := formatNetworkDDoSThreshold(value, networkDDoSBandwidthUnits)
+		if threshold != expected {
+			t.Errorf("Should have formatted %d as %q, got: %q", value, expected, threshold)
+		}
+	}
+}
+
+func TestSuppressEquivalentNetworkDDoSThresholds(t *testing.T) {
+	suppress := suppressEquivalentNetworkDDoSThresholds(networkDDoSBandwidthUnits)
+
+	if !suppress("", "1Gbps", "1000Mbps", nil) {
+		t.Errorf("1Gbps and 1000Mbps should be equivalent")
+	}
+	if suppress("", "1Gbps", "100Mbps", nil) {
+		t.Errorf("1Gbps and 100Mbps should not be equivalent")
+	}
+}
+
+func TestAccIncapsulaNetworkDDoSSettings_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIncapsulaProtectedIPRangeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaNetworkDDoSSettingsConfigBasic("ALWAYS_ON", "1000Mbps"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaNetworkDDoSSettingsMode(networkDDoSSettingsResource, "ALWAYS_ON"),
+					resource.TestCheckResourceAttr(networkDDoSSettingsResource, "bandwidth_threshold", "1Gbps"),
+				),
+			},
+			{
+				Config: testAccCheckIncapsulaNetworkDDoSSettingsConfigBasic("ON_DEMAND", "500Mbps"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaNetworkDDoSSettingsMode(networkDDoSSettingsResource, "ON_DEMAND"),
+					resource.TestCheckResourceAttr(networkDDoSSettingsResource, "bandwidth_threshold", "500Mbps"),
+				),
+			},
+			{
+				ResourceName:      networkDDoSSettingsResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckIncapsulaNetworkDDoSSettingsMode(name, mode string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		res, ok := state.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Incapsula network DDoS settings resource not found: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*providerMeta).client
+		settings, _, err := client.GetNetworkDDoSSettings(0, res.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Incapsula network DDoS settings for protected IP range %s do not exist: %s", res.Primary.ID, err)
+		}
+		if settings.Mode != mode {
+			return fmt.Errorf("Incapsula network DDoS mode for protected IP range %s is %s, expected %s", res.Primary.ID, settings.Mode, mode)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIncapsulaNetworkDDoSSettingsConfigBasic(mode, bandwidthThreshold string) string {
+	return testAccCheckIncapsulaProtectedIPRangeConfigBasic(true) + fmt.Sprintf(`
+	resource "%s" "%s" {
+		protected_ip_range_id = %s.id
+		mode                  = "%s"
+		bandwidth_threshold   = "%s"
+		packet_rate_threshold = "100Kpps"
+	}`,
+		networkDDoSSettingsResourceType, networkDDoSSettingsResourceName, protectedIPRangeResource, mode, bandwidthThreshold,
+	)
+}
@@ -2,11 +2,13 @@ package incapsula
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	"strconv"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
 )
 
 var assetResource = schema.Resource{
@@ -101,7 +103,8 @@ func resourceNotificationCenterPolicy() *schema.Resource {
 				Elem: &schema.Schema{
 					Type: schema.TypeInt,
 				},
-				Optional: true,
+				Optional:         true,
+				DiffSuppressFunc: suppressEquivalentUnorderedListDiffs,
 			},
 			"emailchannel_external_recipient_list": {
 				Description: "List of external email to get the notifications (not Imperva users)",
@@ -109,7 +112,8 @@ func resourceNotificationCenterPolicy() *schema.Resource {
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
-				Optional: true,
+				Optional:         true,
+				DiffSuppressFunc: suppressEquivalentUnorderedListDiffs,
 			},
 
 			"asset": {
@@ -154,14 +158,15 @@ func resourceNotificationCenterPolicy() *schema.Resource {
 				Elem: &schema.Schema{
 					Type: schema.TypeInt,
 				},
-				Optional: true,
+				Optional:         true,
+				DiffSuppressFunc: suppressEquivalentUnorderedListDiffs,
 			},
 		},
 	}
 }
 
 func resourceNotificationCenterPolicyUpdate(data *schema.ResourceData, i interface{}) error {
-	client := i.(*Client)
+	client := i.(*providerMeta).client
 	notificationCenterPolicyName := data.Get("policy_name").(string)
 	notificationCenterPolicyId, _ := getPolicyId(data)
 	accountId := data.Get("account_id").(int)
@@ -181,7 +186,7 @@ func resourceNotificationCenterPolicyUpdate(data *schema.ResourceData, i interfa
 }
 
 func resourceNotificationCenterPolicyCreate(data *schema.ResourceData, i interface{}) error {
-	client := i.(*Client)
+	client := i.(*providerMeta).client
 	notificationCenterPolicyName := data.Get("policy_name").(string)
 	log.Printf("[INFO] Creating NotificationCenterPolicy: %s\n", notificationCenterPolicyName)
 	notificationPolicyFullDto := getNotificationCenterPolicyFromResource(data)
@@ -204,7 +209,7 @@ func resourceNotificationCenterPolicyCreate(data *schema.ResourceData, i interfa
 
 //This function get all the properties of NotificationCenterPolicy from the resource,
 //so we can share it with create & update function
-func getNotificationCenterPolicyFromResource(data *schema.ResourceData) NotificationPolicyFullDto {
+func getNotificationCenterPolicyFromResource(data *schema.ResourceData) imperva.NotificationPolicyFullDto {
 	policyId, _ := getPolicyId(data)
 	log.Printf(
 		"[INFO] policyId: %d\n"+
@@ -235,17 +240,17 @@ func getNotificationCenterPolicyFromResource(data *schema.ResourceData) Notifica
 	assetList := getAssetsFromResource(data)
 	subAccountsDtoList := getSubAccountsDtoListFromResource(data)
 	notificationChannelList := getEmailChannelFromResource(data)
-	notificationPolicyFullDto := NotificationPolicyFullDto{
+	notificationPolicyFullDto := imperva.NotificationPolicyFullDto{
 		PolicyId:                policyId,
 		AccountId:               data.Get("account_id").(int),
 		PolicyName:              data.Get("policy_name").(string),
 		Status:                  data.Get("status").(string),
 		SubCategory:             data.Get("sub_category").(string),
-		NotificationChannelList: []NotificationChannelEmailDto{notificationChannelList},
+		NotificationChannelList: []imperva.NotificationChannelEmailDto{notificationChannelList},
 		AssetList:               assetList,
 		ApplyToNewAssets:        data.Get("apply_to_new_assets").(string),
 		PolicyType:              data.Get("policy_type").(string),
-		SubAccountPolicyInfo: SubAccountPolicyInfo{
+		SubAccountPolicyInfo: imperva.SubAccountPolicyInfo{
 			ApplyToNewSubAccounts: data.Get("apply_to_new_sub_accounts").(string),
 			SubAccountList:        subAccountsDtoList,
 		},
@@ -255,11 +260,11 @@ func getNotificationCenterPolicyFromResource(data *schema.ResourceData) Notifica
 	return notificationPolicyFullDto
 }
 
-func getEmailChannelFromResource(data *schema.ResourceData) NotificationChannelEmailDto {
-	var userRecipientDto []RecipientDto
+func getEmailChannelFromResource(data *schema.ResourceData) imperva.NotificationChannelEmailDto {
+	var userRecipientDto []imperva.RecipientDto
 	usersIds := data.Get("emailchannel_user_recipient_list").([]interface{})
 	for _, userId := range usersIds {
-		recipientDto := RecipientDto{
+		recipientDto := imperva.RecipientDto{
 			RecipientType: "User",
 			Id:            userId.(int),
 		}
@@ -268,36 +273,36 @@ func getEmailChannelFromResource(data *schema.ResourceData) NotificationChannelE
 
 	externalUsersEmail := data.Get("emailchannel_external_recipient_list").([]interface{})
 	for _, userEmail := range externalUsersEmail {
-		recipientDto := RecipientDto{
+		recipientDto := imperva.RecipientDto{
 			RecipientType: "External",
 			DisplayName:   userEmail.(string),
 		}
 		userRecipientDto = append(userRecipientDto, recipientDto)
 	}
 
-	notificationChannelList := NotificationChannelEmailDto{
+	notificationChannelList := imperva.NotificationChannelEmailDto{
 		ChannelType:     "email",
 		RecipientToList: userRecipientDto,
 	}
 	return notificationChannelList
 }
 
-func getSubAccountsDtoListFromResource(d *schema.ResourceData) []SubAccountDTO {
+func getSubAccountsDtoListFromResource(d *schema.ResourceData) []imperva.SubAccountDTO {
 	subAccountsIds := d.Get("sub_account_list").([]interface{})
-	var subAccountsDtoList []SubAccountDTO
+	var subAccountsDtoList []imperva.SubAccountDTO
 	for _, subAccountId := range subAccountsIds {
-		subAccountDTO := SubAccountDTO{SubAccountId: subAccountId.(int)}
+		subAccountDTO := imperva.SubAccountDTO{SubAccountId: subAccountId.(int)}
 		subAccountsDtoList = append(subAccountsDtoList, subAccountDTO)
 	}
 	return subAccountsDtoList
 }
 
-func getAssetsFromResource(d *schema.ResourceData) []AssetDto {
-	var assetList []AssetDto
+func getAssetsFromResource(d *schema.ResourceData) []imperva.AssetDto {
+	var assetList []imperva.AssetDto
 	assets := d.Get("asset").(*schema.Set)
 	for _, asset := range assets.List() {
 		assetResource := asset.(map[string]interface{})
-		assetDto := AssetDto{
+		assetDto := imperva.AssetDto{
 			AssetType: assetResource["asset_type"].(string),
 			AssetId:   assetResource["asset_id"].(int),
 		}
@@ -307,7 +312,7 @@ func getAssetsFromResource(d *schema.ResourceData) []AssetDto {
 }
 
 func resourceNotificationCenterPolicyRead(data *schema.ResourceData, i interface{}) error {
-	client := i.(*Client)
+	client := i.(*providerMeta).client
 	policyID, _ := getPolicyId(data)
 	accountId := data.Get("account_id").(int)
 	notificationCenterPolicy, err := client.GetNotificationCenterPolicy(policyID, accountId)
@@ -342,7 +347,7 @@ func resourceNotificationCenterPolicyRead(data *schema.ResourceData, i interface
 	return nil
 }
 
-func handleAssetsRead(data *schema.ResourceData, notificationCenterPolicy *NotificationPolicy) {
+func handleAssetsRead(data *schema.ResourceData, notificationCenterPolicy *imperva.NotificationPolicy) {
 	var assets []interface{}
 	for _, assetFromServer := range notificationCenterPolicy.Data.AssetList {
 		asset := map[string]interface{}{}
@@ -356,7 +361,7 @@ func handleAssetsRead(data *schema.ResourceData, notificationCenterPolicy *Notif
 	data.Set("asset", assetSet)
 }
 
-func handleEmailChannelRead(data *schema.ResourceData, notificationCenterPolicy *NotificationPolicy) {
+func handleEmailChannelRead(data *schema.ResourceData, notificationCenterPolicy *imperva.NotificationPolicy) {
 	var emailChannelUserRecipientsList []int
 	var emailChannelExternalRecipientsList []string
 	for _, channel := range notificationCenterPolicy.Data.NotificationChannelList {
@@ -389,7 +394,7 @@ func getPolicyId(data *schema.ResourceData) (int, error) {
 }
 
 func resourceNotificationCenterPolicyDelete(data *schema.ResourceData, i interface{}) error {
-	client := i.(*Client)
+	client := i.(*providerMeta).client
 	policyID, _ := getPolicyId(data)
 	accountId := data.Get("account_id").(int)
 	log.Printf("[INFO] Deleting NotificationCenterPolicy policyId: %d and accountId: %d", policyID, accountId)
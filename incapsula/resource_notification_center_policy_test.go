@@ -2,12 +2,13 @@ package incapsula
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"log"
 	"strconv"
 	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 const notificationCenterPolicyResourceType = "incapsula_notification_center_policy"
@@ -89,7 +90,7 @@ func testAccNotificationCenterPolicy_WithAsst(t *testing.T) {
 }
 
 func testAccNotificationCenterPolicyDestroy(state *terraform.State) error {
-	client := testAccProvider.Meta().(*Client)
+	client := testAccProvider.Meta().(*providerMeta).client
 	for _, res := range state.RootModule().Resources {
 		if res.Type != notificationCenterPolicyResourceType {
 			continue
@@ -124,7 +125,7 @@ func testCheckNotificationCenterPolicyExists(fullResourceName string) resource.T
 			return fmt.Errorf("NotificationCenterPolicy Id does not exists, policy id string: %s ", policyIdStr)
 		}
 
-		client := testAccProvider.Meta().(*Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 		accountIdStr := res.Primary.Attributes["account_id"]
 		accountId, _ := strconv.Atoi(accountIdStr)
 		log.Printf("[INFO] ****Test**** policyId: %d accountId:%d", policyId, accountId)
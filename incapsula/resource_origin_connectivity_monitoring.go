@@ -0,0 +1,158 @@
+package incapsula
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+func resourceOriginConnectivityMonitoring() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOriginConnectivityMonitoringUpdate,
+		Read:   resourceOriginConnectivityMonitoringRead,
+		Update: resourceOriginConnectivityMonitoringUpdate,
+		Delete: resourceOriginConnectivityMonitoringDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				_, err := infraProtectImportState(d, m)
+				if err != nil {
+					return nil, err
+				}
+				d.Set("protected_ip_range_id", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"protected_ip_range_id": {
+				Description: "The ID of the protected IP range to monitor.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"probe_targets": {
+				Description: "The origin IP addresses to probe.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPAddress,
+				},
+			},
+
+			// Optional Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account to operate on. If not specified, operation will be performed on the account identified by the authentication parameters.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"probe_interval": {
+				Description:  "The interval between probes, in seconds.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntBetween(10, 3600),
+			},
+			"failure_threshold": {
+				Description:  "The number of consecutive failed probes after which an alert is sent.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"notification_policy_id": {
+				Description: "The ID of the notification center policy receiving the alerts. If not specified, the default account notifications are used.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceOriginConnectivityMonitoringRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	monitoring, statusCode, err := client.GetOriginConnectivityMonitoring(accountID, d.Id())
+
+	// If the range is deleted on the server, blow it out locally and run through the normal TF cycle
+	if statusCode == 404 {
+		log.Printf("[INFO] Incapsula protected IP range %s has already been deleted: %s\n", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula origin connectivity monitoring for protected IP range %s: %s\n", d.Id(), err)
+		return err
+	}
+
+	// Monitoring which was disabled outside of Terraform is recreated
+	if !monitoring.Enabled {
+		log.Printf("[INFO] Incapsula origin connectivity monitoring for protected IP range %s is disabled\n", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("protected_ip_range_id", d.Id())
+	d.Set("probe_targets", monitoring.ProbeTargets)
+	d.Set("probe_interval", monitoring.ProbeIntervalSeconds)
+	d.Set("failure_threshold", monitoring.FailureThreshold)
+	d.Set("notification_policy_id", monitoring.NotificationPolicyID)
+
+	return nil
+}
+
+func resourceOriginConnectivityMonitoringUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+	rangeID := d.Get("protected_ip_range_id").(string)
+
+	probeTargets := make([]string, 0)
+	for _, target := range d.Get("probe_targets").(*schema.Set).List() {
+		probeTargets = append(probeTargets, target.(string))
+	}
+
+	monitoring := imperva.OriginConnectivityMonitoring{
+		Enabled:              true,
+		ProbeTargets:         probeTargets,
+		ProbeIntervalSeconds: d.Get("probe_interval").(int),
+		FailureThreshold:     d.Get("failure_threshold").(int),
+		NotificationPolicyID: d.Get("notification_policy_id").(int),
+	}
+
+	_, err := client.UpdateOriginConnectivityMonitoring(accountID, rangeID, &monitoring)
+	if err != nil {
+		log.Printf("[ERROR] Could not update Incapsula origin connectivity monitoring for protected IP range %s: %s\n", rangeID, err)
+		return err
+	}
+
+	d.SetId(rangeID)
+
+	return resourceOriginConnectivityMonitoringRead(d, m)
+}
+
+func resourceOriginConnectivityMonitoringDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+
+	// Deleting the monitoring settings is just disabling the monitoring
+	monitoring := imperva.OriginConnectivityMonitoring{
+		Enabled:      false,
+		ProbeTargets: []string{},
+	}
+
+	_, err := client.UpdateOriginConnectivityMonitoring(d.Get("account_id").(int), d.Id(), &monitoring)
+	if err != nil {
+		log.Printf("[ERROR] Could not disable Incapsula origin connectivity monitoring for protected IP range %s: %s\n", d.Id(), err)
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
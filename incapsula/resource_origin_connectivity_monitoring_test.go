@@ -0,0 +1,74 @@
+package incapsula
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const originConnectivityMonitoringResourceType = "incapsula_origin_connectivity_monitoring"
+const originConnectivityMonitoringResourceName = "testacc-terraform-origin-connectivity-monitoring"
+const originConnectivityMonitoringResource = originConnectivityMonitoringResourceType + "." + originConnectivityMonitoringResourceName
+
+func TestAccIncapsulaOriginConnectivityMonitoring_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIncapsulaProtectedIPRangeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaOriginConnectivityMonitoringConfigBasic(3),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaOriginConnectivityMonitoringEnabled(originConnectivityMonitoringResource),
+					resource.TestCheckResourceAttr(originConnectivityMonitoringResource, "probe_targets.#", "1"),
+					resource.TestCheckResourceAttr(originConnectivityMonitoringResource, "failure_threshold", "3"),
+				),
+			},
+			{
+				Config: testAccCheckIncapsulaOriginConnectivityMonitoringConfigBasic(5),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaOriginConnectivityMonitoringEnabled(originConnectivityMonitoringResource),
+					resource.TestCheckResourceAttr(originConnectivityMonitoringResource, "failure_threshold", "5"),
+				),
+			},
+			{
+				ResourceName:      originConnectivityMonitoringResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckIncapsulaOriginConnectivityMonitoringEnabled(name string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		res, ok := state.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Incapsula origin connectivity monitoring resource not found: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*providerMeta).client
+		monitoring, _, err := client.GetOriginConnectivityMonitoring(0, res.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Incapsula origin connectivity monitoring for protected IP range %s does not exist: %s", res.Primary.ID, err)
+		}
+		if !monitoring.Enabled {
+			return fmt.Errorf("Incapsula origin connectivity monitoring for protected IP range %s is disabled", res.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIncapsulaOriginConnectivityMonitoringConfigBasic(failureThreshold int) string {
+	return testAccCheckIncapsulaProtectedIPRangeConfigBasic(true) + fmt.Sprintf(`
+	resource "%s" "%s" {
+		protected_ip_range_id = %s.id
+		probe_targets         = ["192.0.2.10"]
+		failure_threshold     = %d
+	}`,
+		originConnectivityMonitoringResourceType, originConnectivityMonitoringResourceName, protectedIPRangeResource, failureThreshold,
+	)
+}
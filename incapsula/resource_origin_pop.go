@@ -2,23 +2,44 @@ package incapsula
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
 	"strconv"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceOriginPOP() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This resource is deprecated. It will be removed in a future version. Please use resource incapsula_data_centers_configuration instead.",
-		Create:             resourceOriginPOPUpdate,
+		Create:             withSiteLock(resourceOriginPOPUpdate),
 		Read:               resourceOriginPOPRead,
-		Update:             resourceOriginPOPUpdate,
-		Delete:             resourceOriginPOPDelete,
+		Update:             withSiteLock(resourceOriginPOPUpdate),
+		Delete:             withSiteLock(resourceOriginPOPDelete),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idSlice := strings.Split(d.Id(), "/")
+				if len(idSlice) != 2 || idSlice[0] == "" || idSlice[1] == "" {
+					return nil, fmt.Errorf("unexpected format of ID (%q), expected site_id/dc_id", d.Id())
+				}
+
+				siteID, err := strconv.Atoi(idSlice[0])
+				if err != nil {
+					return nil, fmt.Errorf("failed to convert site ID from import command, actual value: %s, expected numeric id", idSlice[0])
+				}
+				dcID, err := strconv.Atoi(idSlice[1])
+				if err != nil {
+					return nil, fmt.Errorf("failed to convert data center ID from import command, actual value: %s, expected numeric id", idSlice[1])
+				}
+
+				d.Set("site_id", siteID)
+				d.Set("dc_id", dcID)
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
+		CustomizeDiff: validateSiteReference("site_id"),
+
 		Schema: map[string]*schema.Schema{
 			// Required Arguments
 			"dc_id": {
@@ -50,7 +71,7 @@ func resourceOriginPOP() *schema.Resource {
 }
 
 func resourceOriginPOPUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 	dcID := d.Get("dc_id").(int)
 	siteID := d.Get("site_id").(int)
 	originPOP := d.Get("origin_pop").(string)
@@ -74,7 +95,7 @@ func resourceOriginPOPUpdate(d *schema.ResourceData, m interface{}) error {
 
 func resourceOriginPOPRead(d *schema.ResourceData, m interface{}) error {
 	// Implement by reading the ListDataCentersResponse for the data centers
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 	if !strings.Contains(d.Id(), "/") {
 		log.Printf("[ERROR] The origin_pop resource in your state file is in the old, unsupported format. /n" +
 			"We recommend to use the new resource of data_center_configuration which replaced this resource./n" +
@@ -120,7 +141,7 @@ func resourceOriginPOPRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceOriginPOPDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 	dcID := d.Get("dc_id").(int)
 	err := client.SetOriginPOP(dcID, "")
 
@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
 )
 
 func resourcePolicy() *schema.Resource {
@@ -19,6 +20,8 @@ func resourcePolicy() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: validateAccountReference("account_id"),
+
 		Schema: map[string]*schema.Schema{
 			// Required Arguments
 			"name": {
@@ -32,15 +35,16 @@ func resourcePolicy() *schema.Resource {
 				Required:    true,
 			},
 			"policy_type": {
-				Description: "The policy type. Possible values: ACL, WHITELIST, WAF_RULES",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:  "The policy type. Possible values: ACL, WHITELIST, WAF_RULES",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateEnum(policyTypeValues),
 			},
 			"policy_settings": {
 				Description:      "The policy settings as JSON string. See Imperva documentation for help with constructing a correct value.",
 				Type:             schema.TypeString,
 				Required:         true,
-				DiffSuppressFunc: suppressEquivalentJSONStringDiffs,
+				DiffSuppressFunc: suppressEquivalentUnorderedJSONStringDiffs,
 				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
 					// Check if valid JSON
 					d := val.(string)
@@ -70,13 +74,13 @@ func resourcePolicy() *schema.Resource {
 }
 
 func resourcePolicyCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	policySettingsString := d.Get("policy_settings").(string)
-	var policySettings []PolicySetting
+	var policySettings []imperva.PolicySetting
 	err := json.Unmarshal([]byte(policySettingsString), &policySettings)
 
-	policySubmitted := PolicySubmitted{
+	policySubmitted := imperva.PolicySubmitted{
 		Name:           d.Get("name").(string),
 		Enabled:        d.Get("enabled").(bool),
 		PolicyType:     d.Get("policy_type").(string),
@@ -101,7 +105,7 @@ func resourcePolicyCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourcePolicyRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	policyID := d.Id()
 	policyGetResponse, err := client.GetPolicy(policyID)
@@ -130,7 +134,7 @@ func resourcePolicyRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourcePolicyUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -138,10 +142,10 @@ func resourcePolicyUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	policySettingsString := d.Get("policy_settings").(string)
-	var policySettings []PolicySetting
+	var policySettings []imperva.PolicySetting
 	err = json.Unmarshal([]byte(policySettingsString), &policySettings)
 
-	policySubmitted := PolicySubmitted{
+	policySubmitted := imperva.PolicySubmitted{
 		Name:           d.Get("name").(string),
 		Enabled:        d.Get("enabled").(bool),
 		PolicyType:     d.Get("policy_type").(string),
@@ -160,7 +164,7 @@ func resourcePolicyUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourcePolicyDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	err := client.DeletePolicy(d.Id())
 
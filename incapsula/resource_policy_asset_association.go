@@ -2,9 +2,10 @@ package incapsula
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourcePolicyAssetAssociation() *schema.Resource {
@@ -14,7 +15,17 @@ func resourcePolicyAssetAssociation() *schema.Resource {
 		Update: nil,
 		Delete: resourcePolicyAssetAssociationDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idSlice := strings.Split(d.Id(), "/")
+				if len(idSlice) != 3 || idSlice[0] == "" || idSlice[1] == "" || idSlice[2] == "" {
+					return nil, fmt.Errorf("unexpected format of ID (%q), expected policy_id/asset_id/asset_type", d.Id())
+				}
+
+				d.Set("policy_id", idSlice[0])
+				d.Set("asset_id", idSlice[1])
+				d.Set("asset_type", idSlice[2])
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -32,17 +43,18 @@ func resourcePolicyAssetAssociation() *schema.Resource {
 				ForceNew:    true,
 			},
 			"asset_type": {
-				Description: "The Policy type for the asset association. Only value at the moment is `WEBSITE`.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Description:  "The Policy type for the asset association. Only value at the moment is `WEBSITE`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateEnum(policyAssetTypeValues),
 			},
 		},
 	}
 }
 
 func resourcePolicyAssetAssociationCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	policyID := d.Get("policy_id").(string)
 	assetID := d.Get("asset_id").(string)
@@ -64,14 +76,14 @@ func resourcePolicyAssetAssociationCreate(d *schema.ResourceData, m interface{})
 }
 
 func resourcePolicyAssetAssociationRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	policyID := strings.Split(d.Id(), "/")[0]
 	assetID := strings.Split(d.Id(), "/")[1]
 	assetType := strings.Split(d.Id(), "/")[2]
 
 	log.Printf("[INFO] Trying to read Incapsula Policy Asset Association: %s-%s-%s\n", policyID, assetID, assetType)
-	var isAssociated, err = client.isPolicyAssetAssociated(policyID, assetID, assetType)
+	var isAssociated, err = client.IsPolicyAssetAssociated(policyID, assetID, assetType)
 
 	if err != nil {
 		log.Printf("[ERROR] Could not read Incapsula Policy Asset Association: %s-%s-%s, err: %s\n", policyID, assetID, assetType, err)
@@ -86,13 +98,16 @@ func resourcePolicyAssetAssociationRead(d *schema.ResourceData, m interface{}) e
 		d.Set("asset_type", assetType)
 		d.Set("policy_id", policyID)
 		d.SetId(syntheticID)
+	} else {
+		log.Printf("[INFO] Incapsula Policy Asset Association does not exist: %s-%s-%s\n", policyID, assetID, assetType)
+		d.SetId("")
 	}
 
 	return nil
 }
 
 func resourcePolicyAssetAssociationDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	policyID := d.Get("policy_id").(string)
 	assetID := d.Get("asset_id").(string)
@@ -0,0 +1,135 @@
+package incapsula
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+func resourceProtectedIPRange() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceProtectedIPRangeCreate,
+		Read:   resourceProtectedIPRangeRead,
+		Update: resourceProtectedIPRangeUpdate,
+		Delete: resourceProtectedIPRangeDelete,
+		Importer: &schema.ResourceImporter{
+			State: infraProtectImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"prefix": {
+				Description:  "The IP range to protect in CIDR notation, e.g. 192.0.2.0/24.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDR,
+			},
+
+			// Optional Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account to operate on. If not specified, operation will be performed on the account identified by the authentication parameters.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Description: "A description of the IP range.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"enabled": {
+				Description: "Whether protection is enabled for the IP range. Disabling keeps the range onboarded.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+
+			// Computed Attributes
+			"announcement_status": {
+				Description: "The BGP announcement status of the IP range, e.g. ANNOUNCED or NOT_ANNOUNCED.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceProtectedIPRangeCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	ipRange, err := client.AddProtectedIPRange(accountID, protectedIPRangeFromResourceData(d))
+	if err != nil {
+		log.Printf("[ERROR] Could not create Incapsula protected IP range %s for account %d: %s\n", d.Get("prefix"), accountID, err)
+		return err
+	}
+
+	d.SetId(ipRange.ID)
+	log.Printf("[INFO] Created Incapsula protected IP range %s for account %d\n", d.Id(), accountID)
+
+	return resourceProtectedIPRangeRead(d, m)
+}
+
+func resourceProtectedIPRangeRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	ipRange, statusCode, err := client.GetProtectedIPRange(accountID, d.Id())
+
+	// If the range is deleted on the server, blow it out locally and run through the normal TF cycle
+	if statusCode == 404 {
+		log.Printf("[INFO] Incapsula protected IP range %s has already been deleted: %s\n", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula protected IP range %s: %s\n", d.Id(), err)
+		return err
+	}
+
+	d.Set("prefix", ipRange.Prefix)
+	d.Set("description", ipRange.Description)
+	d.Set("enabled", ipRange.Enabled)
+	d.Set("announcement_status", ipRange.AnnouncementStatus)
+
+	return nil
+}
+
+func resourceProtectedIPRangeUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+
+	_, err := client.UpdateProtectedIPRange(accountID, d.Id(), protectedIPRangeFromResourceData(d))
+	if err != nil {
+		log.Printf("[ERROR] Could not update Incapsula protected IP range %s: %s\n", d.Id(), err)
+		return err
+	}
+
+	return resourceProtectedIPRangeRead(d, m)
+}
+
+func resourceProtectedIPRangeDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+
+	err := client.DeleteProtectedIPRange(d.Get("account_id").(int), d.Id())
+	if err != nil {
+		log.Printf("[ERROR] Could not delete Incapsula protected IP range %s: %s\n", d.Id(), err)
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func protectedIPRangeFromResourceData(d *schema.ResourceData) *imperva.ProtectedIPRange {
+	return &imperva.ProtectedIPRange{
+		Prefix:      d.Get("prefix").(string),
+		Description: d.Get("description").(string),
+		Enabled:     d.Get("enabled").(bool),
+	}
+}
@@ -0,0 +1,93 @@
+package incapsula
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const protectedIPRangeResourceType = "incapsula_protected_ip_range"
+const protectedIPRangeResourceName = "testacc-terraform-protected-ip-range"
+const protectedIPRangeResource = protectedIPRangeResourceType + "." + protectedIPRangeResourceName
+
+func TestAccIncapsulaProtectedIPRange_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIncapsulaProtectedIPRangeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaProtectedIPRangeConfigBasic(true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaProtectedIPRangeExists(protectedIPRangeResource),
+					resource.TestCheckResourceAttr(protectedIPRangeResource, "prefix", "192.0.2.0/24"),
+					resource.TestCheckResourceAttr(protectedIPRangeResource, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(protectedIPRangeResource, "announcement_status"),
+				),
+			},
+			{
+				Config: testAccCheckIncapsulaProtectedIPRangeConfigBasic(false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaProtectedIPRangeExists(protectedIPRangeResource),
+					resource.TestCheckResourceAttr(protectedIPRangeResource, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      protectedIPRangeResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckIncapsulaProtectedIPRangeExists(name string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		res, ok := state.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Incapsula protected IP range resource not found: %s", name)
+		}
+
+		if res.Primary.ID == "" {
+			return fmt.Errorf("Incapsula protected IP range ID does not exist")
+		}
+
+		client := testAccProvider.Meta().(*providerMeta).client
+		_, _, err := client.GetProtectedIPRange(0, res.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Incapsula protected IP range %s does not exist: %s", res.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIncapsulaProtectedIPRangeDestroy(state *terraform.State) error {
+	client := testAccProvider.Meta().(*providerMeta).client
+
+	for _, res := range state.RootModule().Resources {
+		if res.Type != protectedIPRangeResourceType {
+			continue
+		}
+
+		_, statusCode, _ := client.GetProtectedIPRange(0, res.Primary.ID)
+		if statusCode != 404 {
+			return fmt.Errorf("Incapsula protected IP range %s still exists", res.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIncapsulaProtectedIPRangeConfigBasic(enabled bool) string {
+	return fmt.Sprintf(`
+	resource "%s" "%s" {
+		prefix      = "192.0.2.0/24"
+		description = "testacc-terraform-protected-ip-range"
+		enabled     = %t
+	}`,
+		protectedIPRangeResourceType, protectedIPRangeResourceName, enabled,
+	)
+}
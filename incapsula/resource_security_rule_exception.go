@@ -6,22 +6,11 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
 )
 
-// Security Rule Enumerations
-// NOTE: no whitelists for rule: api.acl.whitelisted_ips
-const blacklistedCountriesExceptionRuleID = "api.acl.blacklisted_countries"
-const blacklistedURLsExceptionRuleID = "api.acl.blacklisted_urls"
-const blacklistedIPsExceptionRuleID = "api.acl.blacklisted_ips"
-const backdoorExceptionRuleID = "api.threats.backdoor"
-const crossSiteScriptingExceptionRuleID = "api.threats.cross_site_scripting"
-const illegalResourceAccessExceptionRuleID = "api.threats.illegal_resource_access"
-const remoteFileInclusionExceptionRuleID = "api.threats.remote_file_inclusion"
-const sqlInjectionExceptionRuleID = "api.threats.sql_injection"
-const ddosExceptionRuleID = "api.threats.ddos"
-const botAccessControlExceptionRuleID = "api.threats.bot_access_control"
-
 const exceptionTypeUrl = "api.rule_exception_type.url"
 const exceptionTypeHttpParameter = "api.rule_exception_type.http_parameter"
 const exceptionTypeIp = "api.rule_exception_type.client_ip"
@@ -38,10 +27,11 @@ type DeleteSecurityRuleExceptionResponse struct {
 
 func resourceSecurityRuleException() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceSecurityRuleExceptionCreate,
-		Read:   resourceSecurityRuleExceptionRead,
-		Update: resourceSecurityRuleExceptionUpdate,
-		Delete: resourceSecurityRuleExceptionDelete,
+		Create:        withSiteLock(resourceSecurityRuleExceptionCreate),
+		Read:          resourceSecurityRuleExceptionRead,
+		Update:        withSiteLock(resourceSecurityRuleExceptionUpdate),
+		Delete:        withSiteLock(resourceSecurityRuleExceptionDelete),
+		CustomizeDiff: customdiff.All(validateSiteReference("site_id"), validateGeoCodes("countries", "continents")),
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				idSlice := strings.Split(d.Id(), "/")
@@ -145,7 +135,7 @@ func resourceSecurityRuleException() *schema.Resource {
 }
 
 func resourceSecurityRuleExceptionCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	ruleID := d.Get("rule_id").(string)
 
@@ -178,7 +168,7 @@ func resourceSecurityRuleExceptionCreate(d *schema.ResourceData, m interface{})
 
 func resourceSecurityRuleExceptionRead(d *schema.ResourceData, m interface{}) error {
 	// Implement by reading the SiteResponse for the site
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	siteID := strconv.Itoa(d.Get("site_id").(int))
 	ruleID := d.Get("rule_id").(string)
@@ -202,16 +192,14 @@ func resourceSecurityRuleExceptionRead(d *schema.ResourceData, m interface{}) er
 
 	// Now with the site status, iterate through the rules and find our ID
 	exceptionFound := false
-	if ruleID == blacklistedCountriesExceptionRuleID || ruleID == blacklistedURLsExceptionRuleID || ruleID == blacklistedIPsExceptionRuleID {
+	if ruleID == imperva.BlacklistedCountriesExceptionRuleID || ruleID == imperva.BlacklistedURLsExceptionRuleID || ruleID == imperva.BlacklistedIPsExceptionRuleID {
 		for _, entry := range siteStatusResponse.Security.Acls.Rules {
 			if entry.ID == d.Get("rule_id").(string) {
 				for _, exception := range entry.Exceptions {
 					if exception.ID == whitelistID {
-						for _, value := range exception.Values {
-							d.Set(value.ID, value.Name)
-							exceptionFound = true
-							break
-						}
+						setSecurityRuleExceptionValues(d, exception.Values)
+						exceptionFound = true
+						break
 					}
 				}
 			}
@@ -221,34 +209,7 @@ func resourceSecurityRuleExceptionRead(d *schema.ResourceData, m interface{}) er
 			if entry.ID == d.Get("rule_id").(string) {
 				for _, exception := range entry.Exceptions {
 					if exception.ID == whitelistID {
-						for _, value := range exception.Values {
-							switch value.ID {
-							case exceptionTypeUrl:
-								var urlPatternList []string
-								var urlList []string
-								for _, url := range value.Urls {
-									urlList = append(urlList, url.Value)
-									urlPatternList = append(urlPatternList, url.Pattern)
-								}
-								d.Set("url_patterns", strings.Join(urlPatternList, ","))
-								d.Set("urls", strings.Join(urlList, ","))
-							case exceptionTypeCountry:
-								d.Set("countries", strings.Join(value.Geo.Countries, ","))
-							case exceptionTypeContinent:
-								d.Set("continents", strings.Join(value.Geo.Continents, ","))
-							case exceptionTypeClientAppId:
-								d.Set("client_apps", strings.Join(value.ClientApps, ","))
-							case exceptionTypeClientAppType:
-								d.Set("client_app_types", strings.Join(value.ClientAppTypes, ","))
-							case exceptionTypeHttpParameter:
-								d.Set("parameters", strings.Join(value.Parameters, ","))
-							case exceptionTypeIp:
-								d.Set("ips", strings.Join(value.Ips, ","))
-							case exceptionTypeUserAgent:
-								d.Set("user_agents", strings.Join(value.UserAgents, ","))
-
-							}
-						}
+						setSecurityRuleExceptionValues(d, exception.Values)
 						exceptionFound = true
 						break
 					}
@@ -266,8 +227,46 @@ func resourceSecurityRuleExceptionRead(d *schema.ResourceData, m interface{}) er
 	return nil
 }
 
+// securityRuleExceptionAttributes are the conditions of an exception, reset on read so the ones removed
+// outside of Terraform show up as drift
+var securityRuleExceptionAttributes = []string{"client_app_types", "client_apps", "continents", "countries", "ips", "parameters", "url_patterns", "urls", "user_agents"}
+
+func setSecurityRuleExceptionValues(d *schema.ResourceData, values []imperva.SecurityRuleExceptionValue) {
+	for _, attribute := range securityRuleExceptionAttributes {
+		d.Set(attribute, "")
+	}
+
+	for _, value := range values {
+		switch value.ID {
+		case exceptionTypeUrl:
+			var urlPatternList []string
+			var urlList []string
+			for _, url := range value.Urls {
+				urlList = append(urlList, url.Value)
+				urlPatternList = append(urlPatternList, url.Pattern)
+			}
+			d.Set("url_patterns", strings.Join(urlPatternList, ","))
+			d.Set("urls", strings.Join(urlList, ","))
+		case exceptionTypeCountry:
+			d.Set("countries", strings.Join(value.Geo.Countries, ","))
+		case exceptionTypeContinent:
+			d.Set("continents", strings.Join(value.Geo.Continents, ","))
+		case exceptionTypeClientAppId:
+			d.Set("client_apps", strings.Join(value.ClientApps, ","))
+		case exceptionTypeClientAppType:
+			d.Set("client_app_types", strings.Join(value.ClientAppTypes, ","))
+		case exceptionTypeHttpParameter:
+			d.Set("parameters", strings.Join(value.Parameters, ","))
+		case exceptionTypeIp:
+			d.Set("ips", strings.Join(value.Ips, ","))
+		case exceptionTypeUserAgent:
+			d.Set("user_agents", strings.Join(value.UserAgents, ","))
+		}
+	}
+}
+
 func resourceSecurityRuleExceptionUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	ruleID := d.Get("rule_id").(string)
 	whitelistID := d.Id()
@@ -277,7 +276,7 @@ func resourceSecurityRuleExceptionUpdate(d *schema.ResourceData, m interface{})
 	// Add the appropriate exception params based on ruleID, set exception_id_only to return the whitelist_id for newly created rule
 	switch ruleID {
 	// ACL RuleIDs
-	case blacklistedCountriesExceptionRuleID:
+	case imperva.BlacklistedCountriesExceptionRuleID:
 		_, err := client.EditSecurityRuleException(
 			d.Get("site_id").(int),
 			ruleID,
@@ -296,7 +295,7 @@ func resourceSecurityRuleExceptionUpdate(d *schema.ResourceData, m interface{})
 			log.Printf("[ERROR] Could not update Incapsula security rule exception for rule_id (%s) on site_id (%d), %s\n", ruleID, d.Get("site_id").(int), err)
 			return err
 		}
-	case blacklistedIPsExceptionRuleID:
+	case imperva.BlacklistedIPsExceptionRuleID:
 		_, err := client.EditSecurityRuleException(
 			d.Get("site_id").(int),
 			ruleID,
@@ -315,7 +314,7 @@ func resourceSecurityRuleExceptionUpdate(d *schema.ResourceData, m interface{})
 			log.Printf("[ERROR] Could not update Incapsula security rule exception for rule_id (%s) on site_id (%d), %s\n", ruleID, d.Get("site_id").(int), err)
 			return err
 		}
-	case blacklistedURLsExceptionRuleID:
+	case imperva.BlacklistedURLsExceptionRuleID:
 		_, err := client.EditSecurityRuleException(
 			d.Get("site_id").(int),
 			ruleID,
@@ -334,7 +333,7 @@ func resourceSecurityRuleExceptionUpdate(d *schema.ResourceData, m interface{})
 			log.Printf("[ERROR] Could not update Incapsula security rule exception for rule_id (%s) on site_id (%d), %s\n", ruleID, d.Get("site_id").(int), err)
 			return err
 		}
-	case backdoorExceptionRuleID:
+	case imperva.BackdoorExceptionRuleID:
 		_, err := client.EditSecurityRuleException(
 			d.Get("site_id").(int),
 			ruleID,
@@ -353,7 +352,7 @@ func resourceSecurityRuleExceptionUpdate(d *schema.ResourceData, m interface{})
 			log.Printf("[ERROR] Could not update Incapsula security rule exception for rule_id (%s) on site_id (%d), %s\n", ruleID, d.Get("site_id").(int), err)
 			return err
 		}
-	case botAccessControlExceptionRuleID:
+	case imperva.BotAccessControlExceptionRuleID:
 		_, err := client.EditSecurityRuleException(
 			d.Get("site_id").(int),
 			ruleID,
@@ -372,7 +371,7 @@ func resourceSecurityRuleExceptionUpdate(d *schema.ResourceData, m interface{})
 			log.Printf("[ERROR] Could not update Incapsula security rule exception for rule_id (%s) on site_id (%d), %s\n", ruleID, d.Get("site_id").(int), err)
 			return err
 		}
-	case crossSiteScriptingExceptionRuleID:
+	case imperva.CrossSiteScriptingExceptionRuleID:
 		_, err := client.EditSecurityRuleException(
 			d.Get("site_id").(int),
 			ruleID,
@@ -391,7 +390,7 @@ func resourceSecurityRuleExceptionUpdate(d *schema.ResourceData, m interface{})
 			log.Printf("[ERROR] Could not update Incapsula security rule exception for rule_id (%s) on site_id (%d), %s\n", ruleID, d.Get("site_id").(int), err)
 			return err
 		}
-	case ddosExceptionRuleID:
+	case imperva.DDoSExceptionRuleID:
 		_, err := client.EditSecurityRuleException(
 			d.Get("site_id").(int),
 			ruleID,
@@ -410,7 +409,7 @@ func resourceSecurityRuleExceptionUpdate(d *schema.ResourceData, m interface{})
 			log.Printf("[ERROR] Could not update Incapsula security rule exception for rule_id (%s) on site_id (%d), %s\n", ruleID, d.Get("site_id").(int), err)
 			return err
 		}
-	case illegalResourceAccessExceptionRuleID:
+	case imperva.IllegalResourceAccessExceptionRuleID:
 		_, err := client.EditSecurityRuleException(
 			d.Get("site_id").(int),
 			ruleID,
@@ -429,7 +428,7 @@ func resourceSecurityRuleExceptionUpdate(d *schema.ResourceData, m interface{})
 			log.Printf("[ERROR] Could not update Incapsula security rule exception for rule_id (%s) on site_id (%d), %s\n", ruleID, d.Get("site_id").(int), err)
 			return err
 		}
-	case remoteFileInclusionExceptionRuleID:
+	case imperva.RemoteFileInclusionExceptionRuleID:
 		_, err := client.EditSecurityRuleException(
 			d.Get("site_id").(int),
 			ruleID,
@@ -448,7 +447,7 @@ func resourceSecurityRuleExceptionUpdate(d *schema.ResourceData, m interface{})
 			log.Printf("[ERROR] Could not update Incapsula security rule exception for rule_id (%s) on site_id (%d), %s\n", ruleID, d.Get("site_id").(int), err)
 			return err
 		}
-	case sqlInjectionExceptionRuleID:
+	case imperva.SQLInjectionExceptionRuleID:
 		_, err := client.EditSecurityRuleException(
 			d.Get("site_id").(int),
 			ruleID,
@@ -478,7 +477,7 @@ func resourceSecurityRuleExceptionUpdate(d *schema.ResourceData, m interface{})
 }
 
 func resourceSecurityRuleExceptionDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	ruleID := d.Get("rule_id").(string)
 	whitelistID := d.Id()
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
 )
 
 const securityRuleExceptionNameBlacklistedCountries = "Example security rule exception - blacklisted_countries"
@@ -122,7 +123,7 @@ func testCheckSecurityRuleExceptionExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Incapsula security rule exception ID does not exist")
 		}
 
-		client := testAccProvider.Meta().(*Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 		siteStatusResponse, err := client.ListSecurityRuleExceptions(siteID, ruleID)
 		if err != nil {
 			return fmt.Errorf("ListSecurityRuleExceptions Error for site_id (%s) and rule_id (%s) %s", siteID, ruleID, err)
@@ -196,3 +197,23 @@ resource "incapsula_security_rule_exception" "example-waf-blacklisted-countries-
 }`, securityRuleExceptionResourceNameBlacklistedCountries,
 	)
 }
+
+func TestSetSecurityRuleExceptionValues(t *testing.T) {
+	d := resourceSecurityRuleException().TestResourceData()
+	d.Set("ips", "1.2.3.4")
+	d.Set("urls", "/myurl")
+	d.Set("url_patterns", "EQUALS")
+
+	// The URLs were removed and the IPs changed outside of Terraform
+	value := imperva.SecurityRuleExceptionValue{ID: exceptionTypeIp, Ips: []string{"5.6.7.8", "9.9.9.9"}}
+	countries := imperva.SecurityRuleExceptionValue{ID: exceptionTypeCountry}
+	countries.Geo.Countries = []string{"AI", "AN"}
+	setSecurityRuleExceptionValues(d, []imperva.SecurityRuleExceptionValue{value, countries})
+
+	expected := map[string]string{"ips": "5.6.7.8,9.9.9.9", "countries": "AI,AN", "urls": "", "url_patterns": "", "continents": ""}
+	for attribute, value := range expected {
+		if d.Get(attribute).(string) != value {
+			t.Errorf("Should have set %s to %q, got: %q", attribute, value, d.Get(attribute))
+		}
+	}
+}
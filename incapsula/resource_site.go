@@ -2,30 +2,32 @@ package incapsula
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
 )
 
-const create_retries = 3
-const update_retries = 0
 const sleep_before_update_seconds = 5
 const sleep_before_retry_seconds = 3
 
 func resourceSite() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgraders(&schema.Resource{
 		Create: resourceSiteCreate,
 		Read:   resourceSiteRead,
-		Update: resourceSiteUpdate,
-		Delete: resourceSiteDelete,
+		Update: withSiteIDLock(resourceSiteUpdate),
+		Delete: withSiteIDLock(resourceSiteDelete),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			State: resourceSiteImportState,
 		},
 
+		CustomizeDiff: validateAccountReference("account_id"),
+
 		Schema: map[string]*schema.Schema{
 			// Required Arguments
 			"domain": {
@@ -45,16 +47,16 @@ func resourceSite() *schema.Resource {
 
 			// Optional Arguments
 			"account_id": {
-				Description: "Numeric identifier of the account to operate on. If not specified, operation will be performed on the account identified by the authentication parameters.",
+				Description: "Numeric identifier of the account to operate on. If not specified, operation will be performed on the account identified by the authentication parameters. Changing this value moves the site to the new account (e.g. from the parent account to a subaccount) instead of recreating it.",
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Computed:    true,
-				ForceNew:    true,
 			},
 			"ref_id": {
 				Description: "Customer specific identifier for this operation.",
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 			},
 			"send_site_setup_emails": {
 				Description: "If this value is false, end users will not get emails about the add site process such as DNS instructions and SSL setup.",
@@ -86,15 +88,18 @@ func resourceSite() *schema.Resource {
 				Optional:    true,
 			},
 			"active": {
-				Description: "active or bypass.",
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
+				Description:  "active or bypass.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateEnum(siteActiveValues),
 			},
 			"domain_validation": {
-				Description: "email or html or dns.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:  "email or html or dns.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateEnum(siteDomainValidationValues),
 			},
 			"approver": {
 				Description: "my.approver@email.com (some approver email address).",
@@ -107,22 +112,25 @@ func resourceSite() *schema.Resource {
 				Optional:    true,
 			},
 			"acceleration_level": {
-				Description: "none | standard | aggressive.",
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
+				Description:  "none | standard | aggressive.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateEnum(siteAccelerationLevelValues),
 			},
 			"seal_location": {
-				Description: "api.seal_location.bottom_left | api.seal_location.none | api.seal_location.right_bottom | api.seal_location.right | api.seal_location.left | api.seal_location.bottom_right | api.seal_location.bottom.",
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
+				Description:  "api.seal_location.bottom_left | api.seal_location.none | api.seal_location.right_bottom | api.seal_location.right | api.seal_location.left | api.seal_location.bottom_right | api.seal_location.bottom.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateEnum(siteSealLocationValues),
 			},
 			"restricted_cname_reuse": {
-				Description: "Use this option to allow Imperva to detect and add domains that are using the Imperva-provided CNAME (not recommended). One of: true | false",
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
+				Description:  "Use this option to allow Imperva to detect and add domains that are using the Imperva-provided CNAME (not recommended). One of: true | false",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateEnum(booleanStringValues),
 			},
 			"domain_redirect_to_full": {
 				Description: "true or empty string.",
@@ -135,10 +143,11 @@ func resourceSite() *schema.Resource {
 				Optional:    true,
 			},
 			"data_storage_region": {
-				Description: "The data region to use. Options are `APAC`, `AU`, `EU`, and `US`.",
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
+				Description:  "The data region to use. Options are `APAC`, `AU`, `EU`, and `US`.",
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validateEnum(dataStorageRegionValues),
 			},
 			"hashing_enabled": {
 				Description: "Specify if hashing (masking setting) should be enabled.",
@@ -160,10 +169,11 @@ func resourceSite() *schema.Resource {
 				},
 			},
 			"log_level": {
-				Description: "The log level. Options are `full`, `security`, and `none`.",
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
+				Description:  "The log level. Options are `full`, `security`, and `none`.",
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validateEnum(logLevelValues),
 			},
 			"perf_client_comply_no_cache": {
 				Description: "Comply with No-Cache and Max-Age directives in client requests. By default, these cache directives are ignored. Resources are dynamically profiled and re-configured to optimize performance.",
@@ -196,16 +206,18 @@ func resourceSite() *schema.Resource {
 				Optional:    true,
 			},
 			"perf_mode_https": {
-				Description: "The resources that are cached over HTTPS, the general level applies. Options are `disabled`, `dont_include_html`, `include_html`, and `include_all_resources`.",
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
+				Description:  "The resources that are cached over HTTPS, the general level applies. Options are `disabled`, `dont_include_html`, `include_html`, and `include_all_resources`.",
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validateEnum(perfModeHTTPSValues),
 			},
 			"perf_mode_level": {
-				Description: "Caching level. Options are `disable`, `standard`, `smart`, and `all_resources`.",
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
+				Description:  "Caching level. Options are `disable`, `standard`, `smart`, and `all_resources`.",
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validateEnum(perfModeLevelValues),
 			},
 			"perf_mode_time": {
 				Description: "The time, in seconds, that you set for this option determines how often the cache is refreshed. Relevant for the `include_html` and `include_all_resources` levels only.",
@@ -245,10 +257,11 @@ func resourceSite() *schema.Resource {
 				Optional:    true,
 			},
 			"perf_response_cache_response_header_mode": {
-				Description: "The working mode for caching response headers. Options are `all` and `custom`.",
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
+				Description:  "The working mode for caching response headers. Options are `all` and `custom`.",
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validateEnum(perfCacheResponseHeaderModeValues),
 			},
 			"perf_response_cache_response_headers": {
 				Description: "An array of strings representing the response headers to be cached when working in `custom` mode. If empty, no response headers are cached.",
@@ -258,7 +271,7 @@ func resourceSite() *schema.Resource {
 				},
 				Computed:         true,
 				Optional:         true,
-				DiffSuppressFunc: suppressEquivalentStringDiffs,
+				DiffSuppressFunc: suppressEquivalentUnorderedListDiffs,
 			},
 			"perf_response_cache_shield": {
 				Description: "Adds an intermediate cache between other Imperva PoPs and your origin servers to protect your servers from redundant requests.",
@@ -267,10 +280,11 @@ func resourceSite() *schema.Resource {
 				Optional:    true,
 			},
 			"perf_response_stale_content_mode": {
-				Description: "The working mode for serving stale content. Options are `disabled`, `adaptive`, and `custom`.",
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
+				Description:  "The working mode for serving stale content. Options are `disabled`, `adaptive`, and `custom`.",
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validateEnum(perfStaleContentModeValues),
 			},
 			"perf_response_stale_content_time": {
 				Description: "The time, in seconds, to serve stale content for when working in `custom` work mode.",
@@ -308,6 +322,18 @@ func resourceSite() *schema.Resource {
 				Optional:    true,
 				Default:     "true",
 			},
+			"deletion_protection": {
+				Description: "Protect the site from being deleted. When `true`, destroying the resource fails with an error. Default value: false",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"deactivate_on_destroy": {
+				Description: "When `true`, destroying the resource sets the site to bypass mode and removes it from the state instead of deleting it. Default value: false",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
 			// Computed Attributes
 			"site_creation_date": {
 				Description: "Numeric representation of the site creation date.",
@@ -356,13 +382,58 @@ func resourceSite() *schema.Resource {
 		},
 
 		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(1 * time.Minute),
 		},
+	})
+}
+
+// resourceSiteImportState accepts the numeric site ID, the domain of the site, or account_id/domain
+// when the same domain exists in more than one account
+func resourceSiteImportState(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if _, err := strconv.Atoi(d.Id()); err == nil {
+		return []*schema.ResourceData{d}, nil
 	}
+
+	accountID := 0
+	domain := d.Id()
+	if idSlice := strings.Split(d.Id(), "/"); len(idSlice) == 2 {
+		var err error
+		accountID, err = strconv.Atoi(idSlice[0])
+		if err != nil || idSlice[1] == "" {
+			return nil, fmt.Errorf("unexpected format of ID (%q), expected site_id, domain or account_id/domain", d.Id())
+		}
+		domain = idSlice[1]
+	}
+
+	client := m.(*providerMeta).client
+
+	log.Printf("[INFO] Looking up Incapsula site for domain %s (account id: %d) for import\n", domain, accountID)
+
+	sites, err := client.FindSitesByDomain(accountID, domain)
+	if err != nil {
+		return nil, fmt.Errorf("Error looking up site for domain %s (account id: %d): %s", domain, accountID, err)
+	}
+
+	if len(sites) == 0 {
+		return nil, fmt.Errorf("No site matched domain %s (account id: %d)", domain, accountID)
+	}
+
+	if len(sites) > 1 {
+		return nil, fmt.Errorf("More than one site matched domain %s (account id: %d). First two matches are site ids: %d and %d. Import using account_id/domain to narrow the search", domain, accountID, sites[0].SiteID, sites[1].SiteID)
+	}
+
+	log.Printf("[INFO] Found Incapsula site %d in account %d for domain %s\n", sites[0].SiteID, sites[0].AccountID, domain)
+
+	d.SetId(strconv.Itoa(sites[0].SiteID))
+	d.Set("account_id", sites[0].AccountID)
+
+	return []*schema.ResourceData{d}, nil
 }
 
 func resourceSiteCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 	domain := d.Get("domain").(string)
 
 	log.Printf("[INFO] Creating Incapsula site for domain: %s\n", domain)
@@ -392,7 +463,8 @@ func resourceSiteCreate(d *schema.ResourceData, m interface{}) error {
 	// Set an arbitrary period to sleep
 	time.Sleep(sleep_before_update_seconds * time.Second)
 
-	err = updateAdditionalSiteProperties(create_retries, client, d)
+	// The site may still be in the process of being added, keep retrying until the create timeout
+	err = updateAdditionalSiteProperties(d.Timeout(schema.TimeoutCreate), client, d)
 	if err != nil {
 		return err
 	}
@@ -417,19 +489,21 @@ func resourceSiteCreate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	// Set the rest of the state from the resource read
+	// Set the rest of the state from the resource read, the listed details of the site are outdated
+	siteID, _ := strconv.Atoi(d.Id())
+	client.InvalidateCachedSite(siteID)
 	return resourceSiteRead(d, m)
 }
 
 func resourceSiteRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	domain := d.Get("domain").(string)
 	siteID, _ := strconv.Atoi(d.Id())
 
 	log.Printf("[INFO] Reading Incapsula site for domain: %s\n", domain)
 
-	siteStatusResponse, err := client.SiteStatus(domain, siteID)
+	siteStatusResponse, err := client.CachedSiteStatus(domain, siteID, d.Get("account_id").(int))
 
 	// Site object may have been deleted
 	if siteStatusResponse != nil && siteStatusResponse.Res.(float64) == 9413 {
@@ -452,6 +526,12 @@ func resourceSiteRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("active", siteStatusResponse.Active)
 	d.Set("restricted_cname_reuse", strconv.FormatBool(siteStatusResponse.RestrictedCnameReuse))
 	d.Set("seal_location", siteStatusResponse.SealLocation.ID)
+	d.Set("ref_id", siteStatusResponse.RefID)
+	if siteStatusResponse.Ssl.GeneratedCertificate.ValidationMethod != "" {
+		d.Set("domain_validation", siteStatusResponse.Ssl.GeneratedCertificate.ValidationMethod)
+	}
+	// approver, domain_redirect_to_full, force_ssl, ignore_ssl, remove_ssl and send_site_setup_emails are actions
+	// rather than settings, they and logs_account_id aren't returned by the API and are kept as configured
 
 	// Set the DNS information
 	dnsARecordValues := make([]string, 0)
@@ -570,9 +650,14 @@ func resourceSiteRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceSiteUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
+
+	err := moveSite(client, d)
+	if err != nil {
+		return err
+	}
 
-	err := updateAdditionalSiteProperties(update_retries, client, d)
+	err = updateAdditionalSiteProperties(d.Timeout(schema.TimeoutUpdate), client, d)
 	if err != nil {
 		return err
 	}
@@ -597,15 +682,39 @@ func resourceSiteUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	// Set the rest of the state from the resource read
+	// Set the rest of the state from the resource read, the listed details of the site are outdated
+	siteID, _ := strconv.Atoi(d.Id())
+	client.InvalidateCachedSite(siteID)
 	return resourceSiteRead(d, m)
 }
 
 func resourceSiteDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 	domain := d.Get("domain").(string)
 	siteID, _ := strconv.Atoi(d.Id())
 
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("Cannot delete Incapsula site (%s) for domain %s: deletion_protection is enabled. Set deletion_protection to false and apply before destroying the site", d.Id(), domain)
+	}
+
+	if d.Get("deactivate_on_destroy").(bool) {
+		log.Printf("[INFO] Deactivating Incapsula site for domain: %s instead of deleting it\n", domain)
+
+		_, err := client.UpdateSite(d.Id(), "active", "bypass")
+		if err != nil {
+			log.Printf("[ERROR] Could not deactivate Incapsula site (%s) for domain %s: %s\n", d.Id(), domain, err)
+			return err
+		}
+
+		log.Printf("[INFO] Deactivated site (%s) for domain %s, the site was not deleted\n", d.Id(), domain)
+
+		// Set the ID to empty
+		// Implicitly clears the resource
+		d.SetId("")
+
+		return nil
+	}
+
 	log.Printf("[INFO] Deleting Incapsula site for domain: %s\n", domain)
 
 	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
@@ -625,10 +734,10 @@ func resourceSiteDelete(d *schema.ResourceData, m interface{}) error {
 	})
 }
 
-func updateAdditionalSiteProperties(retries int, client *Client, d *schema.ResourceData) error {
+func updateAdditionalSiteProperties(timeout time.Duration, client *imperva.Client, d *schema.ResourceData) error {
 	updateParams := [12]string{"acceleration_level", "active", "approver", "domain_redirect_to_full", "domain_validation", "ignore_ssl", "remove_ssl", "ref_id", "seal_location", "restricted_cname_reuse", "naked_domain_san", "wildcard_san"}
 	retryCounter := 1
-	return resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+	return resource.Retry(timeout, func() *resource.RetryError {
 		for i := 0; i < len(updateParams); i++ {
 			param := updateParams[i]
 
@@ -637,8 +746,8 @@ func updateAdditionalSiteProperties(retries int, client *Client, d *schema.Resou
 				log.Printf("[INFO] Updating Incapsula site param (%s) with value (%s) for site_id: %s\n", param, value, d.Id())
 				_, err := client.UpdateSite(d.Id(), param, value)
 				if err != nil {
-					if retryCounter <= retries && strings.Contains(err.Error(), "Add site operation") {
-						log.Printf("[INFO] retry number %d/%d to update Incapsula site param (%s) for site_id: %s\n", retryCounter, retries, param, d.Id())
+					if imperva.IsConflictError(err) {
+						log.Printf("[INFO] retry number %d (timeout: %s) to update Incapsula site param (%s) for site_id: %s\n", retryCounter, timeout, param, d.Id())
 						time.Sleep(sleep_before_retry_seconds * time.Second)
 						retryCounter++
 						return resource.RetryableError(err)
@@ -652,7 +761,20 @@ func updateAdditionalSiteProperties(retries int, client *Client, d *schema.Resou
 	})
 }
 
-func updateDataStorageRegion(client *Client, d *schema.ResourceData) error {
+func moveSite(client *imperva.Client, d *schema.ResourceData) error {
+	if d.HasChange("account_id") {
+		siteID, _ := strconv.Atoi(d.Id())
+		accountID := d.Get("account_id").(int)
+		_, err := client.MoveSite(siteID, accountID)
+		if err != nil {
+			log.Printf("[ERROR] Could not move Incapsula site_id: %s to account_id: %d %s\n", d.Id(), accountID, err)
+			return err
+		}
+	}
+	return nil
+}
+
+func updateDataStorageRegion(client *imperva.Client, d *schema.ResourceData) error {
 	if d.HasChange("data_storage_region") {
 		dataStorageRegion := d.Get("data_storage_region").(string)
 		_, err := client.UpdateDataStorageRegion(d.Id(), dataStorageRegion)
@@ -664,11 +786,11 @@ func updateDataStorageRegion(client *Client, d *schema.ResourceData) error {
 	return nil
 }
 
-func updateMaskingSettings(client *Client, d *schema.ResourceData) error {
+func updateMaskingSettings(client *imperva.Client, d *schema.ResourceData) error {
 	if d.HasChange("hashing_enabled") || d.HasChange("hash_salt") {
 		hashingEnabled := d.Get("hashing_enabled").(bool)
 		hashSalt := d.Get("hash_salt").(string)
-		maskingSettings := MaskingSettings{HashingEnabled: hashingEnabled, HashSalt: hashSalt}
+		maskingSettings := imperva.MaskingSettings{HashingEnabled: hashingEnabled, HashSalt: hashSalt}
 		err := client.UpdateMaskingSettings(d.Id(), &maskingSettings)
 		if err != nil {
 			log.Printf("[ERROR] Could not update Incapsula site masking settings for site_id: %s %s\n", d.Id(), err)
@@ -678,7 +800,7 @@ func updateMaskingSettings(client *Client, d *schema.ResourceData) error {
 	return nil
 }
 
-func updateLogLevel(client *Client, d *schema.ResourceData) error {
+func updateLogLevel(client *imperva.Client, d *schema.ResourceData) error {
 	if d.HasChange("log_level") ||
 		d.HasChange("logs_account_id") {
 		logLevel := d.Get("log_level").(string)
@@ -692,7 +814,7 @@ func updateLogLevel(client *Client, d *schema.ResourceData) error {
 	return nil
 }
 
-func updatePerformanceSettings(client *Client, d *schema.ResourceData) error {
+func updatePerformanceSettings(client *imperva.Client, d *schema.ResourceData) error {
 	if d.HasChange("perf_client_comply_no_cache") ||
 		d.HasChange("perf_client_enable_client_side_caching") ||
 		d.HasChange("perf_client_send_age_header") ||
@@ -714,7 +836,7 @@ func updatePerformanceSettings(client *Client, d *schema.ResourceData) error {
 		d.HasChange("perf_response_tag_response_header") ||
 		d.HasChange("perf_ttl_prefer_last_modified") ||
 		d.HasChange("perf_ttl_use_shortest_caching") {
-		performanceSettings := PerformanceSettings{}
+		performanceSettings := imperva.PerformanceSettings{}
 		performanceSettings.ClientSide.ComplyNoCache = d.Get("perf_client_comply_no_cache").(bool)
 		performanceSettings.ClientSide.EnableClientSideCaching = d.Get("perf_client_enable_client_side_caching").(bool)
 		performanceSettings.ClientSide.SendAgeHeader = d.Get("perf_client_send_age_header").(bool)
@@ -0,0 +1,127 @@
+package incapsula
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+func resourceSiteCNAMEConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: withSiteLock(resourceSiteCNAMEConfigurationUpdate),
+		Read:   resourceSiteCNAMEConfigurationRead,
+		Update: withSiteLock(resourceSiteCNAMEConfigurationUpdate),
+		Delete: withSiteLock(resourceSiteCNAMEConfigurationDelete),
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				siteID, err := strconv.Atoi(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				d.Set("site_id", siteID)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		CustomizeDiff: validateSiteReference("site_id"),
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"site_id": {
+				Description: "Numeric identifier of the site to operate on.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			// Optional Arguments
+			"custom_cname": {
+				Description:  "The custom (branded) CNAME assigned to the site, from the custom CNAMEs of the account. The CNAME generated by Imperva is used when not set.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringDoesNotContainAny(" ,"),
+			},
+			"cname_reuse": {
+				Description: "Allows the other domains of the account to reuse the CNAME of the site, e.g. to onboard the subdomains of a wildcard domain.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			// Computed Attributes
+			"edge_hostname": {
+				Description: "The hostname the domain of the site must point to, with a CNAME record.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceSiteCNAMEConfigurationUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	siteID := d.Get("site_id").(int)
+
+	configuration := &imperva.SiteCNAMEConfiguration{
+		CustomCNAME: d.Get("custom_cname").(string),
+		CNAMEReuse:  d.Get("cname_reuse").(bool),
+	}
+	_, err := client.UpdateSiteCNAMEConfiguration(siteID, configuration)
+	if err != nil {
+		log.Printf("[ERROR] Could not update Incapsula CNAME configuration for site id %d: %s\n", siteID, err)
+		return err
+	}
+
+	d.SetId(strconv.Itoa(siteID))
+	log.Printf("[INFO] Updated Incapsula CNAME configuration for site id %d\n", siteID)
+
+	// The listed details of the site, e.g. its DNS records, are outdated
+	client.InvalidateCachedSite(siteID)
+	return resourceSiteCNAMEConfigurationRead(d, m)
+}
+
+func resourceSiteCNAMEConfigurationRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	siteID, _ := strconv.Atoi(d.Id())
+
+	configuration, statusCode, err := client.GetSiteCNAMEConfiguration(siteID)
+
+	// If the site is deleted on the server, blow it out locally and run through the normal TF cycle
+	if statusCode == 404 {
+		log.Printf("[INFO] Incapsula site id %d has already been deleted: %s\n", siteID, err)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula CNAME configuration for site id %d: %s\n", siteID, err)
+		return err
+	}
+
+	d.Set("site_id", siteID)
+	d.Set("custom_cname", configuration.CustomCNAME)
+	d.Set("cname_reuse", configuration.CNAMEReuse)
+	d.Set("edge_hostname", configuration.EdgeHostname)
+
+	return nil
+}
+
+func resourceSiteCNAMEConfigurationDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	siteID := d.Get("site_id").(int)
+
+	// The CNAME settings can't be removed from a site, the CNAME generated by Imperva is restored without reuse
+	_, err := client.UpdateSiteCNAMEConfiguration(siteID, &imperva.SiteCNAMEConfiguration{})
+	if err != nil {
+		log.Printf("[ERROR] Could not reset Incapsula CNAME configuration for site id %d: %s\n", siteID, err)
+		return err
+	}
+
+	client.InvalidateCachedSite(siteID)
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package incapsula
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const siteCNAMEConfigurationResourceType = "incapsula_site_cname_configuration"
+const siteCNAMEConfigurationResourceName = "testacc-terraform-site-cname-configuration"
+const siteCNAMEConfigurationResource = siteCNAMEConfigurationResourceType + "." + siteCNAMEConfigurationResourceName
+
+func TestAccIncapsulaSiteCNAMEConfiguration_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaSiteCNAMEConfigurationConfigBasic(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(siteCNAMEConfigurationResource, "cname_reuse", "true"),
+					resource.TestCheckResourceAttrSet(siteCNAMEConfigurationResource, "edge_hostname"),
+				),
+			},
+			{
+				Config: testAccCheckIncapsulaSiteCNAMEConfigurationConfigBasic(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(siteCNAMEConfigurationResource, "cname_reuse", "false"),
+				),
+			},
+			{
+				ResourceName:      siteCNAMEConfigurationResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIncapsulaSiteCNAMEConfigurationConfigBasic(cnameReuse bool) string {
+	return testAccCheckIncapsulaSiteConfigBasic(GenerateTestDomain(nil)) + fmt.Sprintf(`
+	resource "%s" "%s" {
+		site_id     = %s.id
+		cname_reuse = %t
+	}`,
+		siteCNAMEConfigurationResourceType, siteCNAMEConfigurationResourceName, siteResourceName, cnameReuse,
+	)
+}
@@ -0,0 +1,147 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSiteFullConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: withSiteLock(resourceSiteFullConfigCreate),
+		Read:   resourceSiteFullConfigRead,
+		Update: withSiteLock(resourceSiteFullConfigUpdate),
+		Delete: resourceSiteFullConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				siteID, err := strconv.Atoi(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				d.Set("site_id", siteID)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		CustomizeDiff: validateSiteReference("site_id"),
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"site_id": {
+				Description: "Numeric identifier of the site to operate on.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			// Optional Arguments
+			"configuration": {
+				Description:      "The configuration document of the site as JSON, with its `settings`, `performance`, `rules` and `data_centers`. If not specified, the current configuration of the site is exported and left untouched.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateSiteFullConfig,
+				DiffSuppressFunc: suppressEquivalentSiteFullConfigDiffs,
+			},
+		},
+	}
+}
+
+func resourceSiteFullConfigCreate(d *schema.ResourceData, m interface{}) error {
+	siteID := d.Get("site_id").(int)
+	d.SetId(strconv.Itoa(siteID))
+
+	if _, ok := d.GetOk("configuration"); ok {
+		err := applySiteFullConfigFromResource(d, m)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceSiteFullConfigRead(d, m)
+}
+
+func resourceSiteFullConfigRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	siteID, _ := strconv.Atoi(d.Id())
+
+	log.Printf("[INFO] Reading Incapsula full configuration for site id: %d\n", siteID)
+
+	siteStatusResponse, err := client.CachedSiteStatus("full-config", siteID, 0)
+
+	// Site object may have been deleted
+	if siteStatusResponse != nil && siteStatusResponse.Res.(float64) == 9413 {
+		log.Printf("[INFO] Incapsula Site ID %d has already been deleted: %s\n", siteID, err)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula full configuration for site id: %d, %s\n", siteID, err)
+		return err
+	}
+
+	config, err := exportSiteFullConfig(client, siteStatusResponse)
+	if err != nil {
+		log.Printf("[ERROR] Could not export Incapsula full configuration for site id: %d, %s\n", siteID, err)
+		return err
+	}
+
+	configuration, err := normalizeSiteFullConfig(config)
+	if err != nil {
+		return fmt.Errorf("Error encoding the full configuration of site id: %d: %s", siteID, err)
+	}
+
+	d.Set("site_id", siteID)
+	d.Set("configuration", configuration)
+
+	log.Printf("[INFO] Finished reading Incapsula full configuration for site id: %d\n", siteID)
+
+	return nil
+}
+
+func resourceSiteFullConfigUpdate(d *schema.ResourceData, m interface{}) error {
+	if d.HasChange("configuration") {
+		err := applySiteFullConfigFromResource(d, m)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceSiteFullConfigRead(d, m)
+}
+
+func applySiteFullConfigFromResource(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	siteID := d.Get("site_id").(int)
+
+	config, err := parseSiteFullConfig(d.Get("configuration").(string))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Applying Incapsula full configuration for site id: %d\n", siteID)
+
+	err = applySiteFullConfig(client, siteID, config)
+
+	// The listed details of the site are outdated, even if only part of the configuration was applied
+	client.InvalidateCachedSite(siteID)
+
+	if err != nil {
+		log.Printf("[ERROR] Could not apply Incapsula full configuration for site id: %d, %s\n", siteID, err)
+		return err
+	}
+
+	log.Printf("[INFO] Applied Incapsula full configuration for site id: %d\n", siteID)
+
+	return nil
+}
+
+func resourceSiteFullConfigDelete(d *schema.ResourceData, m interface{}) error {
+	// The site keeps its configuration, it's only no longer managed through the document
+	log.Printf("[INFO] Removing Incapsula full configuration for site id: %s from the state\n", d.Id())
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,41 @@
+package incapsula
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const siteFullConfigResourceType = "incapsula_site_full_config"
+const siteFullConfigResourceName = "testacc-terraform-site-full-config"
+const siteFullConfigResource = siteFullConfigResourceType + "." + siteFullConfigResourceName
+
+func TestAccIncapsulaSiteFullConfig_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaSiteFullConfigConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(siteFullConfigResource, "configuration"),
+				),
+			},
+			{
+				ResourceName:      siteFullConfigResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIncapsulaSiteFullConfigConfigBasic() string {
+	return testAccCheckIncapsulaSiteConfigBasic(GenerateTestDomain(nil)) + fmt.Sprintf(`
+	resource "%s" "%s" {
+		site_id = %s.id
+	}`,
+		siteFullConfigResourceType, siteFullConfigResourceName, siteResourceName,
+	)
+}
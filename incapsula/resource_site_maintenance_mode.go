@@ -0,0 +1,299 @@
+package incapsula
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+const siteMaintenanceModeBypass = "bypass"
+const siteMaintenanceModeErrorPage = "error_page"
+
+func resourceSiteMaintenanceMode() *schema.Resource {
+	return &schema.Resource{
+		Create: withSiteLock(resourceSiteMaintenanceModeUpdate),
+		Read:   resourceSiteMaintenanceModeRead,
+		Update: withSiteLock(resourceSiteMaintenanceModeUpdate),
+		Delete: withSiteLock(resourceSiteMaintenanceModeDelete),
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				siteID, err := strconv.Atoi(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				d.Set("site_id", siteID)
+				d.Set("mode", siteMaintenanceModeBypass)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		CustomizeDiff: customdiff.All(validateSiteReference("site_id"), validateSiteMaintenanceMode),
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"site_id": {
+				Description: "Numeric identifier of the site to operate on.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"enabled": {
+				Description: "Switches the site into maintenance mode.",
+				Type:        schema.TypeBool,
+				Required:    true,
+			},
+
+			// Optional Arguments
+			"mode": {
+				Description:  "The behavior of the site in maintenance mode. `bypass` routes the traffic directly to the origin, `error_page` blocks the requests with the error response.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      siteMaintenanceModeBypass,
+				ValidateFunc: validateEnum(siteMaintenanceModeValues),
+			},
+			"filter": {
+				Description: "The requests blocked in `error_page` mode, e.g. to let the health checks through. If not specified, all the requests are blocked.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"response_code": {
+				Description:  "The status code of the error response in `error_page` mode.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      503,
+				ValidateFunc: validation.IntBetween(400, 599),
+			},
+			"error_response_format": {
+				Description:  "The format of `error_response_data`. Possible values: `json`, `xml`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "json",
+				ValidateFunc: validateEnum(incapRuleErrorResponseFormatValues),
+			},
+			"error_response_data": {
+				Description: "The error response returned in `error_page` mode.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			// Computed Attributes
+			"block_rule_id": {
+				Description: "The ID of the rule blocking the requests in `error_page` mode.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"error_response_rule_id": {
+				Description: "The ID of the rule returning the error response in `error_page` mode.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func validateSiteMaintenanceMode(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if diff.Get("mode").(string) == siteMaintenanceModeErrorPage && diff.NewValueKnown("error_response_data") && diff.Get("error_response_data").(string) == "" {
+		return fmt.Errorf("error_response_data must be set when mode is %s", siteMaintenanceModeErrorPage)
+	}
+	return nil
+}
+
+// siteMaintenanceModeRules returns the rules of the error_page mode by attribute of their ID, the error response
+// is listed first so it's in place before the requests are blocked
+func siteMaintenanceModeRules(d *schema.ResourceData) ([]string, map[string]*imperva.IncapRule) {
+	return []string{"error_response_rule_id", "block_rule_id"}, map[string]*imperva.IncapRule{
+		"error_response_rule_id": {
+			Name:                "Maintenance mode error response",
+			Action:              "RULE_ACTION_CUSTOM_ERROR_RESPONSE",
+			ResponseCode:        d.Get("response_code").(int),
+			ErrorType:           "error.type.access_denied",
+			ErrorResponseFormat: d.Get("error_response_format").(string),
+			ErrorResponseData:   d.Get("error_response_data").(string),
+		},
+		"block_rule_id": {
+			Name:   "Maintenance mode",
+			Action: "RULE_ACTION_BLOCK",
+			Filter: d.Get("filter").(string),
+		},
+	}
+}
+
+func resourceSiteMaintenanceModeUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	siteID := d.Get("site_id").(int)
+	enabled := d.Get("enabled").(bool)
+	mode := d.Get("mode").(string)
+
+	log.Printf("[INFO] Setting Incapsula maintenance mode (enabled: %t, mode: %s) for site id: %d\n", enabled, mode, siteID)
+
+	// The rule IDs are kept in the state even if a later step fails
+	d.SetId(strconv.Itoa(siteID))
+
+	if enabled && mode == siteMaintenanceModeErrorPage {
+		err := addSiteMaintenanceModeRules(client, d)
+		if err != nil {
+			return err
+		}
+	} else {
+		err := deleteSiteMaintenanceModeRules(client, d)
+		if err != nil {
+			return err
+		}
+	}
+
+	active := "active"
+	if enabled && mode == siteMaintenanceModeBypass {
+		active = "bypass"
+	}
+	_, err := client.UpdateSite(strconv.Itoa(siteID), "active", active)
+	if err != nil {
+		log.Printf("[ERROR] Could not set Incapsula site id: %d %s, %s\n", siteID, active, err)
+		return err
+	}
+
+	log.Printf("[INFO] Set Incapsula maintenance mode (enabled: %t, mode: %s) for site id: %d\n", enabled, mode, siteID)
+
+	// The listed details of the site are outdated
+	client.InvalidateCachedSite(siteID)
+	return resourceSiteMaintenanceModeRead(d, m)
+}
+
+func addSiteMaintenanceModeRules(client *imperva.Client, d *schema.ResourceData) error {
+	siteID := strconv.Itoa(d.Get("site_id").(int))
+	keys, rules := siteMaintenanceModeRules(d)
+	for _, key := range keys {
+		ruleID := d.Get(key).(int)
+		if ruleID != 0 {
+			_, err := client.UpdateIncapRule(siteID, ruleID, rules[key])
+			if err != nil {
+				log.Printf("[ERROR] Could not update Incapsula maintenance mode rule %d for site id: %s, %s\n", ruleID, siteID, err)
+				return err
+			}
+			continue
+		}
+
+		rule, err := client.AddIncapRule(siteID, rules[key])
+		if err != nil {
+			log.Printf("[ERROR] Could not add Incapsula maintenance mode rule (%s) for site id: %s, %s\n", rules[key].Name, siteID, err)
+			return err
+		}
+		d.Set(key, rule.RuleID)
+	}
+	return nil
+}
+
+func deleteSiteMaintenanceModeRules(client *imperva.Client, d *schema.ResourceData) error {
+	siteID := strconv.Itoa(d.Get("site_id").(int))
+	keys, _ := siteMaintenanceModeRules(d)
+	// The requests are unblocked before the error response is removed
+	for i := len(keys) - 1; i >= 0; i-- {
+		ruleID := d.Get(keys[i]).(int)
+		if ruleID == 0 {
+			continue
+		}
+
+		err := client.DeleteIncapRule(siteID, ruleID)
+		if err != nil && !imperva.IsNotFoundError(err) {
+			log.Printf("[ERROR] Could not delete Incapsula maintenance mode rule %d for site id: %s, %s\n", ruleID, siteID, err)
+			return err
+		}
+		d.Set(keys[i], 0)
+	}
+	return nil
+}
+
+func resourceSiteMaintenanceModeRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	siteID, _ := strconv.Atoi(d.Id())
+
+	log.Printf("[INFO] Reading Incapsula maintenance mode for site id: %d\n", siteID)
+
+	siteStatusResponse, err := client.CachedSiteStatus("maintenance-mode", siteID, 0)
+
+	// Site object may have been deleted
+	if siteStatusResponse != nil && siteStatusResponse.Res.(float64) == 9413 {
+		log.Printf("[INFO] Incapsula Site ID %d has already been deleted: %s\n", siteID, err)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula maintenance mode for site id: %d, %s\n", siteID, err)
+		return err
+	}
+
+	// The rules may have been deleted outside of Terraform
+	keys, _ := siteMaintenanceModeRules(d)
+	rulesFound := true
+	for _, key := range keys {
+		ruleID := d.Get(key).(int)
+		if ruleID == 0 {
+			rulesFound = false
+			continue
+		}
+
+		rule, statusCode, err := client.ReadIncapRule(strconv.Itoa(siteID), ruleID)
+		if statusCode == 404 {
+			log.Printf("[INFO] Incapsula maintenance mode rule %d for site id: %d has already been deleted\n", ruleID, siteID)
+			d.Set(key, 0)
+			rulesFound = false
+			continue
+		}
+		if err != nil {
+			log.Printf("[ERROR] Could not read Incapsula maintenance mode rule %d for site id: %d, %s\n", ruleID, siteID, err)
+			return err
+		}
+
+		// The rules may have been changed outside of Terraform
+		switch key {
+		case "error_response_rule_id":
+			d.Set("response_code", rule.ResponseCode)
+			d.Set("error_response_format", rule.ErrorResponseFormat)
+			d.Set("error_response_data", rule.ErrorResponseData)
+		case "block_rule_id":
+			d.Set("filter", rule.Filter)
+		}
+	}
+
+	enabled := rulesFound
+	if d.Get("mode").(string) == siteMaintenanceModeBypass {
+		enabled = siteStatusResponse.Active == "bypass"
+	}
+
+	d.Set("site_id", siteID)
+	d.Set("enabled", enabled)
+
+	log.Printf("[INFO] Finished reading Incapsula maintenance mode for site id: %d\n", siteID)
+
+	return nil
+}
+
+func resourceSiteMaintenanceModeDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	siteID := d.Get("site_id").(int)
+
+	log.Printf("[INFO] Disabling Incapsula maintenance mode for site id: %d\n", siteID)
+
+	err := deleteSiteMaintenanceModeRules(client, d)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.UpdateSite(strconv.Itoa(siteID), "active", "active")
+	if err != nil {
+		return fmt.Errorf("Error disabling maintenance mode of site id: %d: %s", siteID, err)
+	}
+
+	client.InvalidateCachedSite(siteID)
+	d.SetId("")
+
+	log.Printf("[INFO] Disabled Incapsula maintenance mode for site id: %d\n", siteID)
+
+	return nil
+}
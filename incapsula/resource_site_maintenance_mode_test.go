@@ -0,0 +1,85 @@
+package incapsula
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const siteMaintenanceModeResourceType = "incapsula_site_maintenance_mode"
+const siteMaintenanceModeResourceName = "testacc-terraform-site-maintenance-mode"
+const siteMaintenanceModeResource = siteMaintenanceModeResourceType + "." + siteMaintenanceModeResourceName
+
+func TestAccIncapsulaSiteMaintenanceMode_Bypass(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaSiteMaintenanceModeConfigBypass(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(siteMaintenanceModeResource, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIncapsulaSiteMaintenanceModeConfigBypass(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(siteMaintenanceModeResource, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      siteMaintenanceModeResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIncapsulaSiteMaintenanceMode_ErrorPage(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaSiteMaintenanceModeConfigErrorPage(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(siteMaintenanceModeResource, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(siteMaintenanceModeResource, "block_rule_id"),
+					resource.TestCheckResourceAttrSet(siteMaintenanceModeResource, "error_response_rule_id"),
+				),
+			},
+			{
+				Config: testAccCheckIncapsulaSiteMaintenanceModeConfigErrorPage(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(siteMaintenanceModeResource, "enabled", "false"),
+					resource.TestCheckResourceAttr(siteMaintenanceModeResource, "block_rule_id", "0"),
+					resource.TestCheckResourceAttr(siteMaintenanceModeResource, "error_response_rule_id", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIncapsulaSiteMaintenanceModeConfigBypass(enabled bool) string {
+	return testAccCheckIncapsulaSiteConfigBasic(GenerateTestDomain(nil)) + fmt.Sprintf(`
+	resource "%s" "%s" {
+		site_id = %s.id
+		enabled = %t
+	}`,
+		siteMaintenanceModeResourceType, siteMaintenanceModeResourceName, siteResourceName, enabled,
+	)
+}
+
+func testAccCheckIncapsulaSiteMaintenanceModeConfigErrorPage(enabled bool) string {
+	return testAccCheckIncapsulaSiteConfigBasic(GenerateTestDomain(nil)) + fmt.Sprintf(`
+	resource "%s" "%s" {
+		site_id             = %s.id
+		enabled             = %t
+		mode                = "error_page"
+		error_response_data = "{\"message\": \"Down for maintenance\"}"
+	}`,
+		siteMaintenanceModeResourceType, siteMaintenanceModeResourceName, siteResourceName, enabled,
+	)
+}
@@ -2,6 +2,7 @@ package incapsula
 
 import (
 	"fmt"
+	"log"
 	"os"
 	"strconv"
 	"testing"
@@ -12,11 +13,44 @@ import (
 
 const siteResourceName = "incapsula_site.testacc-terraform-site"
 
+// testAccSiteDomainSuffix ends the domains of all the sites created by the acceptance tests, see the sweeper
+const testAccSiteDomainSuffix = ".examplesite.com"
+
+func init() {
+	resource.AddTestSweepers("incapsula_site", &resource.Sweeper{
+		Name:         "incapsula_site",
+		Dependencies: []string{"incapsula_custom_certificate"},
+		F:            testSweepSites,
+	})
+}
+
 func GenerateTestDomain(t *testing.T) string {
 	if v := os.Getenv("INCAPSULA_API_ID"); v == "" && t != nil {
 		t.Fatal("INCAPSULA_API_ID must be set for acceptance tests")
 	}
-	return "id" + os.Getenv("INCAPSULA_API_ID") + ".examplesite.com"
+	return "id" + os.Getenv("INCAPSULA_API_ID") + testAccSiteDomainSuffix
+}
+
+func testSweepSites(region string) error {
+	client, err := sharedClientForSweepers()
+	if err != nil {
+		return fmt.Errorf("Error getting client: %s", err)
+	}
+
+	sites, err := listTestSitesForSweepers(client)
+	if err != nil {
+		return fmt.Errorf("Error listing sites: %s", err)
+	}
+
+	for _, site := range sites {
+		log.Printf("[INFO] Sweeping Incapsula site %s (site id: %d)", site.Domain, site.SiteID)
+		err = client.DeleteSite(site.Domain, site.SiteID)
+		if err != nil {
+			return fmt.Errorf("Error deleting site %s (site id: %d): %s", site.Domain, site.SiteID, err)
+		}
+	}
+
+	return nil
 }
 
 func TestAccIncapsulaSite_Basic(t *testing.T) {
@@ -49,14 +83,21 @@ func TestAccIncapsulaSite_ImportBasic(t *testing.T) {
 				ResourceName:            "incapsula_site.testacc-terraform-site",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"site_ip"},
+				ImportStateVerifyIgnore: []string{"site_ip", "deletion_protection", "deactivate_on_destroy"},
+			},
+			{
+				ResourceName:            "incapsula_site.testacc-terraform-site",
+				ImportState:             true,
+				ImportStateId:           GenerateTestDomain(t),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"site_ip", "deletion_protection", "deactivate_on_destroy"},
 			},
 		},
 	})
 }
 
 func testAccCheckIncapsulaSiteDestroy(state *terraform.State) error {
-	client := testAccProvider.Meta().(*Client)
+	client := testAccProvider.Meta().(*providerMeta).client
 
 	for _, res := range state.RootModule().Resources {
 		if res.Type != "incapsula_site" {
@@ -100,7 +141,7 @@ func testCheckIncapsulaSiteExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Site ID conversion error for %s: %s", siteIDStr, err)
 		}
 
-		client := testAccProvider.Meta().(*Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 		siteStatusResponse, err := client.SiteStatus(GenerateTestDomain(nil), siteID)
 		if siteStatusResponse == nil {
 			return fmt.Errorf("Incapsula site for domain: %s (site id: %d) does not exist", GenerateTestDomain(nil), siteID)
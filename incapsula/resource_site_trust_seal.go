@@ -0,0 +1,118 @@
+package incapsula
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const siteSealLocationNone = "api.seal_location.none"
+
+func resourceSiteTrustSeal() *schema.Resource {
+	return &schema.Resource{
+		Create: withSiteLock(resourceSiteTrustSealUpdate),
+		Read:   resourceSiteTrustSealRead,
+		Update: withSiteLock(resourceSiteTrustSealUpdate),
+		Delete: withSiteLock(resourceSiteTrustSealDelete),
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				siteID, err := strconv.Atoi(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				d.Set("site_id", siteID)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		CustomizeDiff: validateSiteReference("site_id"),
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"site_id": {
+				Description: "Numeric identifier of the site to operate on.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"location": {
+				Description:  "The location of the trust seal on the pages of the site. Set to `api.seal_location.none` to hide the seal.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateEnum(siteSealLocationValues),
+			},
+		},
+	}
+}
+
+func resourceSiteTrustSealUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	siteID := d.Get("site_id").(int)
+	location := d.Get("location").(string)
+
+	log.Printf("[INFO] Setting Incapsula trust seal location to %s for site id: %d\n", location, siteID)
+
+	_, err := client.UpdateSite(strconv.Itoa(siteID), "seal_location", location)
+	if err != nil {
+		log.Printf("[ERROR] Could not set Incapsula trust seal location to %s for site id: %d, %s\n", location, siteID, err)
+		return err
+	}
+
+	d.SetId(strconv.Itoa(siteID))
+
+	log.Printf("[INFO] Set Incapsula trust seal location to %s for site id: %d\n", location, siteID)
+
+	// The listed details of the site are outdated
+	client.InvalidateCachedSite(siteID)
+	return resourceSiteTrustSealRead(d, m)
+}
+
+func resourceSiteTrustSealRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	siteID, _ := strconv.Atoi(d.Id())
+
+	log.Printf("[INFO] Reading Incapsula trust seal for site id: %d\n", siteID)
+
+	siteStatusResponse, err := client.CachedSiteStatus("trust-seal", siteID, 0)
+
+	// Site object may have been deleted
+	if siteStatusResponse != nil && siteStatusResponse.Res.(float64) == 9413 {
+		log.Printf("[INFO] Incapsula Site ID %d has already been deleted: %s\n", siteID, err)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula trust seal for site id: %d, %s\n", siteID, err)
+		return err
+	}
+
+	d.Set("site_id", siteID)
+	d.Set("location", siteStatusResponse.SealLocation.ID)
+
+	log.Printf("[INFO] Finished reading Incapsula trust seal for site id: %d\n", siteID)
+
+	return nil
+}
+
+func resourceSiteTrustSealDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	siteID := d.Get("site_id").(int)
+
+	log.Printf("[INFO] Hiding Incapsula trust seal for site id: %d\n", siteID)
+
+	// The seal can't be removed from a site, it's hidden
+	_, err := client.UpdateSite(strconv.Itoa(siteID), "seal_location", siteSealLocationNone)
+	if err != nil {
+		log.Printf("[ERROR] Could not hide Incapsula trust seal for site id: %d, %s\n", siteID, err)
+		return err
+	}
+
+	client.InvalidateCachedSite(siteID)
+	d.SetId("")
+
+	log.Printf("[INFO] Hid Incapsula trust seal for site id: %d\n", siteID)
+
+	return nil
+}
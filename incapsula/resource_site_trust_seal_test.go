@@ -0,0 +1,48 @@
+package incapsula
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const siteTrustSealResourceType = "incapsula_site_trust_seal"
+const siteTrustSealResourceName = "testacc-terraform-site-trust-seal"
+const siteTrustSealResource = siteTrustSealResourceType + "." + siteTrustSealResourceName
+
+func TestAccIncapsulaSiteTrustSeal_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaSiteTrustSealConfigBasic("api.seal_location.bottom_right"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(siteTrustSealResource, "location", "api.seal_location.bottom_right"),
+				),
+			},
+			{
+				Config: testAccCheckIncapsulaSiteTrustSealConfigBasic("api.seal_location.left"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(siteTrustSealResource, "location", "api.seal_location.left"),
+				),
+			},
+			{
+				ResourceName:      siteTrustSealResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIncapsulaSiteTrustSealConfigBasic(location string) string {
+	return testAccCheckIncapsulaSiteConfigBasic(GenerateTestDomain(nil)) + fmt.Sprintf(`
+	resource "%s" "%s" {
+		site_id  = %s.id
+		location = "%s"
+	}`,
+		siteTrustSealResourceType, siteTrustSealResourceName, siteResourceName, location,
+	)
+}
@@ -1,22 +1,39 @@
 package incapsula
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"context"
+	"fmt"
 	"log"
 	"strconv"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
 )
 
+const (
+	cloneSettingDataStorageRegion    = "data_storage_region"
+	cloneSettingPolicies             = "policies"
+	cloneSettingNotificationPolicies = "notification_policies"
+	cloneSettingLogConfig            = "log_config"
+)
+
+var cloneSettings = []string{cloneSettingDataStorageRegion, cloneSettingPolicies, cloneSettingNotificationPolicies, cloneSettingLogConfig}
+
 func resourceSubAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceSubAccountCreate,
-		Read:   resourceSubAccountRead,
-		Delete: resourceSubAccountDelete,
+		CreateContext: resourceSubAccountCreate,
+		Read:          resourceSubAccountRead,
+		Update:        resourceSubAccountUpdate,
+		Delete:        resourceSubAccountDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: validateAccountReference("parent_id"),
+
 		Schema: map[string]*schema.Schema{
 			// Required Arguments
 			"sub_account_name": {
@@ -43,40 +60,79 @@ func resourceSubAccount() *schema.Resource {
 				Description: "Available only for Enterprise Plan customers that purchased the Logs Integration SKU. Numeric identifier of the account that purchased the logs integration SKU and which collects the logs. If not specified, operation will be performed on the account identified by the authentication parameters.",
 				Type:        schema.TypeInt,
 				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 			},
 			"log_level": {
 				Description:  "The log level. Options are `full`, `security`, `none` and `default`.",
 				Type:         schema.TypeString,
 				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice([]string{"full", "security", "none", "default"}, false),
 			},
+			"source_account_id": {
+				Description:      "Numeric identifier of an existing sub-account of the parent account to use as a template. The settings listed in `clone_settings` are copied from this account to the new sub-account during creation, changing it afterwards has no effect.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				DiffSuppressFunc: suppressDiffAfterCreate,
+			},
+			"clone_settings": {
+				Description: "The settings to copy from `source_account_id`. Options are `data_storage_region`, `policies`, `notification_policies` and `log_config`. If not specified, all of them are copied.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(cloneSettings, false),
+				},
+				Optional:         true,
+				RequiredWith:     []string{"source_account_id"},
+				DiffSuppressFunc: suppressDiffAfterCreate,
+			},
+			"deletion_protection": {
+				Description: "Protect the sub-account from being deleted. When `true`, destroying the resource fails with an error. Default value: false",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
 		},
 	}
 }
 
-func resourceSubAccountCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+// suppressDiffAfterCreate ignores the changes of the arguments only used to create the resource
+func suppressDiffAfterCreate(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
+
+func resourceSubAccountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*providerMeta).client
 	subAccountName := d.Get("sub_account_name").(string)
 
+	// The log configuration is part of the creation request, a failure to read it leaves nothing to clean up
+	err := cloneSubAccountLogConfig(client, d)
+	if err != nil {
+		log.Printf("[ERROR] Could not clone the log configuration of account %d for Incapsula subaccount %s, %s\n", d.Get("source_account_id").(int), subAccountName, err)
+		return diag.FromErr(err)
+	}
+
 	log.Printf("[INFO] Creating Incapsula subaccount: %s\n", subAccountName)
 	log.Printf("[INFO] logs_account_id: %d\n", d.Get("logs_account_id").(int))
 	log.Printf("[INFO] log_level: %s\n", d.Get("log_level").(string))
 	log.Printf("[INFO] parent_id: %d\n", d.Get("parent_id").(int))
 	log.Printf("[INFO] ref_id: %s\n", d.Get("ref_id").(string))
 
-	subAccountPayload := SubAccountPayload{subAccountName,
-		d.Get("ref_id").(string),
-		d.Get("log_level").(string),
-		d.Get("parent_id").(int),
-		d.Get("logs_account_id").(int)}
+	subAccountPayload := imperva.SubAccountPayload{
+		SubAccountName: subAccountName,
+		RefID:          d.Get("ref_id").(string),
+		LogLevel:       d.Get("log_level").(string),
+		ParentID:       d.Get("parent_id").(int),
+		LogsAccountID:  d.Get("logs_account_id").(int),
+	}
 
 	SubAccountAddResponse, err := client.AddSubAccount(&subAccountPayload)
 
 	if err != nil {
 		log.Printf("[ERROR] Could not create Incapsula subaccount %s, %s\n", subAccountName, err)
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Set the SubAccount ID
@@ -88,11 +144,18 @@ func resourceSubAccountCreate(d *schema.ResourceData, m interface{}) error {
 	// Set an arbitrary period to sleep
 	time.Sleep(3 * time.Second)
 
-	return resourceSubAccountRead(d, m)
+	// The subaccount exists, settings which couldn't be copied are reported without tainting it
+	diags := cloneSubAccountSettings(client, d)
+
+	err = resourceSubAccountRead(d, m)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	return diags
 }
 
 func resourceSubAccountRead(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 	subAccountID, _ := strconv.Atoi(d.Id())
 	subAccount, err := client.GetSubAccount(d.Get("parent_id").(int), subAccountID)
 
@@ -117,10 +180,20 @@ func resourceSubAccountRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+func resourceSubAccountUpdate(d *schema.ResourceData, m interface{}) error {
+	// Only deletion_protection can be updated in place and it isn't sent to the API, the clone arguments are ignored
+	// after creation
+	return resourceSubAccountRead(d, m)
+}
+
 func resourceSubAccountDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 	subAccountID, _ := strconv.Atoi(d.Id())
 
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("Cannot delete Incapsula subaccount id: %d: deletion_protection is enabled. Set deletion_protection to false and apply before destroying the subaccount", subAccountID)
+	}
+
 	log.Printf("[INFO] Deleting Incapsula subaccount id: %d\n", subAccountID)
 
 	err := client.DeleteSubAccount(subAccountID)
@@ -138,3 +211,150 @@ func resourceSubAccountDelete(d *schema.ResourceData, m interface{}) error {
 
 	return nil
 }
+
+// subAccountCloneSettings returns the settings to copy from source_account_id, all of them by default
+func subAccountCloneSettings(d *schema.ResourceData) []string {
+	settings := d.Get("clone_settings").(*schema.Set)
+	if settings.Len() == 0 {
+		return cloneSettings
+	}
+
+	result := make([]string, 0, settings.Len())
+	for _, setting := range settings.List() {
+		result = append(result, setting.(string))
+	}
+	return result
+}
+
+// cloneSubAccountLogConfig sets the log level and logs account of the template account on the creation request,
+// unless they're configured
+func cloneSubAccountLogConfig(client *imperva.Client, d *schema.ResourceData) error {
+	sourceAccountID := d.Get("source_account_id").(int)
+	if sourceAccountID == 0 || !containsString(subAccountCloneSettings(d), cloneSettingLogConfig) {
+		return nil
+	}
+
+	sourceAccount, err := client.GetSubAccount(d.Get("parent_id").(int), sourceAccountID)
+	if err != nil {
+		return err
+	}
+	if sourceAccount == nil || sourceAccount.SubAccountPayload == nil {
+		return fmt.Errorf("source_account_id %d is not a sub-account of the parent account", sourceAccountID)
+	}
+
+	if _, ok := d.GetOk("log_level"); !ok {
+		d.Set("log_level", sourceAccount.LogLevel)
+	}
+	if _, ok := d.GetOk("logs_account_id"); !ok {
+		d.Set("logs_account_id", sourceAccount.LogsAccountID)
+	}
+	return nil
+}
+
+// cloneSubAccountSettings copies the settings of the template account to the new subaccount, the settings which
+// couldn't be copied are returned as warnings
+func cloneSubAccountSettings(client *imperva.Client, d *schema.ResourceData) diag.Diagnostics {
+	sourceAccountID := d.Get("source_account_id").(int)
+	if sourceAccountID == 0 {
+		return nil
+	}
+	subAccountID, _ := strconv.Atoi(d.Id())
+
+	var diags diag.Diagnostics
+	for _, setting := range subAccountCloneSettings(d) {
+		log.Printf("[INFO] Cloning %s from account %d to Incapsula subaccount %d\n", setting, sourceAccountID, subAccountID)
+
+		var err error
+		switch setting {
+		case cloneSettingDataStorageRegion:
+			err = cloneDataStorageRegion(client, sourceAccountID, subAccountID)
+		case cloneSettingPolicies:
+			err = clonePolicies(client, sourceAccountID, subAccountID)
+		case cloneSettingNotificationPolicies:
+			err = cloneNotificationCenterPolicies(client, sourceAccountID, subAccountID)
+		}
+
+		if err != nil {
+			log.Printf("[ERROR] Could not clone %s from account %d to Incapsula subaccount %d: %s\n", setting, sourceAccountID, subAccountID, err)
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Could not clone %s from account %d to Incapsula subaccount %d", setting, sourceAccountID, subAccountID),
+				Detail:   fmt.Sprintf("The subaccount was created without them: %s", err),
+			})
+		}
+	}
+
+	return diags
+}
+
+func cloneDataStorageRegion(client *imperva.Client, sourceAccountID, subAccountID int) error {
+	accountDataStorageRegionResponse, err := client.GetAccountDataStorageRegion(strconv.Itoa(sourceAccountID))
+	if err != nil {
+		return err
+	}
+
+	_, err = client.UpdateAccountDataStorageRegion(strconv.Itoa(subAccountID), accountDataStorageRegionResponse.Region)
+	return err
+}
+
+func clonePolicies(client *imperva.Client, sourceAccountID, subAccountID int) error {
+	policyListResponse, err := client.ListPolicies(sourceAccountID)
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range policyListResponse.Value {
+		// Default policies are created with the account, inherited ones belong to another account
+		if len(policy.DefaultPolicyConfig) > 0 || (policy.AccountID != 0 && policy.AccountID != sourceAccountID) {
+			log.Printf("[DEBUG] Skipping default or inherited policy %d of account %d\n", policy.ID, sourceAccountID)
+			continue
+		}
+
+		policySubmitted := imperva.PolicySubmitted{
+			Name:           policy.Name,
+			Description:    policy.Description,
+			Enabled:        policy.Enabled,
+			AccountID:      subAccountID,
+			PolicyType:     policy.PolicyType,
+			PolicySettings: policy.PolicySettings,
+		}
+
+		_, err := client.AddPolicy(&policySubmitted)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func cloneNotificationCenterPolicies(client *imperva.Client, sourceAccountID, subAccountID int) error {
+	notificationPolicyList, err := client.ListNotificationCenterPolicies(sourceAccountID)
+	if err != nil {
+		return err
+	}
+
+	for _, notificationPolicy := range notificationPolicyList.Data {
+		// Policies inherited from the parent account apply to the subaccount already
+		if notificationPolicy.AccountId != 0 && notificationPolicy.AccountId != sourceAccountID {
+			log.Printf("[DEBUG] Skipping inherited notification policy %d of account %d\n", notificationPolicy.PolicyId, sourceAccountID)
+			continue
+		}
+
+		// Assets and sub accounts belong to the source account, so the copy starts without them
+		notificationPolicy.PolicyId = 0
+		notificationPolicy.AccountId = subAccountID
+		notificationPolicy.AssetList = []imperva.AssetDto{}
+		notificationPolicy.SubAccountPolicyInfo = imperva.SubAccountPolicyInfo{
+			ApplyToNewSubAccounts: "FALSE",
+			SubAccountList:        []imperva.SubAccountDTO{},
+		}
+
+		_, err := client.AddNotificationCenterPolicy(&notificationPolicy)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -2,17 +2,65 @@ package incapsula
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"log"
 	"strconv"
+	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
 )
 
 const subAccountResourceType = "incapsula_subaccount"
 const subAccountResourceName = "example_subaccount"
 const subAccountName = "acceptance-subaccount-test-1"
 
+// testAccSubAccountNamePrefix starts the names of all the subaccounts created by the acceptance tests, see the sweeper
+const testAccSubAccountNamePrefix = "acceptance-subaccount-test"
+
+func init() {
+	resource.AddTestSweepers("incapsula_subaccount", &resource.Sweeper{
+		Name:         "incapsula_subaccount",
+		Dependencies: []string{"incapsula_site"},
+		F:            testSweepSubAccounts,
+	})
+}
+
+func testSweepSubAccounts(region string) error {
+	client, err := sharedClientForSweepers()
+	if err != nil {
+		return fmt.Errorf("Error getting client: %s", err)
+	}
+
+	// List all the pages before deleting, so deletions don't shift the pages
+	testSubAccounts := make([]imperva.SubAccount, 0)
+	for pageNum := 0; ; pageNum++ {
+		subAccounts, err := client.ListSubAccounts(0, pageNum)
+		if err != nil {
+			return fmt.Errorf("Error listing subaccounts: %s", err)
+		}
+		for _, subAccount := range subAccounts {
+			if subAccount.SubAccountPayload != nil && strings.HasPrefix(subAccount.SubAccountName, testAccSubAccountNamePrefix) {
+				testSubAccounts = append(testSubAccounts, subAccount)
+			}
+		}
+		if len(subAccounts) < imperva.PAGE_SIZE {
+			break
+		}
+	}
+
+	for _, subAccount := range testSubAccounts {
+		log.Printf("[INFO] Sweeping Incapsula subaccount %s (id: %d)", subAccount.SubAccountName, subAccount.SubAccountID)
+		err = client.DeleteSubAccount(subAccount.SubAccountID)
+		if err != nil {
+			return fmt.Errorf("Error deleting subaccount %s (id: %d): %s", subAccount.SubAccountName, subAccount.SubAccountID, err)
+		}
+	}
+
+	return nil
+}
+
 func TestAccIncapsulaSubAccount_Basic(t *testing.T) {
 	log.Printf("========================BEGIN TEST========================")
 	log.Printf("[DEBUG]Running test resource_txt_settings.go.TestAccIncapsulaSubAccount_Basic")
@@ -30,10 +78,11 @@ func TestAccIncapsulaSubAccount_Basic(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      subAccountResourceType + "." + subAccountResourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
-				ImportStateIdFunc: testACCStateSubAccountID,
+				ResourceName:            subAccountResourceType + "." + subAccountResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testACCStateSubAccountID,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -49,7 +98,7 @@ func getAccIncapsulaSubAccountConfigBasic() string {
 }
 
 func testAccIncapsulaSubAccountDestroy(state *terraform.State) error {
-	client := testAccProvider.Meta().(*Client)
+	client := testAccProvider.Meta().(*providerMeta).client
 
 	for _, res := range state.RootModule().Resources {
 		if res.Type != subAccountResourceType {
@@ -114,7 +163,7 @@ func testCheckIncapsulaSubAccountExists() resource.TestCheckFunc {
 			return fmt.Errorf("Incapsula API SubAccount does not exists")
 		}
 
-		client := testAccProvider.Meta().(*Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 		log.Printf("[INFO] **** subAccountID: %d", subAccountID)
 		subAccount, err := client.GetSubAccount(0, subAccountID)
 		if err != nil {
@@ -6,18 +6,21 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
 )
 
 func resourceTXTRecord() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTXTRecordCreate,
+		Create: withSiteLock(resourceTXTRecordCreate),
 		Read:   resourceTXTRecordRead,
-		Update: resourceTXTRecordUpdate,
-		Delete: resourceTXTRecordDelete,
+		Update: withSiteLock(resourceTXTRecordUpdate),
+		Delete: withSiteLock(resourceTXTRecordDelete),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: validateSiteReference("site_id"),
+
 		Schema: map[string]*schema.Schema{
 			// Required Argument
 			"site_id": {
@@ -58,7 +61,7 @@ func resourceTXTRecord() *schema.Resource {
 
 func resourceTXTRecordCreate(d *schema.ResourceData, m interface{}) error {
 	// Implement by create the TXT Records
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 	siteID := d.Get("site_id").(int)
 
 	TXTRecordOne := d.Get("txt_record_value_one").(string)
@@ -84,7 +87,7 @@ func resourceTXTRecordCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceTXTRecordUpdate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 	siteID := d.Get("site_id").(int)
 	errDelete := deleteSpecificTXTRecordIfNeeded(d, siteID, client)
 	if errDelete != nil {
@@ -98,7 +101,7 @@ func resourceTXTRecordUpdate(d *schema.ResourceData, m interface{}) error {
 	return resourceTXTRecordRead(d, m)
 }
 
-func updateSpecificTXTRecordIfNeeded(d *schema.ResourceData, siteID int, client *Client) error {
+func updateSpecificTXTRecordIfNeeded(d *schema.ResourceData, siteID int, client *imperva.Client) error {
 	if (d.HasChange("txt_record_value_one") && d.Get("txt_record_value_one") != "") ||
 		(d.HasChange("txt_record_value_two") && d.Get("txt_record_value_two") != "") ||
 		(d.HasChange("txt_record_value_three") && d.Get("txt_record_value_three") != "") ||
@@ -125,7 +128,7 @@ func updateSpecificTXTRecordIfNeeded(d *schema.ResourceData, siteID int, client
 	return nil
 }
 
-func deleteSpecificTXTRecordIfNeeded(d *schema.ResourceData, siteID int, client *Client) error {
+func deleteSpecificTXTRecordIfNeeded(d *schema.ResourceData, siteID int, client *imperva.Client) error {
 	if d.HasChange("txt_record_value_one") && d.Get("txt_record_value_one") == "" {
 		log.Printf("[INFO] Delete Incapsula TXT Record 1, for siteID: %d", siteID)
 		err := client.DeleteTXTRecord(siteID, "1")
@@ -167,7 +170,7 @@ func deleteSpecificTXTRecordIfNeeded(d *schema.ResourceData, siteID int, client
 
 func resourceTXTRecordRead(d *schema.ResourceData, m interface{}) error {
 	// Implement by reading the TXTRecordResponse for the TXT Records
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 	id, err := strconv.Atoi(d.Id())
 	if err != nil {
 		log.Printf("[ERROR] The ID should be numeric. Currrent value: %s", d.Id())
@@ -197,7 +200,7 @@ func resourceTXTRecordRead(d *schema.ResourceData, m interface{}) error {
 
 func resourceTXTRecordDelete(d *schema.ResourceData, m interface{}) error {
 	// Implement by deleting the a TXT Record
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 	siteID := d.Get("site_id").(int)
 	err := client.DeleteTXTRecordAll(siteID)
 	if err != nil {
@@ -2,12 +2,13 @@ package incapsula
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"log"
 	"strconv"
 	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 const txtRecordResourceName = "incapsula_txt_record"
@@ -44,7 +45,7 @@ func TestAccIncapsulaTxtRecord_Basic(t *testing.T) {
 }
 
 func testACCStateTXTRecordDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*Client)
+	client := testAccProvider.Meta().(*providerMeta).client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != txtRecordResource {
@@ -91,7 +92,7 @@ func testCheckTxtRecordExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Error parsing ID %v to int", res.Primary.ID)
 		}
 
-		client := testAccProvider.Meta().(*Client)
+		client := testAccProvider.Meta().(*providerMeta).client
 		recordResponse, err := client.ReadTXTRecords(siteId)
 		if err != nil || strings.Contains(recordResponse.ResMessage, "no TXT records") {
 			fmt.Errorf("Incapsula TXT Record doesn't exist")
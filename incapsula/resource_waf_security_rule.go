@@ -7,7 +7,9 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
 )
 
 // Default actions to reset polies to upon delete/destroy
@@ -23,10 +25,10 @@ const botAccessControlChallengeSuspectedBotsDefaultAction = "false"
 
 func resourceWAFSecurityRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceWAFSecurityRuleCreate,
+		Create: withSiteLock(resourceWAFSecurityRuleCreate),
 		Read:   resourceWAFSecurityRuleRead,
-		Update: resourceWAFSecurityRuleUpdate,
-		Delete: resourceWAFSecurityRuleDelete,
+		Update: withSiteLock(resourceWAFSecurityRuleUpdate),
+		Delete: withSiteLock(resourceWAFSecurityRuleDelete),
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				idSlice := strings.Split(d.Id(), "/")
@@ -46,6 +48,8 @@ func resourceWAFSecurityRule() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: validateSiteReference("site_id"),
+
 		Schema: map[string]*schema.Schema{
 			// Required Arguments
 			"site_id": {
@@ -55,54 +59,67 @@ func resourceWAFSecurityRule() *schema.Resource {
 				ForceNew:    true,
 			},
 			"rule_id": {
-				Description: "The identifier of the WAF rule, e.g api.threats.cross_site_scripting.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Description:  "The identifier of the WAF rule, e.g api.threats.cross_site_scripting.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(getWAFRuleCatalogIDs(), false),
 			},
 
 			// Required for rule_id: api.threats.backdoor, api.threats.cross_site_scripting, api.threats.illegal_resource_access, api.threats.remote_file_inclusion, api.threats.sql_injection
 			"security_rule_action": {
-				Description: "The action that should be taken when a threat is detected, for example: api.threats.action.block_ip.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:  "The action that should be taken when a threat is detected, for example: api.threats.action.block_ip.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateEnum(wafActionValues),
 			},
 
 			// Required for rule_id: api.threats.ddos
 			"activation_mode": {
-				Description: "The mode of activation for ddos on a site. Possible values: off, auto, on.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:  "The mode of activation for ddos on a site. Possible values: off, auto, on.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateEnum(ddosActivationModeValues),
 			},
 			"ddos_traffic_threshold": {
-				Description: "Consider site to be under DDoS if the request rate is above this threshold. The valid values are 10, 20, 50, 100, 200, 500, 750, 1000, 2000, 3000, 4000, 5000.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:  "Consider site to be under DDoS if the request rate is above this threshold. The valid values are 10, 20, 50, 100, 200, 500, 750, 1000, 2000, 3000, 4000, 5000.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateEnum(ddosTrafficThresholdValues),
 			},
 
 			// Required for rule_id: api.threats.bot_access_control
 			"block_bad_bots": {
-				Description: "Whether or not to block bad bots. Possible values: true, false.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:  "Whether or not to block bad bots. Possible values: true, false.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateEnum(booleanStringValues),
 			},
 			"challenge_suspected_bots": {
-				Description: "Whether or not to send a challenge to clients that are suspected to be bad bots (CAPTCHA for example). Possible values: true, false.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:  "Whether or not to send a challenge to clients that are suspected to be bad bots (CAPTCHA for example). Possible values: true, false.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateEnum(booleanStringValues),
+			},
+
+			// Computed Attributes
+			"account_id": {
+				Description: "Numeric identifier of the account of the site, the site is refreshed from the listing of its account.",
+				Type:        schema.TypeInt,
+				Computed:    true,
 			},
 		},
 	}
 }
 
 func resourceWAFSecurityRuleCreate(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	ruleID := d.Get("rule_id").(string)
 
 	log.Printf("[INFO] Creating Incapsula WAF Rule rule_id (%s) on site_id (%d)\n", ruleID, d.Get("site_id").(int))
 
-	if ruleID == backdoorRuleID || ruleID == crossSiteScriptingRuleID || ruleID == illegalResourceAccessRuleID || ruleID == remoteFileInclusionRuleID || ruleID == sqlInjectionRuleID {
+	if ruleID == imperva.BackdoorRuleID || ruleID == imperva.CrossSiteScriptingRuleID || ruleID == imperva.IllegalResourceAccessRuleID || ruleID == imperva.RemoteFileInclusionRuleID || ruleID == imperva.SQLInjectionRuleID {
 		_, err := client.ConfigureWAFSecurityRule(
 			d.Get("site_id").(int),
 			ruleID,
@@ -116,7 +133,7 @@ func resourceWAFSecurityRuleCreate(d *schema.ResourceData, m interface{}) error
 			log.Printf("[ERROR] Could not create Incapsula WAF Rule rule_id (%s) and security_rule_action (%s) on site_id (%d), %s\n", ruleID, d.Get("security_rule_action").(string), d.Get("site_id").(int), err)
 			return err
 		}
-	} else if ruleID == ddosRuleID {
+	} else if ruleID == imperva.DDoSRuleID {
 		_, err := client.ConfigureWAFSecurityRule(
 			d.Get("site_id").(int),
 			ruleID,
@@ -130,7 +147,7 @@ func resourceWAFSecurityRuleCreate(d *schema.ResourceData, m interface{}) error
 			log.Printf("[ERROR] Could not create Incapsula WAF Rule rule_id (%s) with activation_mode (%s) and ddos_traffic_threshold (%s) on site_id (%d), %s\n", ruleID, d.Get("activation_mode").(string), d.Get("ddos_traffic_threshold").(string), d.Get("site_id").(int), err)
 			return err
 		}
-	} else if ruleID == botAccessControlRuleID {
+	} else if ruleID == imperva.BotAccessControlRuleID {
 		_, err := client.ConfigureWAFSecurityRule(
 			d.Get("site_id").(int),
 			ruleID,
@@ -151,18 +168,20 @@ func resourceWAFSecurityRuleCreate(d *schema.ResourceData, m interface{}) error
 
 	log.Printf("[INFO] Created Incapsula WAF Rule rule_id (%s) on site_id (%d)\n", ruleID, d.Get("site_id").(int))
 
+	// The listed details of the site are outdated
+	client.InvalidateCachedSite(d.Get("site_id").(int))
 	return resourceWAFSecurityRuleRead(d, m)
 }
 
 func resourceWAFSecurityRuleRead(d *schema.ResourceData, m interface{}) error {
 	// Implement by reading the SiteResponse for the site
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	ruleID := d.Get("rule_id").(string)
 
 	log.Printf("[INFO] Reading Incapsula WAF Rule for id: %s\n", ruleID)
 
-	siteStatusResponse, err := client.SiteStatus("waf-rule-read", d.Get("site_id").(int))
+	siteStatusResponse, err := client.CachedSiteStatus("waf-rule-read", d.Get("site_id").(int), d.Get("account_id").(int))
 
 	// Site object may have been deleted
 	if siteStatusResponse != nil && siteStatusResponse.Res.(float64) == 9413 {
@@ -176,28 +195,30 @@ func resourceWAFSecurityRuleRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	d.Set("account_id", siteStatusResponse.AccountID)
+
 	found := false
 	// Now with the site status, iterate through the rules and find our ID
 	for _, entry := range siteStatusResponse.Security.Waf.Rules {
 		if entry.ID == d.Get("rule_id").(string) {
 			// Set different attributes based on the rule id
 			switch entry.ID {
-			case backdoorRuleID:
+			case imperva.BackdoorRuleID:
 				d.Set("security_rule_action", entry.Action)
-			case crossSiteScriptingRuleID:
+			case imperva.CrossSiteScriptingRuleID:
 				d.Set("security_rule_action", entry.Action)
-			case customRuleDefaultActionID:
+			case imperva.CustomRuleDefaultActionID:
 				d.Set("security_rule_action", entry.Action)
-			case illegalResourceAccessRuleID:
+			case imperva.IllegalResourceAccessRuleID:
 				d.Set("security_rule_action", entry.Action)
-			case remoteFileInclusionRuleID:
+			case imperva.RemoteFileInclusionRuleID:
 				d.Set("security_rule_action", entry.Action)
-			case sqlInjectionRuleID:
+			case imperva.SQLInjectionRuleID:
 				d.Set("security_rule_action", entry.Action)
-			case ddosRuleID:
+			case imperva.DDoSRuleID:
 				d.Set("activation_mode", entry.ActivationMode)
 				d.Set("ddos_traffic_threshold", strconv.FormatInt(int64(entry.DdosTrafficThreshold), 10))
-			case botAccessControlRuleID:
+			case imperva.BotAccessControlRuleID:
 				d.Set("block_bad_bots", strconv.FormatBool(entry.BlockBadBots))
 				d.Set("challenge_suspected_bots", strconv.FormatBool(entry.ChallengeSuspectedBots))
 			}
@@ -243,7 +264,7 @@ func testAccStateWAFSecurityRuleID(s *terraform.State) (string, error) {
 }
 
 func resourceWAFSecurityRuleDelete(d *schema.ResourceData, m interface{}) error {
-	client := m.(*Client)
+	client := m.(*providerMeta).client
 
 	ruleID := d.Get("rule_id").(string)
 
@@ -251,7 +272,7 @@ func resourceWAFSecurityRuleDelete(d *schema.ResourceData, m interface{}) error
 
 	// Set WAF rule type defaults based on specific rule id
 	switch ruleID {
-	case backdoorRuleID:
+	case imperva.BackdoorRuleID:
 		_, err := client.ConfigureWAFSecurityRule(
 			d.Get("site_id").(int),
 			ruleID,
@@ -265,7 +286,7 @@ func resourceWAFSecurityRuleDelete(d *schema.ResourceData, m interface{}) error
 			log.Printf("[ERROR] Could not reset Incapsula WAF Rule rule_id (%s) with security_rule_action (%s) on site_id (%d) %s\n", ruleID, backdoorRuleIDDefaultAction, d.Get("site_id").(int), err)
 			return err
 		}
-	case crossSiteScriptingRuleID:
+	case imperva.CrossSiteScriptingRuleID:
 		_, err := client.ConfigureWAFSecurityRule(
 			d.Get("site_id").(int),
 			ruleID,
@@ -279,7 +300,7 @@ func resourceWAFSecurityRuleDelete(d *schema.ResourceData, m interface{}) error
 			log.Printf("[ERROR] Could not reset Incapsula WAF Rule rule_id (%s) with security_rule_action (%s) on site_id (%d) %s\n", ruleID, crossSiteScriptingRuleIDDefaultAction, d.Get("site_id").(int), err)
 			return err
 		}
-	case illegalResourceAccessRuleID:
+	case imperva.IllegalResourceAccessRuleID:
 		_, err := client.ConfigureWAFSecurityRule(
 			d.Get("site_id").(int),
 			ruleID,
@@ -293,7 +314,7 @@ func resourceWAFSecurityRuleDelete(d *schema.ResourceData, m interface{}) error
 			log.Printf("[ERROR] Could not reset Incapsula WAF Rule rule_id (%s) with security_rule_action (%s) on site_id (%d) %s\n", ruleID, illegalResourceAccessRuleIDDefaultAction, d.Get("site_id").(int), err)
 			return err
 		}
-	case remoteFileInclusionRuleID:
+	case imperva.RemoteFileInclusionRuleID:
 		_, err := client.ConfigureWAFSecurityRule(
 			d.Get("site_id").(int),
 			ruleID,
@@ -307,7 +328,7 @@ func resourceWAFSecurityRuleDelete(d *schema.ResourceData, m interface{}) error
 			log.Printf("[ERROR] Could not reset Incapsula WAF Rule rule_id (%s) with security_rule_action (%s) on site_id (%d) %s\n", ruleID, remoteFileInclusionRuleIDDefaultAction, d.Get("site_id").(int), err)
 			return err
 		}
-	case sqlInjectionRuleID:
+	case imperva.SQLInjectionRuleID:
 		_, err := client.ConfigureWAFSecurityRule(
 			d.Get("site_id").(int),
 			ruleID,
@@ -321,7 +342,7 @@ func resourceWAFSecurityRuleDelete(d *schema.ResourceData, m interface{}) error
 			log.Printf("[ERROR] Could not reset Incapsula WAF Rule rule_id (%s) with security_rule_action (%s) on site_id (%d) %s\n", ruleID, sqlInjectionRuleIDDefaultAction, d.Get("site_id").(int), err)
 			return err
 		}
-	case ddosRuleID:
+	case imperva.DDoSRuleID:
 		_, err := client.ConfigureWAFSecurityRule(
 			d.Get("site_id").(int),
 			ruleID,
@@ -335,7 +356,7 @@ func resourceWAFSecurityRuleDelete(d *schema.ResourceData, m interface{}) error
 			log.Printf("[ERROR] Could not reset Incapsula WAF Rule rule_id (%s) with default_activation_mode (%s) and ddos_traffic_threshold (%s) on site_id (%d) %s\n", ruleID, ddosRuleIDDefaultActivationMode, ddosRuleIDDefaultDDOSTrafficThreshold, d.Get("site_id").(int), err)
 			return err
 		}
-	case botAccessControlRuleID:
+	case imperva.BotAccessControlRuleID:
 		_, err := client.ConfigureWAFSecurityRule(
 			d.Get("site_id").(int),
 			ruleID,
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
 )
 
 const wafSecurityRuleNameBackdoor = "Example waf backdoor rule"
@@ -162,12 +163,12 @@ func testAccCheckWAFSecurityRuleDestroyBackdoor(state *terraform.State) error {
 
 		ruleID := res.Primary.ID
 		if ruleID == "" {
-			return fmt.Errorf("Incapsula WAF rule ID " + backdoorRuleID + " does not exist")
+			return fmt.Errorf("Incapsula WAF rule ID " + imperva.BackdoorRuleID + " does not exist")
 		}
 
 		err := "nil"
 		if err == "nil" {
-			return fmt.Errorf("Incapsula WAF security rule for site for domain: %s (site id: %s) still exists", backdoorRuleID, ruleID)
+			return fmt.Errorf("Incapsula WAF security rule for site for domain: %s (site id: %s) still exists", imperva.BackdoorRuleID, ruleID)
 		}
 	}
 
@@ -182,12 +183,12 @@ func testAccCheckWAFSecurityRuleDestroyDDoS(state *terraform.State) error {
 
 		ruleID := res.Primary.ID
 		if ruleID == "" {
-			return fmt.Errorf("Incapsula WAF rule ID " + backdoorRuleID + " does not exist")
+			return fmt.Errorf("Incapsula WAF rule ID " + imperva.BackdoorRuleID + " does not exist")
 		}
 
 		err := "nil"
 		if err == "nil" {
-			return fmt.Errorf("Incapsula WAF security rule for site for domain: %s (site id: %s) still exists", backdoorRuleID, ruleID)
+			return fmt.Errorf("Incapsula WAF security rule for site for domain: %s (site id: %s) still exists", imperva.BackdoorRuleID, ruleID)
 		}
 	}
 
@@ -202,12 +203,12 @@ func testAccCheckWAFSecurityRuleDestroyBots(state *terraform.State) error {
 
 		ruleID := res.Primary.ID
 		if ruleID == "" {
-			return fmt.Errorf("Incapsula WAF rule ID " + backdoorRuleID + " does not exist")
+			return fmt.Errorf("Incapsula WAF rule ID " + imperva.BackdoorRuleID + " does not exist")
 		}
 
 		err := "nil"
 		if err == "nil" {
-			return fmt.Errorf("Incapsula WAF security rule for site for domain: %s (site id: %s) still exists", backdoorRuleID, ruleID)
+			return fmt.Errorf("Incapsula WAF security rule for site for domain: %s (site id: %s) still exists", imperva.BackdoorRuleID, ruleID)
 		}
 	}
 
@@ -0,0 +1,350 @@
+package incapsula
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+func resourceWaitingRoom() *schema.Resource {
+	return &schema.Resource{
+		Create: withSiteLock(resourceWaitingRoomCreate),
+		Read:   resourceWaitingRoomRead,
+		Update: withSiteLock(resourceWaitingRoomUpdate),
+		Delete: withSiteLock(resourceWaitingRoomDelete),
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idSlice := strings.Split(d.Id(), "/")
+				if len(idSlice) != 2 || idSlice[0] == "" || idSlice[1] == "" {
+					return nil, fmt.Errorf("unexpected format of ID (%q), expected site_id/waiting_room_id", d.Id())
+				}
+
+				siteID, err := strconv.Atoi(idSlice[0])
+				if err != nil {
+					return nil, fmt.Errorf("failed to convert site ID from import command, actual value: %s, expected numeric id", idSlice[0])
+				}
+
+				d.Set("site_id", siteID)
+				d.SetId(idSlice[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		CustomizeDiff: customdiff.All(validateSiteReference("site_id"), validateWaitingRoomThresholds, validateWaitingRoomActivationWindow),
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"site_id": {
+				Description: "Numeric identifier of the site to operate on.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The waiting room name, unique within the site.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			// Optional Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account of the site. Required when the site belongs to a sub account.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Description: "The waiting room description.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"enabled": {
+				Description: "Whether the waiting room is enabled.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"html_template_base64": {
+				Description: "The HTML page displayed to the visitors in the queue, encoded in base64. The default template of the account is used when not set.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"filter": {
+				Description: "A rule filter, in the syntax of the rules, restricting the waiting room to the matching requests, e.g. URL == \"/checkout\". All the requests to the site are queued when not set.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"bots_action_in_queuing_mode": {
+				Description:  "What happens to bots while the waiting room is queuing visitors. Options are `WAIT_IN_LINE` and `BYPASS`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "WAIT_IN_LINE",
+				ValidateFunc: validateEnum(waitingRoomBotsActionValues),
+			},
+			"queue_inactivity_timeout": {
+				Description:  "The time, in minutes, after which an inactive visitor loses their position in the queue.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
+			"entrance_rate_enabled": {
+				Description: "Activates the waiting room when the rate of new visitors exceeds `entrance_rate_threshold`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"entrance_rate_threshold": {
+				Description:  "The number of new visitors per minute above which the waiting room is activated.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"concurrent_sessions_enabled": {
+				Description: "Activates the waiting room when the number of active sessions exceeds `concurrent_sessions_threshold`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"concurrent_sessions_threshold": {
+				Description:  "The number of active sessions above which the waiting room is activated.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"inactivity_timeout": {
+				Description:  "The time, in minutes, after which an inactive session stops counting as an active session.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 30),
+			},
+			"hide_position_in_line": {
+				Description: "Hides the position of the visitors in the queue.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"activation_start_time": {
+				Description:      "The time the waiting room starts being active, in RFC3339 format, e.g. `2022-11-25T08:00:00Z`. The waiting room is active as soon as it's created when not set.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentTimeDiffs,
+			},
+			"activation_end_time": {
+				Description:      "The time the waiting room stops being active, in RFC3339 format, e.g. `2022-11-29T00:00:00Z`. The waiting room stays active when not set.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentTimeDiffs,
+			},
+
+			// Computed Attributes
+			"mode": {
+				Description: "Whether the waiting room is currently queuing visitors: `QUEUING` or `NOT_QUEUING`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "The creation time of the waiting room, in milliseconds since the epoch.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"last_modified_at": {
+				Description: "The last modification time of the waiting room, in milliseconds since the epoch.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"last_modified_by": {
+				Description: "The user who last modified the waiting room.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// validateWaitingRoomThresholds checks a threshold activates the waiting room, and the enabled thresholds are set
+func validateWaitingRoomThresholds(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	entranceRateEnabled := diff.Get("entrance_rate_enabled").(bool)
+	concurrentSessionsEnabled := diff.Get("concurrent_sessions_enabled").(bool)
+	if !entranceRateEnabled && !concurrentSessionsEnabled {
+		return fmt.Errorf("at least one of entrance_rate_enabled and concurrent_sessions_enabled must be true, the waiting room is activated when its threshold is exceeded")
+	}
+	if entranceRateEnabled && diff.NewValueKnown("entrance_rate_threshold") && diff.Get("entrance_rate_threshold").(int) == 0 {
+		return fmt.Errorf("entrance_rate_threshold must be set when entrance_rate_enabled is true")
+	}
+	if concurrentSessionsEnabled && diff.NewValueKnown("concurrent_sessions_threshold") && diff.Get("concurrent_sessions_threshold").(int) == 0 {
+		return fmt.Errorf("concurrent_sessions_threshold must be set when concurrent_sessions_enabled is true")
+	}
+	return nil
+}
+
+// validateWaitingRoomActivationWindow checks the activation window ends after it starts
+func validateWaitingRoomActivationWindow(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if !diff.NewValueKnown("activation_start_time") || !diff.NewValueKnown("activation_end_time") {
+		return nil
+	}
+	return checkWaitingRoomActivationWindow(diff.Get("activation_start_time").(string), diff.Get("activation_end_time").(string))
+}
+
+func checkWaitingRoomActivationWindow(startTime, endTime string) error {
+	if startTime == "" || endTime == "" {
+		return nil
+	}
+	if waitingRoomTimeToMillis(endTime) <= waitingRoomTimeToMillis(startTime) {
+		return fmt.Errorf("activation_end_time (%s) must be after activation_start_time (%s)", endTime, startTime)
+	}
+	return nil
+}
+
+// suppressEquivalentTimeDiffs ignores the changes of RFC3339 times designating the same instant,
+// e.g. in another time zone than the UTC times read back
+func suppressEquivalentTimeDiffs(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
+
+// waitingRoomTimeToMillis converts an RFC3339 time to milliseconds since the epoch, 0 when not set
+func waitingRoomTimeToMillis(value string) int64 {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return 0
+	}
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+// waitingRoomTimeFromMillis converts milliseconds since the epoch to an RFC3339 time in UTC, empty when not set
+func waitingRoomTimeFromMillis(millis int64) string {
+	if millis == 0 {
+		return ""
+	}
+	return time.Unix(0, millis*int64(time.Millisecond)).UTC().Format(time.RFC3339)
+}
+
+func waitingRoomFromResourceData(d *schema.ResourceData) *imperva.WaitingRoom {
+	return &imperva.WaitingRoom{
+		Name:                        d.Get("name").(string),
+		Description:                 d.Get("description").(string),
+		Enabled:                     d.Get("enabled").(bool),
+		HTMLTemplateBase64:          d.Get("html_template_base64").(string),
+		Filter:                      d.Get("filter").(string),
+		BotsActionInQueuingMode:     d.Get("bots_action_in_queuing_mode").(string),
+		QueueInactivityTimeout:      d.Get("queue_inactivity_timeout").(int),
+		IsEntranceRateEnabled:       d.Get("entrance_rate_enabled").(bool),
+		EntranceRateThreshold:       d.Get("entrance_rate_threshold").(int),
+		IsConcurrentSessionsEnabled: d.Get("concurrent_sessions_enabled").(bool),
+		ConcurrentSessionsThreshold: d.Get("concurrent_sessions_threshold").(int),
+		InactivityTimeout:           d.Get("inactivity_timeout").(int),
+		HidePositionInLine:          d.Get("hide_position_in_line").(bool),
+		StartTime:                   waitingRoomTimeToMillis(d.Get("activation_start_time").(string)),
+		EndTime:                     waitingRoomTimeToMillis(d.Get("activation_end_time").(string)),
+	}
+}
+
+func resourceWaitingRoomCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+	siteID := d.Get("site_id").(int)
+
+	waitingRoom, err := client.AddWaitingRoom(accountID, siteID, waitingRoomFromResourceData(d))
+	if err != nil {
+		log.Printf("[ERROR] Could not create Incapsula waiting room %s for site id %d: %s\n", d.Get("name"), siteID, err)
+		return err
+	}
+
+	d.SetId(strconv.FormatInt(waitingRoom.ID, 10))
+	log.Printf("[INFO] Created Incapsula waiting room %s for site id %d\n", d.Id(), siteID)
+
+	return resourceWaitingRoomRead(d, m)
+}
+
+func resourceWaitingRoomRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	accountID := d.Get("account_id").(int)
+	siteID := d.Get("site_id").(int)
+
+	waitingRoom, statusCode, err := client.GetWaitingRoom(accountID, siteID, d.Id())
+
+	// If the waiting room is deleted on the server, blow it out locally and run through the normal TF cycle
+	if statusCode == 404 {
+		log.Printf("[INFO] Incapsula waiting room %s for site id %d has already been deleted: %s\n", d.Id(), siteID, err)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula waiting room %s for site id %d: %s\n", d.Id(), siteID, err)
+		return err
+	}
+
+	if waitingRoom.AccountID != 0 {
+		d.Set("account_id", waitingRoom.AccountID)
+	}
+	d.Set("name", waitingRoom.Name)
+	d.Set("description", waitingRoom.Description)
+	d.Set("enabled", waitingRoom.Enabled)
+	d.Set("html_template_base64", waitingRoom.HTMLTemplateBase64)
+	d.Set("filter", waitingRoom.Filter)
+	d.Set("bots_action_in_queuing_mode", waitingRoom.BotsActionInQueuingMode)
+	d.Set("queue_inactivity_timeout", waitingRoom.QueueInactivityTimeout)
+	d.Set("entrance_rate_enabled", waitingRoom.IsEntranceRateEnabled)
+	d.Set("entrance_rate_threshold", waitingRoom.EntranceRateThreshold)
+	d.Set("concurrent_sessions_enabled", waitingRoom.IsConcurrentSessionsEnabled)
+	d.Set("concurrent_sessions_threshold", waitingRoom.ConcurrentSessionsThreshold)
+	d.Set("inactivity_timeout", waitingRoom.InactivityTimeout)
+	d.Set("hide_position_in_line", waitingRoom.HidePositionInLine)
+	d.Set("activation_start_time", waitingRoomTimeFromMillis(waitingRoom.StartTime))
+	d.Set("activation_end_time", waitingRoomTimeFromMillis(waitingRoom.EndTime))
+	d.Set("mode", waitingRoom.Mode)
+	d.Set("created_at", waitingRoom.CreatedAt)
+	d.Set("last_modified_at", waitingRoom.LastModifiedAt)
+	d.Set("last_modified_by", waitingRoom.LastModifiedBy)
+
+	return nil
+}
+
+func resourceWaitingRoomUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	siteID := d.Get("site_id").(int)
+
+	_, err := client.UpdateWaitingRoom(d.Get("account_id").(int), siteID, d.Id(), waitingRoomFromResourceData(d))
+	if err != nil {
+		log.Printf("[ERROR] Could not update Incapsula waiting room %s for site id %d: %s\n", d.Id(), siteID, err)
+		return err
+	}
+
+	return resourceWaitingRoomRead(d, m)
+}
+
+func resourceWaitingRoomDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*providerMeta).client
+	siteID := d.Get("site_id").(int)
+
+	statusCode, err := client.DeleteWaitingRoom(d.Get("account_id").(int), siteID, d.Id())
+	if err != nil && statusCode != 404 {
+		log.Printf("[ERROR] Could not delete Incapsula waiting room %s for site id %d: %s\n", d.Id(), siteID, err)
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,132 @@
+package incapsula
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const waitingRoomResourceType = "incapsula_waiting_room"
+const waitingRoomResourceName = "testacc-terraform-waiting-room"
+const waitingRoomResource = waitingRoomResourceType + "." + waitingRoomResourceName
+
+func TestAccIncapsulaWaitingRoom_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaWaitingRoomConfigBasic(600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(waitingRoomResource, "name", "testacc-terraform-waiting-room"),
+					resource.TestCheckResourceAttr(waitingRoomResource, "entrance_rate_threshold", "600"),
+					resource.TestCheckResourceAttrSet(waitingRoomResource, "mode"),
+				),
+			},
+			{
+				Config: testAccCheckIncapsulaWaitingRoomConfigBasic(1000),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(waitingRoomResource, "entrance_rate_threshold", "1000"),
+				),
+			},
+			{
+				Config: testAccCheckIncapsulaWaitingRoomConfigActivationWindow(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(waitingRoomResource, "activation_start_time", "2030-11-25T08:00:00Z"),
+					resource.TestCheckResourceAttr(waitingRoomResource, "activation_end_time", "2030-11-29T00:00:00Z"),
+				),
+			},
+			{
+				ResourceName:      waitingRoomResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccStateWaitingRoomID,
+			},
+		},
+	})
+}
+
+func TestAccIncapsulaWaitingRoom_NoThreshold(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaSiteConfigBasic(GenerateTestDomain(nil)) + fmt.Sprintf(`
+	resource "%s" "%s" {
+		site_id = %s.id
+		name    = "testacc-terraform-waiting-room"
+	}`, waitingRoomResourceType, waitingRoomResourceName, siteResourceName),
+				ExpectError: regexp.MustCompile("at least one of entrance_rate_enabled and concurrent_sessions_enabled must be true"),
+			},
+		},
+	})
+}
+
+func TestWaitingRoomActivationWindow(t *testing.T) {
+	d := resourceWaitingRoom().TestResourceData()
+	d.Set("activation_start_time", "2030-11-25T10:00:00+02:00")
+	d.Set("activation_end_time", "2030-11-29T00:00:00Z")
+
+	waitingRoom := waitingRoomFromResourceData(d)
+	if waitingRoom.StartTime != 1921824000000 || waitingRoom.EndTime != 1922140800000 {
+		t.Errorf("Should have sent the activation window in milliseconds, got: %d, %d", waitingRoom.StartTime, waitingRoom.EndTime)
+	}
+	if waitingRoomTimeFromMillis(waitingRoom.StartTime) != "2030-11-25T08:00:00Z" || waitingRoomTimeFromMillis(0) != "" {
+		t.Errorf("Should have read back the activation window in UTC, got: %s", waitingRoomTimeFromMillis(waitingRoom.StartTime))
+	}
+	if !suppressEquivalentTimeDiffs("activation_start_time", "2030-11-25T08:00:00Z", "2030-11-25T10:00:00+02:00", d) {
+		t.Errorf("Should have suppressed the diff of the same time in another time zone")
+	}
+	if suppressEquivalentTimeDiffs("activation_start_time", "2030-11-25T08:00:00Z", "", d) {
+		t.Errorf("Should not have suppressed the removal of the time")
+	}
+
+	if err := checkWaitingRoomActivationWindow("2030-11-25T08:00:00Z", ""); err != nil {
+		t.Errorf("Should have accepted a window without end, got: %s", err)
+	}
+	err := checkWaitingRoomActivationWindow("2030-11-29T00:00:00Z", "2030-11-25T08:00:00Z")
+	if err == nil || !strings.Contains(err.Error(), "must be after activation_start_time") {
+		t.Errorf("Should have rejected a window ending before it starts, got: %v", err)
+	}
+}
+
+func testAccStateWaitingRoomID(s *terraform.State) (string, error) {
+	res, ok := s.RootModule().Resources[waitingRoomResource]
+	if !ok {
+		return "", fmt.Errorf("Incapsula waiting room resource not found: %s", waitingRoomResource)
+	}
+	return fmt.Sprintf("%s/%s", res.Primary.Attributes["site_id"], res.Primary.ID), nil
+}
+
+func testAccCheckIncapsulaWaitingRoomConfigBasic(entranceRateThreshold int) string {
+	return testAccCheckIncapsulaSiteConfigBasic(GenerateTestDomain(nil)) + fmt.Sprintf(`
+	resource "%s" "%s" {
+		site_id                 = %s.id
+		name                    = "testacc-terraform-waiting-room"
+		filter                  = "URL == \"/checkout\""
+		entrance_rate_enabled   = true
+		entrance_rate_threshold = %d
+	}`,
+		waitingRoomResourceType, waitingRoomResourceName, siteResourceName, entranceRateThreshold,
+	)
+}
+
+func testAccCheckIncapsulaWaitingRoomConfigActivationWindow() string {
+	return testAccCheckIncapsulaSiteConfigBasic(GenerateTestDomain(nil)) + fmt.Sprintf(`
+	resource "%s" "%s" {
+		site_id                 = %s.id
+		name                    = "testacc-terraform-waiting-room"
+		filter                  = "URL == \"/checkout\""
+		entrance_rate_enabled   = true
+		entrance_rate_threshold = 1000
+		activation_start_time   = "2030-11-25T08:00:00Z"
+		activation_end_time     = "2030-11-29T00:00:00Z"
+	}`,
+		waitingRoomResourceType, waitingRoomResourceName, siteResourceName,
+	)
+}
@@ -0,0 +1,36 @@
+package incapsula
+
+import (
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+// retryOnConflict runs the operation, retrying it until the timeout while the API reports a conflicting change.
+// Before each retry the current state is re-read with reread (when set), which returns true when the retry is no
+// longer needed, e.g. the object to delete is already gone, or an error when the operation can't succeed anymore.
+func retryOnConflict(timeout time.Duration, operation func() error, reread func() (bool, error)) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
+		err := operation()
+		if err == nil {
+			return nil
+		}
+		if !imperva.IsConflictError(err) {
+			return resource.NonRetryableError(err)
+		}
+
+		log.Printf("[INFO] Conflicting change in flight, retrying: %s\n", err)
+		if reread != nil {
+			done, rereadErr := reread()
+			if rereadErr != nil {
+				return resource.NonRetryableError(rereadErr)
+			}
+			if done {
+				return nil
+			}
+		}
+		return resource.RetryableError(err)
+	})
+}
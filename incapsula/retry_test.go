@@ -0,0 +1,48 @@
+package incapsula
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+func TestRetryOnConflict(t *testing.T) {
+	conflict := imperva.NewAPIError(http.StatusConflict, nil, "conflict")
+
+	attempts, rereads := 0, 0
+	err := retryOnConflict(time.Minute, func() error {
+		attempts++
+		if attempts == 1 {
+			return conflict
+		}
+		return nil
+	}, func() (bool, error) {
+		rereads++
+		return false, nil
+	})
+	if err != nil || attempts != 2 || rereads != 1 {
+		t.Errorf("Should have re-read and retried once, got: %v, %d attempts, %d re-reads", err, attempts, rereads)
+	}
+
+	attempts = 0
+	err = retryOnConflict(time.Minute, func() error {
+		attempts++
+		return conflict
+	}, func() (bool, error) {
+		return true, nil
+	})
+	if err != nil || attempts != 1 {
+		t.Errorf("Should have stopped when the re-read state needs no retry, got: %v, %d attempts", err, attempts)
+	}
+
+	attempts = 0
+	err = retryOnConflict(time.Minute, func() error {
+		attempts++
+		return imperva.NewAPIError(http.StatusBadRequest, nil, "bad request")
+	}, nil)
+	if err == nil || err.Error() != "bad request" || attempts != 1 {
+		t.Errorf("Should not have retried other errors, got: %v, %d attempts", err, attempts)
+	}
+}
@@ -0,0 +1,396 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+// siteFullConfig is the configuration document of a site: its settings, performance settings, rules and data centers.
+// The IDs of the data centers are left out, the rules refer to them by name, so the document of a site can be
+// applied to another one.
+type siteFullConfig struct {
+	Settings    *siteFullConfigSettings      `json:"settings"`
+	Performance *imperva.PerformanceSettings `json:"performance"`
+	Rules       []siteFullConfigRule         `json:"rules"`
+	DataCenters *imperva.DataCentersStruct   `json:"data_centers"`
+}
+
+// siteFullConfigRule is a rule of the document, the rules forwarding to a data center name it instead of its dc_id
+type siteFullConfigRule struct {
+	imperva.IncapRule
+	DataCenter string `json:"data_center,omitempty"`
+}
+
+// siteFullConfigSettings are the settings of the site updated one by one, see UpdateSite
+type siteFullConfigSettings struct {
+	Active               string `json:"active"`
+	AccelerationLevel    string `json:"acceleration_level"`
+	SealLocation         string `json:"seal_location"`
+	RestrictedCnameReuse bool   `json:"restricted_cname_reuse"`
+}
+
+// parseSiteFullConfig parses the configuration document, rejecting unknown keys and missing sections
+func parseSiteFullConfig(document string) (*siteFullConfig, error) {
+	decoder := json.NewDecoder(strings.NewReader(document))
+	decoder.DisallowUnknownFields()
+
+	var config siteFullConfig
+	err := decoder.Decode(&config)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case config.Settings == nil:
+		return nil, errors.New("missing settings")
+	case config.Performance == nil:
+		return nil, errors.New("missing performance")
+	case config.Rules == nil:
+		return nil, errors.New("missing rules")
+	case config.DataCenters == nil:
+		return nil, errors.New("missing data_centers")
+	}
+
+	return &config, nil
+}
+
+// normalizeSiteFullConfig returns the canonical encoding of the configuration document, with sorted keys and lists,
+// so equivalent documents are equal
+func normalizeSiteFullConfig(config *siteFullConfig) (string, error) {
+	document, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return canonicalJSON(string(document), true)
+}
+
+func validateSiteFullConfig(v interface{}, k string) (ws []string, errs []error) {
+	_, err := parseSiteFullConfig(v.(string))
+	if err != nil {
+		errs = append(errs, fmt.Errorf("%q is not a valid site configuration: %s", k, err))
+	}
+	return
+}
+
+func suppressEquivalentSiteFullConfigDiffs(k, old, new string, d *schema.ResourceData) bool {
+	oldConfig, err := parseSiteFullConfig(old)
+	if err != nil {
+		return false
+	}
+	newConfig, err := parseSiteFullConfig(new)
+	if err != nil {
+		return false
+	}
+
+	oldNormalized, err := normalizeSiteFullConfig(oldConfig)
+	if err != nil {
+		return false
+	}
+	newNormalized, err := normalizeSiteFullConfig(newConfig)
+	if err != nil {
+		return false
+	}
+	return oldNormalized == newNormalized
+}
+
+// exportSiteFullConfig collects the configuration document of the site from its status, its performance settings,
+// the listing of its rules and its data centers configuration
+func exportSiteFullConfig(client *imperva.Client, siteStatus *imperva.SiteStatusResponse) (*siteFullConfig, error) {
+	siteID := strconv.Itoa(siteStatus.SiteID)
+
+	config := &siteFullConfig{
+		Settings: &siteFullConfigSettings{
+			Active:               siteStatus.Active,
+			AccelerationLevel:    siteStatus.AccelerationLevelRaw,
+			SealLocation:         siteStatus.SealLocation.ID,
+			RestrictedCnameReuse: siteStatus.RestrictedCnameReuse,
+		},
+	}
+
+	performanceSettings, _, err := client.GetPerformanceSettings(siteID)
+	if err != nil {
+		return nil, err
+	}
+	config.Performance = performanceSettings
+
+	dataCenters, err := getSiteFullConfigDataCenters(client, siteID)
+	if err != nil {
+		return nil, err
+	}
+	dataCenterNames := make(map[int]string)
+	for i, dataCenter := range dataCenters.DataCenters {
+		if dataCenter.ID != nil {
+			dataCenterNames[*dataCenter.ID] = dataCenter.Name
+		}
+		dataCenters.DataCenters[i].ID = nil
+	}
+	// The password isn't part of the document, applying it keeps the password of the site
+	dataCenters.KickStartPass = ""
+	config.DataCenters = dataCenters
+
+	rules, err := listSiteFullConfigRules(client, siteID)
+	if err != nil {
+		return nil, err
+	}
+	config.Rules = make([]siteFullConfigRule, 0, len(rules))
+	for _, rule := range rules {
+		documentRule := siteFullConfigRule{IncapRule: rule.IncapRule}
+		if name, ok := dataCenterNames[rule.DCID]; ok {
+			documentRule.DataCenter = name
+			documentRule.DCID = 0
+		}
+		config.Rules = append(config.Rules, documentRule)
+	}
+
+	return config, nil
+}
+
+// listSiteFullConfigRules reads the Incap Rules and delivery rules of the site, sorted by ID
+func listSiteFullConfigRules(client *imperva.Client, siteID string) ([]imperva.IncapRuleWithID, error) {
+	incapRuleListResponse, err := client.ListIncapRules(siteID)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleIDs := make([]int, 0)
+	for _, rules := range []map[string][]imperva.IncapRuleListItem{incapRuleListResponse.IncapRules, incapRuleListResponse.DeliveryRules} {
+		for _, category := range rules {
+			for _, rule := range category {
+				ruleID, err := strconv.Atoi(rule.ID.String())
+				if err != nil {
+					return nil, fmt.Errorf("Error parsing Incap Rule ID %s for Site ID %s: %s", rule.ID, siteID, err)
+				}
+				ruleIDs = append(ruleIDs, ruleID)
+			}
+		}
+	}
+	sort.Ints(ruleIDs)
+
+	rules := make([]imperva.IncapRuleWithID, 0, len(ruleIDs))
+	for _, ruleID := range ruleIDs {
+		rule, _, err := client.ReadIncapRule(siteID, ruleID)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, *rule)
+	}
+	return rules, nil
+}
+
+func getSiteFullConfigDataCenters(client *imperva.Client, siteID string) (*imperva.DataCentersStruct, error) {
+	responseDTO, err := client.GetDataCentersConfiguration(siteID)
+	if err != nil {
+		return nil, err
+	}
+	if len(responseDTO.Errors) > 0 {
+		return nil, fmt.Errorf("Error reading Data Centers configuration for site (%s): %s", siteID, responseDTO.Errors)
+	}
+	if len(responseDTO.Data) == 0 {
+		return nil, fmt.Errorf("Error reading Data Centers configuration for site (%s): no data centers", siteID)
+	}
+	return &responseDTO.Data[0], nil
+}
+
+// siteFullConfigRuleChanges are the changes turning the rules of a site into the rules of a document
+type siteFullConfigRuleChanges struct {
+	add    []imperva.IncapRule
+	update map[int]imperva.IncapRule
+	delete []int
+}
+
+// planSiteFullConfigRules pairs the rules of the site with the rules of the document by name, preferring identical
+// rules when several share a name. The paired rules which differ are updated, the others are added or deleted.
+func planSiteFullConfigRules(current []imperva.IncapRuleWithID, desired []imperva.IncapRule) siteFullConfigRuleChanges {
+	changes := siteFullConfigRuleChanges{update: make(map[int]imperva.IncapRule)}
+	paired := make(map[int]bool)
+
+	for _, rule := range desired {
+		// An identical rule is kept as is, otherwise the first unpaired rule of the name is updated
+		match := -1
+		for i, candidate := range current {
+			if paired[candidate.RuleID] || candidate.Name != rule.Name {
+				continue
+			}
+			if match == -1 {
+				match = i
+			}
+			if reflect.DeepEqual(candidate.IncapRule, rule) {
+				match = i
+				break
+			}
+		}
+
+		if match == -1 {
+			changes.add = append(changes.add, rule)
+			continue
+		}
+		paired[current[match].RuleID] = true
+		if !reflect.DeepEqual(current[match].IncapRule, rule) {
+			changes.update[current[match].RuleID] = rule
+		}
+	}
+
+	for _, rule := range current {
+		if !paired[rule.RuleID] {
+			changes.delete = append(changes.delete, rule.RuleID)
+		}
+	}
+
+	return changes
+}
+
+// applySiteFullConfig changes the site to match the configuration document, leaving the sections already matching
+// untouched
+func applySiteFullConfig(client *imperva.Client, siteID int, config *siteFullConfig) error {
+	id := strconv.Itoa(siteID)
+
+	siteStatus, err := client.SiteStatus("full-config", siteID)
+	if err != nil {
+		return err
+	}
+
+	settings := []struct {
+		param, current, desired string
+	}{
+		{"active", siteStatus.Active, config.Settings.Active},
+		{"acceleration_level", siteStatus.AccelerationLevelRaw, config.Settings.AccelerationLevel},
+		{"seal_location", siteStatus.SealLocation.ID, config.Settings.SealLocation},
+		{"restricted_cname_reuse", strconv.FormatBool(siteStatus.RestrictedCnameReuse), strconv.FormatBool(config.Settings.RestrictedCnameReuse)},
+	}
+	for _, setting := range settings {
+		if setting.desired == "" || setting.desired == setting.current {
+			continue
+		}
+		log.Printf("[INFO] Updating Incapsula site param (%s) with value (%s) for site_id: %s\n", setting.param, setting.desired, id)
+		_, err = client.UpdateSite(id, setting.param, setting.desired)
+		if err != nil {
+			return err
+		}
+	}
+
+	performanceSettings, _, err := client.GetPerformanceSettings(id)
+	if err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(performanceSettings, config.Performance) {
+		_, err = client.UpdatePerformanceSettings(id, config.Performance)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The data centers are applied first, so the rules forwarding to them get their IDs
+	err = applySiteFullConfigDataCenters(client, id, config.DataCenters)
+	if err != nil {
+		return err
+	}
+
+	dataCenters, err := getSiteFullConfigDataCenters(client, id)
+	if err != nil {
+		return err
+	}
+	rules, err := resolveSiteFullConfigRules(config.Rules, dataCenters)
+	if err != nil {
+		return err
+	}
+
+	return applySiteFullConfigRules(client, id, rules)
+}
+
+// resolveSiteFullConfigRules returns the rules of the document with the dc_id of the data center they name
+func resolveSiteFullConfigRules(documentRules []siteFullConfigRule, dataCenters *imperva.DataCentersStruct) ([]imperva.IncapRule, error) {
+	dataCenterIDs := make(map[string]int)
+	for _, dataCenter := range dataCenters.DataCenters {
+		if dataCenter.ID != nil {
+			dataCenterIDs[dataCenter.Name] = *dataCenter.ID
+		}
+	}
+
+	rules := make([]imperva.IncapRule, 0, len(documentRules))
+	for _, documentRule := range documentRules {
+		rule := documentRule.IncapRule
+		if documentRule.DataCenter != "" {
+			dataCenterID, ok := dataCenterIDs[documentRule.DataCenter]
+			if !ok {
+				return nil, fmt.Errorf("rule %s forwards to the data center %s, which is not a data center of the site", rule.Name, documentRule.DataCenter)
+			}
+			rule.DCID = dataCenterID
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func applySiteFullConfigRules(client *imperva.Client, siteID string, rules []imperva.IncapRule) error {
+	current, err := listSiteFullConfigRules(client, siteID)
+	if err != nil {
+		return err
+	}
+
+	changes := planSiteFullConfigRules(current, rules)
+	for _, ruleID := range changes.delete {
+		err = client.DeleteIncapRule(siteID, ruleID)
+		if err != nil && !imperva.IsNotFoundError(err) {
+			return err
+		}
+	}
+	for ruleID, rule := range changes.update {
+		rule := rule
+		_, err = client.UpdateIncapRule(siteID, ruleID, &rule)
+		if err != nil {
+			return err
+		}
+	}
+	for _, rule := range changes.add {
+		rule := rule
+		_, err = client.AddIncapRule(siteID, &rule)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applySiteFullConfigDataCenters(client *imperva.Client, siteID string, dataCenters *imperva.DataCentersStruct) error {
+	current, err := getSiteFullConfigDataCenters(client, siteID)
+	if err != nil {
+		return err
+	}
+
+	// The data centers of the site are kept by name, the others are replaced
+	desired := *dataCenters
+	desired.DataCenters = make([]imperva.DataCenterStruct, len(dataCenters.DataCenters))
+	copy(desired.DataCenters, dataCenters.DataCenters)
+	currentIDs := make(map[string]*int)
+	for _, dataCenter := range current.DataCenters {
+		currentIDs[dataCenter.Name] = dataCenter.ID
+	}
+	for i := range desired.DataCenters {
+		desired.DataCenters[i].ID = currentIDs[desired.DataCenters[i].Name]
+	}
+	if desired.KickStartPass == "" {
+		desired.KickStartPass = current.KickStartPass
+	}
+
+	if reflect.DeepEqual(current, &desired) {
+		return nil
+	}
+
+	responseDTO, err := client.PutDataCentersConfiguration(siteID, imperva.DataCentersConfigurationDTO{Data: []imperva.DataCentersStruct{desired}})
+	if err != nil {
+		return err
+	}
+	if len(responseDTO.Errors) > 0 {
+		return fmt.Errorf("Error updating Data Centers configuration for site (%s): %s", siteID, responseDTO.Errors)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package incapsula
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+const testSiteFullConfig = `{
+	"settings": {"active": "active", "acceleration_level": "standard", "seal_location": "api.seal_location.none", "restricted_cname_reuse": false},
+	"performance": {"mode": {"level": "standard"}},
+	"rules": [
+		{"name": "Block bots", "action": "RULE_ACTION_BLOCK", "filter": "ClientType == Bot"},
+		{"name": "Alert", "action": "RULE_ACTION_ALERT", "filter": "CountryCode == FR"}
+	],
+	"data_centers": {"lbAlgorithm": "BEST_CONNECTION_TIME", "dataCenters": [
+		{"name": "Main", "geoLocations": ["EUROPE", "ASIA"], "servers": [{"address": "1.2.3.4", "isEnabled": true}]}
+	]}
+}`
+
+func TestParseSiteFullConfig(t *testing.T) {
+	config, err := parseSiteFullConfig(testSiteFullConfig)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if len(config.Rules) != 2 || config.Settings.AccelerationLevel != "standard" || config.DataCenters.DataCenters[0].Name != "Main" {
+		t.Errorf("Should have parsed the configuration, got: %+v", config)
+	}
+
+	cases := map[string]string{
+		`{"settings": {}, "performance": {}, "rules": [], "data_centers": {}, "waf": {}}`: "unknown field",
+		`{"settings": {}, "performance": {}, "data_centers": {}}`:                         "missing rules",
+		`{"settings": {}, "performance": {}, "rules": []}`:                                "missing data_centers",
+		`[]`: "cannot unmarshal",
+	}
+	for document, expected := range cases {
+		_, err := parseSiteFullConfig(document)
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("Should have received an error containing %q for %s, got: %v", expected, document, err)
+		}
+	}
+}
+
+func TestSuppressEquivalentSiteFullConfigDiffs(t *testing.T) {
+	reordered := `{
+		"data_centers": {"dataCenters": [
+			{"servers": [{"isEnabled": true, "address": "1.2.3.4"}], "geoLocations": ["ASIA", "EUROPE"], "name": "Main"}
+		], "lbAlgorithm": "BEST_CONNECTION_TIME"},
+		"rules": [
+			{"filter": "CountryCode == FR", "action": "RULE_ACTION_ALERT", "name": "Alert"},
+			{"filter": "ClientType == Bot", "action": "RULE_ACTION_BLOCK", "name": "Block bots"}
+		],
+		"performance": {"mode": {"level": "standard"}},
+		"settings": {"seal_location": "api.seal_location.none", "acceleration_level": "standard", "active": "active"}
+	}`
+	if !suppressEquivalentSiteFullConfigDiffs("configuration", testSiteFullConfig, reordered, nil) {
+		t.Errorf("Should have suppressed the diff of reordered keys and lists")
+	}
+
+	changed := `{"settings": {}, "performance": {"mode": {"level": "standard"}}, "rules": [], "data_centers": {}}`
+	if suppressEquivalentSiteFullConfigDiffs("configuration", testSiteFullConfig, changed, nil) {
+		t.Errorf("Should not have suppressed the diff of a different configuration")
+	}
+}
+
+func TestPlanSiteFullConfigRules(t *testing.T) {
+	block := imperva.IncapRule{Name: "Block", Action: "RULE_ACTION_BLOCK", Filter: "ClientType == Bot"}
+	alert := imperva.IncapRule{Name: "Alert", Action: "RULE_ACTION_ALERT", Filter: "CountryCode == FR"}
+	alertChanged := imperva.IncapRule{Name: "Alert", Action: "RULE_ACTION_ALERT", Filter: "CountryCode == DE"}
+	redirect := imperva.IncapRule{Name: "Redirect", Action: "RULE_ACTION_REDIRECT", From: "/a", To: "/b", ResponseCode: 302}
+	duplicate := imperva.IncapRule{Name: "Block", Action: "RULE_ACTION_BLOCK", Filter: "ClientType == Spam"}
+
+	current := []imperva.IncapRuleWithID{
+		{IncapRule: block, RuleID: 1},
+		{IncapRule: alert, RuleID: 2},
+		{IncapRule: duplicate, RuleID: 3},
+		{IncapRule: redirect, RuleID: 4},
+	}
+	desired := []imperva.IncapRule{alertChanged, duplicate, block, {Name: "New", Action: "RULE_ACTION_ALERT"}}
+
+	changes := planSiteFullConfigRules(current, desired)
+
+	if !reflect.DeepEqual(changes.update, map[int]imperva.IncapRule{2: alertChanged}) {
+		t.Errorf("Should have updated the changed rule, got: %v", changes.update)
+	}
+	if len(changes.add) != 1 || changes.add[0].Name != "New" {
+		t.Errorf("Should have added the new rule, got: %v", changes.add)
+	}
+	if !reflect.DeepEqual(changes.delete, []int{4}) {
+		t.Errorf("Should have deleted the rule missing from the document, got: %v", changes.delete)
+	}
+}
+
+func TestResolveSiteFullConfigRules(t *testing.T) {
+	mainID := 42
+	dataCenters := &imperva.DataCentersStruct{DataCenters: []imperva.DataCenterStruct{{ID: &mainID, Name: "Main"}}}
+	forward := siteFullConfigRule{IncapRule: imperva.IncapRule{Name: "Forward", Action: "RULE_ACTION_FORWARD_TO_DC"}, DataCenter: "Main"}
+	block := siteFullConfigRule{IncapRule: imperva.IncapRule{Name: "Block", Action: "RULE_ACTION_BLOCK"}}
+
+	rules, err := resolveSiteFullConfigRules([]siteFullConfigRule{forward, block}, dataCenters)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if len(rules) != 2 || rules[0].DCID != mainID || rules[1].DCID != 0 {
+		t.Errorf("Should have set the dc_id of the rule forwarding to the data center, got: %+v", rules)
+	}
+
+	forward.DataCenter = "Backup"
+	_, err = resolveSiteFullConfigRules([]siteFullConfigRule{forward}, dataCenters)
+	if err == nil || !strings.Contains(err.Error(), "Backup") {
+		t.Errorf("Should have received an error for the unknown data center, got: %v", err)
+	}
+}
@@ -0,0 +1,106 @@
+package incapsula
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// withStateUpgraders sets the schema version of the resource to the number of upgrades, upgrades[i] upgrading
+// the state of version i to version i+1, so existing states are migrated on refresh instead of requiring users
+// to taint or re-import their resources. The resources without upgrades yet are at version 0. The upgrades get the state decoded from JSON, see schema.StateUpgradeFunc.
+// The legacy flatmap states (Terraform < 0.12) are decoded with the current schema.
+func withStateUpgraders(r *schema.Resource, upgrades ...schema.StateUpgradeFunc) *schema.Resource {
+	stateType := r.CoreConfigSchema().ImpliedType()
+	for version, upgrade := range upgrades {
+		r.StateUpgraders = append(r.StateUpgraders, schema.StateUpgrader{
+			Version: version,
+			Type:    stateType,
+			Upgrade: upgrade,
+		})
+	}
+	r.SchemaVersion = len(upgrades)
+	return r
+}
+
+// upgradeStringsToInts converts the attributes stored as strings in a previous version, e.g. IDs, to numbers.
+// Attributes of nested blocks are given by their path, e.g. "data_center.dc_id". Empty strings are removed,
+// so the attribute gets its default.
+func upgradeStringsToInts(rawState map[string]interface{}, paths ...string) error {
+	for _, path := range paths {
+		err := upgradeAttribute(rawState, strings.Split(path, "."), func(value interface{}) (interface{}, bool, error) {
+			stringValue, ok := value.(string)
+			if !ok {
+				return value, true, nil
+			}
+			if stringValue == "" {
+				return nil, false, nil
+			}
+			intValue, err := strconv.Atoi(stringValue)
+			if err != nil {
+				return nil, false, fmt.Errorf("Error upgrading the state, %s is not a number: %q", path, stringValue)
+			}
+			return intValue, true, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// upgradeFlattenedToNested moves the attributes of a previous version to a nested block with a single element,
+// attributes mapping the previous attributes to the ones of the block. The block isn't created when none of the
+// attributes were set.
+func upgradeFlattenedToNested(rawState map[string]interface{}, block string, attributes map[string]string) {
+	nested := make(map[string]interface{})
+	for previous, attribute := range attributes {
+		value, ok := rawState[previous]
+		delete(rawState, previous)
+		if ok && value != nil {
+			nested[attribute] = value
+		}
+	}
+
+	if len(nested) > 0 {
+		rawState[block] = []interface{}{nested}
+	}
+}
+
+// upgradeAttribute replaces the value of the attribute at the path with the one returned by convert,
+// in each element of the nested blocks along the path. The attribute is removed when convert doesn't keep it.
+func upgradeAttribute(rawState map[string]interface{}, path []string, convert func(value interface{}) (interface{}, bool, error)) error {
+	value, ok := rawState[path[0]]
+	if !ok || value == nil {
+		return nil
+	}
+
+	if len(path) > 1 {
+		elements, ok := value.([]interface{})
+		if !ok {
+			return nil
+		}
+		for _, element := range elements {
+			if elementState, ok := element.(map[string]interface{}); ok {
+				err := upgradeAttribute(elementState, path[1:], convert)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	converted, keep, err := convert(value)
+	if err != nil {
+		return err
+	}
+	if keep {
+		rawState[path[0]] = converted
+	} else {
+		delete(rawState, path[0])
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package incapsula
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestWithStateUpgraders(t *testing.T) {
+	for name, resource := range map[string]*schema.Resource{
+		"site":                       resourceSite(),
+		"incap_rule":                 resourceIncapRule(),
+		"cache_rule":                 resourceCacheRule(),
+		"data_centers_configuration": resourceDataCentersConfiguration(),
+	} {
+		if resource.SchemaVersion != 0 || len(resource.StateUpgraders) != 0 {
+			t.Errorf("Should have kept %s at version 0, got: %d %+v", name, resource.SchemaVersion, resource.StateUpgraders)
+		}
+		if err := resource.InternalValidate(nil, true); err != nil {
+			t.Errorf("Should have a valid %s resource, got: %s", name, err)
+		}
+	}
+
+	upgrade := func(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+		err := upgradeStringsToInts(rawState, "ttl")
+		return rawState, err
+	}
+	resource := withStateUpgraders(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"ttl": {Type: schema.TypeInt, Optional: true},
+		},
+	}, upgrade)
+	if resource.SchemaVersion != 1 || len(resource.StateUpgraders) != 1 || resource.StateUpgraders[0].Version != 0 {
+		t.Errorf("Should have set the schema version and the upgrader from version 0, got: %d %+v", resource.SchemaVersion, resource.StateUpgraders)
+	}
+
+	upgraded, err := resource.StateUpgraders[0].Upgrade(context.Background(), map[string]interface{}{"id": "1", "ttl": "30"}, nil)
+	if err != nil || upgraded["ttl"] != 30 {
+		t.Errorf("Should have upgraded the state, got: %+v, %v", upgraded, err)
+	}
+}
+
+func TestUpgradeStringsToInts(t *testing.T) {
+	rawState := map[string]interface{}{
+		"id":         "123",
+		"account_id": "456",
+		"ttl":        float64(30),
+		"dc_id":      "",
+		"data_center": []interface{}{
+			map[string]interface{}{"dc_id": "1", "origin_server": []interface{}{map[string]interface{}{"weight": "50"}}},
+			map[string]interface{}{"dc_id": float64(2)},
+		},
+	}
+
+	err := upgradeStringsToInts(rawState, "account_id", "ttl", "dc_id", "missing", "data_center.dc_id", "data_center.origin_server.weight")
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+
+	expected := map[string]interface{}{
+		"id":         "123",
+		"account_id": 456,
+		"ttl":        float64(30),
+		"data_center": []interface{}{
+			map[string]interface{}{"dc_id": 1, "origin_server": []interface{}{map[string]interface{}{"weight": 50}}},
+			map[string]interface{}{"dc_id": float64(2)},
+		},
+	}
+	if !reflect.DeepEqual(rawState, expected) {
+		t.Errorf("Should have converted the strings to numbers, got: %+v", rawState)
+	}
+}
+
+func TestUpgradeStringsToIntsInvalid(t *testing.T) {
+	err := upgradeStringsToInts(map[string]interface{}{"account_id": "abc"}, "account_id")
+	if err == nil || err.Error() != `Error upgrading the state, account_id is not a number: "abc"` {
+		t.Errorf("Should have received an error, got: %v", err)
+	}
+}
+
+func TestUpgradeFlattenedToNested(t *testing.T) {
+	rawState := map[string]interface{}{"id": "123", "perf_mode_level": "standard", "perf_mode_https": "include_all_resources", "perf_mode_time": nil}
+	upgradeFlattenedToNested(rawState, "mode", map[string]string{"perf_mode_level": "level", "perf_mode_https": "https", "perf_mode_time": "time"})
+
+	expected := map[string]interface{}{"id": "123", "mode": []interface{}{map[string]interface{}{"level": "standard", "https": "include_all_resources"}}}
+	if !reflect.DeepEqual(rawState, expected) {
+		t.Errorf("Should have moved the attributes to the block, got: %+v", rawState)
+	}
+
+	rawState = map[string]interface{}{"id": "123"}
+	upgradeFlattenedToNested(rawState, "mode", map[string]string{"perf_mode_level": "level"})
+	if _, ok := rawState["mode"]; ok {
+		t.Errorf("Should not have created the block, got: %+v", rawState)
+	}
+}
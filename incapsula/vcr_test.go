@@ -0,0 +1,282 @@
+package incapsula
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+// Acceptance tests record the API interactions to fixtures, or replay them without credentials,
+// when INCAPSULA_VCR_MODE is set. Otherwise they hit the live API.
+const vcrModeEnvVar = "INCAPSULA_VCR_MODE"
+const vcrModeRecord = "record"
+const vcrModeReplay = "replay"
+
+const vcrFixturesDir = "testdata/fixtures"
+const vcrRedacted = "REDACTED"
+
+const contentTypeApplicationJSON = "application/json"
+
+// vcrSensitiveFields are redacted from the recorded JSON and form bodies
+var vcrSensitiveFields = []string{
+	"api_id", "api_key", "private_key", "passphrase", "password", "md5Password", "preSharedKey", "greKey", "tlsClientKey",
+}
+
+var vcrSensitiveJSONPattern = regexp.MustCompile(`"(` + strings.Join(vcrSensitiveFields, "|") + `)"\s*:\s*"[^"]*"`)
+var vcrSensitiveFormPattern = regexp.MustCompile(`(^|[?&])(` + strings.Join(vcrSensitiveFields, "|") + `)=[^&]*`)
+
+// vcrInteraction is a recorded request along with its response
+type vcrInteraction struct {
+	Request struct {
+		Method string `json:"method"`
+		URL    string `json:"url"`
+		Body   string `json:"body,omitempty"`
+	} `json:"request"`
+	Response struct {
+		StatusCode int    `json:"status_code"`
+		Body       string `json:"body"`
+	} `json:"response"`
+}
+
+// vcrCassette holds the interactions of one acceptance test
+type vcrCassette struct {
+	Interactions []vcrInteraction `json:"interactions"`
+}
+
+// vcrTransport is an http.RoundTripper recording the interactions of the current test to its cassette,
+// or replaying them from it. Replayed requests match the first unused interaction with the same method,
+// URL and body, so repeated reads get their responses in the recorded order.
+type vcrTransport struct {
+	mode string
+	dir  string
+	live http.RoundTripper
+
+	mutex    sync.Mutex
+	t        *testing.T
+	cassette *vcrCassette
+	used     []bool
+}
+
+// testAccVCR is nil unless INCAPSULA_VCR_MODE is set
+var testAccVCR = newVCRTransport(os.Getenv(vcrModeEnvVar))
+
+func newVCRTransport(mode string) *vcrTransport {
+	switch mode {
+	case vcrModeRecord, vcrModeReplay:
+		return &vcrTransport{mode: mode, dir: vcrFixturesDir, live: http.DefaultTransport}
+	case "":
+		return nil
+	default:
+		log.Fatalf("%s must be %s or %s, got: %s", vcrModeEnvVar, vcrModeRecord, vcrModeReplay, mode)
+		return nil
+	}
+}
+
+// start loads the named cassette for the test, which is saved when the test ends in record mode. In replay mode,
+// the test is skipped when its cassette wasn't recorded
+func (v *vcrTransport) start(t *testing.T, name string) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	path := filepath.Join(v.dir, strings.ReplaceAll(name, "/", "_")+".json")
+	v.t = t
+	v.cassette = &vcrCassette{Interactions: make([]vcrInteraction, 0)}
+	v.used = nil
+
+	if v.mode == vcrModeReplay {
+		data, err := ioutil.ReadFile(path)
+		if os.IsNotExist(err) {
+			// The tests without a recorded fixture only run live
+			t.Skipf("No fixture %s to replay, record it with %s=%s", path, vcrModeEnvVar, vcrModeRecord)
+		}
+		if err != nil {
+			t.Fatalf("Could not read the fixture %s: %s", path, err)
+		}
+		err = json.Unmarshal(data, v.cassette)
+		if err != nil {
+			t.Fatalf("Could not parse the fixture %s: %s", path, err)
+		}
+		v.used = make([]bool, len(v.cassette.Interactions))
+		return
+	}
+
+	t.Cleanup(func() {
+		v.mutex.Lock()
+		defer v.mutex.Unlock()
+		if t.Failed() {
+			t.Logf("Not saving the fixture %s of failed test %s", path, t.Name())
+			return
+		}
+		data, err := json.MarshalIndent(v.cassette, "", "  ")
+		if err == nil {
+			err = os.MkdirAll(v.dir, 0755)
+		}
+		if err == nil {
+			err = ioutil.WriteFile(path, append(data, '\n'), 0644)
+		}
+		if err != nil {
+			t.Errorf("Could not save the fixture %s: %s", path, err)
+		}
+	})
+}
+
+func (v *vcrTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	requestURL := vcrSanitize(req.URL.String())
+	requestBody := vcrSanitize(string(body))
+
+	if v.mode == vcrModeReplay {
+		return v.replay(req, requestURL, requestBody)
+	}
+
+	resp, err := v.live.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(responseBody))
+
+	var interaction vcrInteraction
+	interaction.Request.Method = req.Method
+	interaction.Request.URL = requestURL
+	interaction.Request.Body = requestBody
+	interaction.Response.StatusCode = resp.StatusCode
+	interaction.Response.Body = vcrSanitize(string(responseBody))
+
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+	if v.cassette != nil {
+		v.cassette.Interactions = append(v.cassette.Interactions, interaction)
+	}
+
+	return resp, nil
+}
+
+func (v *vcrTransport) replay(req *http.Request, requestURL, requestBody string) (*http.Response, error) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	if v.cassette == nil {
+		return nil, fmt.Errorf("no fixture loaded to replay %s %s", req.Method, requestURL)
+	}
+
+	for i, interaction := range v.cassette.Interactions {
+		if v.used[i] || interaction.Request.Method != req.Method || interaction.Request.URL != requestURL || interaction.Request.Body != requestBody {
+			continue
+		}
+		v.used[i] = true
+		return &http.Response{
+			Status:     fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode: interaction.Response.StatusCode,
+			Header:     http.Header{"Content-Type": []string{contentTypeApplicationJSON}},
+			Body:       ioutil.NopCloser(strings.NewReader(interaction.Response.Body)),
+			Request:    req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no recorded interaction left for %s %s in the fixture of %s, re-record it with %s=%s", req.Method, requestURL, v.t.Name(), vcrModeEnvVar, vcrModeRecord)
+}
+
+// vcrSanitize redacts the credentials and secrets from a recorded URL or body
+func vcrSanitize(value string) string {
+	for _, envVar := range []string{"INCAPSULA_API_ID", "INCAPSULA_API_KEY"} {
+		if secret := os.Getenv(envVar); secret != "" {
+			value = strings.ReplaceAll(value, secret, vcrRedacted)
+		}
+	}
+	value = vcrSensitiveJSONPattern.ReplaceAllString(value, `"$1":"`+vcrRedacted+`"`)
+	return vcrSensitiveFormPattern.ReplaceAllString(value, "${1}${2}="+vcrRedacted)
+}
+
+func TestVCRRecordReplay(t *testing.T) {
+	dir := t.TempDir()
+	path := "/infra-protect/v1/bgp-connections/123"
+	var serverURL string
+
+	t.Run("record", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			if req.Method != http.MethodPut || req.URL.Path != path {
+				t.Errorf("Unexpected request: %s %s", req.Method, req.URL.Path)
+			}
+			rw.Header().Set("Content-Type", contentTypeApplicationJSON)
+			rw.Write([]byte(`{"data":[{"id":"123","name":"router","peerAsn":64512,"peerIp":"192.0.2.1","sessionState":"IDLE"}]}`))
+		}))
+		defer server.Close()
+		serverURL = server.URL
+
+		recorder := &vcrTransport{mode: vcrModeRecord, dir: dir, live: server.Client().Transport}
+		recorder.start(t, "bgp")
+		client := imperva.NewClient(&imperva.Config{APIID: "foo", APIKey: "bar", BaseURLAPI: serverURL, Transport: recorder})
+		md5Password := "secret"
+		_, err := client.UpdateBGPConnection(0, "123", &imperva.BGPConnection{Name: "router", MD5Password: &md5Password})
+		if err != nil {
+			t.Errorf("Should not have received an error, got: %s", err)
+		}
+	})
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, "bgp.json"))
+	if err != nil {
+		t.Fatalf("Should have saved the fixture, got: %s", err)
+	}
+	if strings.Contains(string(data), "secret") || !strings.Contains(string(data), `md5Password\":\"REDACTED`) {
+		t.Errorf("Should have redacted the MD5 password, got: %s", string(data))
+	}
+
+	t.Run("replay", func(t *testing.T) {
+		player := &vcrTransport{mode: vcrModeReplay, dir: dir}
+		player.start(t, "bgp")
+		client := imperva.NewClient(&imperva.Config{APIID: "foo", APIKey: "bar", BaseURLAPI: serverURL, Transport: player})
+
+		md5Password := "another-secret"
+		connection, err := client.UpdateBGPConnection(0, "123", &imperva.BGPConnection{Name: "router", MD5Password: &md5Password})
+		if err != nil {
+			t.Errorf("Should not have received an error, got: %s", err)
+		}
+		if connection == nil || connection.SessionState != "IDLE" {
+			t.Errorf("Should have replayed the BGP connection, got: %+v", connection)
+		}
+
+		_, err = client.UpdateBGPConnection(0, "123", &imperva.BGPConnection{Name: "router"})
+		if err == nil || !strings.Contains(err.Error(), "no recorded interaction left") {
+			t.Errorf("Should have received a replay error, got: %s", err)
+		}
+	})
+}
+
+func TestVCRReplayMissingFixture(t *testing.T) {
+	var replayed *testing.T
+	t.Run("replay", func(t *testing.T) {
+		replayed = t
+		player := &vcrTransport{mode: vcrModeReplay, dir: t.TempDir()}
+		player.start(t, "missing")
+		t.Errorf("Should have skipped the test without fixture")
+	})
+	if !replayed.Skipped() {
+		t.Errorf("Should have skipped the test without fixture")
+	}
+}
@@ -0,0 +1,89 @@
+package incapsula
+
+import (
+	"github.com/terraform-providers/terraform-provider-incapsula/imperva"
+)
+
+// WAF rule action enumerations
+const wafActionDisabled = "api.threats.action.disabled"
+const wafActionAlert = "api.threats.action.alert"
+const wafActionBlockRequest = "api.threats.action.block_request"
+const wafActionBlockUser = "api.threats.action.block_user"
+const wafActionBlockIP = "api.threats.action.block_ip"
+const wafActionQuarantineURL = "api.threats.action.quarantine_url"
+
+// DDoS activation mode enumerations
+const ddosActivationModeOff = "api.threats.ddos.activation_mode.off"
+const ddosActivationModeAuto = "api.threats.ddos.activation_mode.auto"
+const ddosActivationModeOn = "api.threats.ddos.activation_mode.on"
+
+// WAFRuleCatalogEntry describes a WAF rule which can be configured with incapsula_waf_security_rule
+type WAFRuleCatalogEntry struct {
+	ID            string
+	Name          string
+	Actions       []string
+	DefaultAction string
+}
+
+// wafRuleCatalog lists the WAF rules supported by the sites/configure/security endpoint. It is static: the API lists
+// the rules of a site with their current action only, not their allowed actions or defaults, so a rule added by
+// Imperva is only supported once it is added here.
+// For the DDoS rule the actions are the activation modes, the bot access control rule has no actions.
+var wafRuleCatalog = []WAFRuleCatalogEntry{
+	{
+		ID:            imperva.BackdoorRuleID,
+		Name:          "Backdoor Protect",
+		Actions:       []string{wafActionDisabled, wafActionAlert, wafActionQuarantineURL},
+		DefaultAction: backdoorRuleIDDefaultAction,
+	},
+	{
+		ID:            imperva.CrossSiteScriptingRuleID,
+		Name:          "Cross Site Scripting",
+		Actions:       []string{wafActionDisabled, wafActionAlert, wafActionBlockRequest, wafActionBlockUser, wafActionBlockIP},
+		DefaultAction: crossSiteScriptingRuleIDDefaultAction,
+	},
+	{
+		ID:            imperva.IllegalResourceAccessRuleID,
+		Name:          "Illegal Resource Access",
+		Actions:       []string{wafActionDisabled, wafActionAlert, wafActionBlockRequest, wafActionBlockUser, wafActionBlockIP},
+		DefaultAction: illegalResourceAccessRuleIDDefaultAction,
+	},
+	{
+		ID:            imperva.RemoteFileInclusionRuleID,
+		Name:          "Remote File Inclusion",
+		Actions:       []string{wafActionDisabled, wafActionAlert, wafActionBlockRequest, wafActionBlockUser, wafActionBlockIP},
+		DefaultAction: remoteFileInclusionRuleIDDefaultAction,
+	},
+	{
+		ID:            imperva.SQLInjectionRuleID,
+		Name:          "SQL Injection",
+		Actions:       []string{wafActionDisabled, wafActionAlert, wafActionBlockRequest, wafActionBlockUser, wafActionBlockIP},
+		DefaultAction: sqlInjectionRuleIDDefaultAction,
+	},
+	{
+		ID:            imperva.DDoSRuleID,
+		Name:          "DDoS",
+		Actions:       []string{ddosActivationModeOff, ddosActivationModeAuto, ddosActivationModeOn},
+		DefaultAction: ddosRuleIDDefaultActivationMode,
+	},
+	{
+		ID:      imperva.BotAccessControlRuleID,
+		Name:    "Bot Access Control",
+		Actions: []string{},
+	},
+}
+
+// getWAFRuleCatalogIDs returns the identifiers of all WAF rules in the catalog
+func getWAFRuleCatalogIDs() []string {
+	ids := make([]string, 0, len(wafRuleCatalog))
+	for _, entry := range wafRuleCatalog {
+		ids = append(ids, entry.ID)
+	}
+	return ids
+}
+
+// isWAFRuleCatalogID tells whether the WAF rule is in the catalog, i.e. it can be configured with
+// incapsula_waf_security_rule
+func isWAFRuleCatalogID(ruleID string) bool {
+	return containsString(getWAFRuleCatalogIDs(), ruleID)
+}